@@ -1,8 +1,14 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"github.com/joho/godotenv"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	app "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server"
 )
@@ -21,7 +27,36 @@ func main() {
 		log.Println("Error loading env file", err)
 	}
 
-	if err := srv.Run(); err != nil {
-		log.Fatal("Error occurred while starting server:", err.Error())
+	if len(os.Args) > 1 && os.Args[1] == app.UploadGCCommand {
+		if err := app.RunUploadGC(os.Args[2:]); err != nil {
+			log.Fatal("Error occurred while collecting orphaned uploads:", err.Error())
+		}
+
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	runErr := make(chan error, 1)
+
+	go func() {
+		runErr <- srv.Run()
+	}()
+
+	select {
+	case err := <-runErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Error occurred while starting server:", err.Error())
+		}
+
+		return
+	case <-ctx.Done():
+	}
+
+	log.Println("Shutting down server")
+
+	if err := srv.Shutdown(); err != nil {
+		log.Fatal("Error occurred while shutting down server:", err.Error())
 	}
 }
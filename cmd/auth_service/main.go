@@ -17,6 +17,7 @@ import (
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery"
 	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
 	authrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/repository"
+	userusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
 	"github.com/gorilla/mux"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -27,6 +28,8 @@ import (
 const (
 	timeout = 10 * time.Second
 	port    = 7071
+
+	backfillSessionsCommand = "backfill-sessions"
 )
 
 func main() {
@@ -36,6 +39,26 @@ func main() {
 	}
 
 	cfg := config.ReadConfig()
+
+	if len(os.Args) > 1 && os.Args[1] == backfillSessionsCommand {
+		if err := runBackfillSessions(cfg); err != nil {
+			log.Fatal("Error occurred while backfilling old sessions:", err.Error())
+		}
+
+		return
+	}
+
+	tokenSecret := os.Getenv("AUTH_TOKEN_SECRET")
+	if tokenSecret == "" {
+		if !cfg.Server.Dev {
+			log.Println("AUTH_TOKEN_SECRET is not set, refusing to start auth service")
+
+			return
+		}
+
+		log.Println("AUTH_TOKEN_SECRET is not set, links from emails will stop working after restart")
+	}
+
 	addr := cfg.Server.AuthIP + cfg.Server.AuthServicePort // ДЛЯ ЗАПУСКА В КОНТЕЙНЕРЕ
 	//addr := cfg.Server.Host + cfg.Server.AuthServicePort // ДЛЯ ЛОКАЛЬНОГО ЗАПУСКА (НЕ В КОНТЕЙНЕРЕ)
 
@@ -78,7 +101,9 @@ func main() {
 	}
 
 	userStorage := authrepo.NewUserStorage(connPool, redisConnPool, postgresMetrics)
-	authManager := delivery.NewAuthManager(userStorage)
+
+	authManager := delivery.NewAuthManager(userStorage, userusecases.NewTokenSigner(tokenSecret),
+		cfg.EmailConfirm, cfg.PasswordReset, cfg.Sessions)
 
 	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.ServeMetricsInterceptor))
 	authproto.RegisterAuthServer(srv, authManager)
@@ -101,3 +126,25 @@ func main() {
 		return
 	}
 }
+
+// runBackfillSessions - подкоманда backfill-sessions: разовая миграция сессий, открытых до появления сроков
+// и индекса user_sessions. Обходит все ключи Redis, поэтому при каждом старте сервиса не запускается.
+func runBackfillSessions(cfg *config.Config) error {
+	redisConnPool := pgxpoolconfig.NewRedisPool(os.Getenv("REDIS_HOST")+":"+os.Getenv("REDIS_PORT_ENV"),
+		os.Getenv("REDIS_PASSWORD"))
+	defer redisConnPool.Close()
+
+	// сессии живут только в Redis, база не нужна
+	userStorage := authrepo.NewUserStorage(nil, redisConnPool, nil)
+	authManager := delivery.NewAuthManager(userStorage, userusecases.NewTokenSigner(""), cfg.EmailConfirm,
+		cfg.PasswordReset, cfg.Sessions)
+
+	backfilled, err := authManager.BackfillSessions(context.Background())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Old sessions backfilled:", backfilled)
+
+	return nil
+}
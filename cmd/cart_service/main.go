@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	createMediaMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/media"
 	createMetricsMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/metrics"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -68,10 +70,18 @@ func main() {
 		log.Println("Error while creating postgres metrics for cart service")
 	}
 
-	cartStorage := cartrepo.NewCartStorage(connPool, postgresMetrics)
+	blobStore, err := blobstore.New(cfg.BlobStore)
+	if err != nil {
+		log.Println("Error occurred while creating blob store", err)
+
+		return
+	}
+
+	cartStorage := cartrepo.NewCartStorage(connPool, postgresMetrics, blobStore)
 	cartManager := delivery.NewCartManager(cartStorage)
 
-	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.ServeMetricsInterceptor))
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.ServeMetricsInterceptor,
+		createMediaMiddleware.InlineImagesServerInterceptor))
 	cartproto.RegisterCartServer(srv, cartManager)
 	log.Println("Cart service is running on port", cfg.Server.CartServicePort)
 
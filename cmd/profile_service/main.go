@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	createMediaMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/media"
 	createMetricsMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/metrics"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -68,10 +70,18 @@ func main() {
 		log.Println("Error while creating postgres metrics")
 	}
 
-	profileStorage := profilerepo.NewProfileStorage(connPool, postgresMetrics)
+	blobStore, err := blobstore.New(cfg.BlobStore)
+	if err != nil {
+		log.Println("Error occurred while creating blob store", err)
+
+		return
+	}
+
+	profileStorage := profilerepo.NewProfileStorage(connPool, postgresMetrics, blobStore)
 	profileManager := delivery.NewProfileManager(profileStorage)
 
-	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.ServeMetricsInterceptor))
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.ServeMetricsInterceptor,
+		createMediaMiddleware.InlineImagesServerInterceptor))
 	profileproto.RegisterProfileServer(srv, profileManager)
 	log.Println("Profile service is running on port", cfg.Server.ProfileServicePort)
 
@@ -0,0 +1,32 @@
+-- Разовое поднятие объявления в обычной выдаче
+ALTER TABLE public.advert ADD COLUMN IF NOT EXISTS bumped_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS advert_bumped_at_idx ON public.advert (bumped_at DESC NULLS LAST, id);
+
+ALTER TABLE public.payments ADD COLUMN IF NOT EXISTS service_type TEXT DEFAULT 'promotion' NOT NULL
+    CONSTRAINT payment_service_type CHECK (service_type IN ('promotion', 'bump'));
+
+ALTER TABLE public.payments ALTER COLUMN promotion_duration DROP NOT NULL;
+
+CREATE INDEX IF NOT EXISTS payments_advert_service_idx ON public.payments (advert_id, service_type, created_time);
+
+-- Поднятие применяется один раз, при переходе платежа в waiting_for_capture, и не трогает продвижение
+CREATE OR REPLACE FUNCTION update_advert_promotion_func()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.service_type = 'bump' THEN
+        IF NEW.payment_status = 'waiting_for_capture' AND OLD.payment_status IS DISTINCT FROM NEW.payment_status THEN
+            UPDATE advert
+            SET bumped_at = now()
+            WHERE id = NEW.advert_id;
+        END IF;
+
+        RETURN NEW;
+    END IF;
+
+    UPDATE advert
+    SET is_promoted = true, promotion_start = now(), promotion_duration = NEW.promotion_duration
+    WHERE id = NEW.advert_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
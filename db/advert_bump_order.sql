@@ -0,0 +1,7 @@
+-- Поднятие ставит объявление на место только что созданного, а не выше всех никогда не поднятых
+DROP INDEX IF EXISTS public.advert_bumped_at_idx;
+
+CREATE INDEX IF NOT EXISTS advert_bumped_or_created_idx ON public.advert ((COALESCE(bumped_at, created_time)) DESC, id);
+
+CREATE INDEX IF NOT EXISTS payments_advert_pending_bump_idx ON public.payments (advert_id, created_time)
+    WHERE service_type = 'bump' AND payment_status = 'pending';
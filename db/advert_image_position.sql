@@ -0,0 +1,14 @@
+ALTER TABLE public.advert_image ADD COLUMN IF NOT EXISTS position INTEGER DEFAULT 0 NOT NULL
+    CONSTRAINT position_is_not_negative CHECK (position >= 0);
+
+UPDATE public.advert_image ai
+SET position = numbered.row_num - 1
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY advert_id ORDER BY id) AS row_num
+    FROM public.advert_image
+) numbered
+WHERE ai.id = numbered.id;
+
+ALTER TABLE public.advert_image DROP CONSTRAINT IF EXISTS advert_image_position_unique;
+ALTER TABLE public.advert_image ADD CONSTRAINT advert_image_position_unique UNIQUE (advert_id, position)
+    DEFERRABLE INITIALLY DEFERRED;
@@ -0,0 +1,6 @@
+ALTER TABLE public.advert_image ADD COLUMN IF NOT EXISTS renditions JSONB DEFAULT '{}'::jsonb NOT NULL;
+
+-- старые записи: оригинал считаем полным размером, а растянутую копию - карточкой
+UPDATE public.advert_image
+SET renditions = jsonb_strip_nulls(jsonb_build_object('full', url, 'card', url_resized))
+WHERE renditions = '{}'::jsonb;
@@ -0,0 +1,10 @@
+-- Бронь объявления на время оформления заказа. Истёкшая бронь не мешает другим покупателям,
+-- а сами строки периодически вычищает ScheduledUpdate.
+CREATE TABLE IF NOT EXISTS public.advert_reservation
+(
+    advert_id      BIGINT                   PRIMARY KEY REFERENCES public.advert (id) ON DELETE CASCADE,
+    user_id        BIGINT                   NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    reserved_until TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS advert_reservation_until_idx ON public.advert_reservation (reserved_until);
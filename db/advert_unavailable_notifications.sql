@@ -0,0 +1,47 @@
+-- Уведомления внутри сервиса: пока только о продаже и снятии объявлений из избранного и корзины
+CREATE TABLE IF NOT EXISTS public.notification
+(
+    id           BIGINT                                            GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id      BIGINT                                            NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    kind         TEXT                                              NOT NULL,
+    advert_id    BIGINT                                            REFERENCES public.advert (id) ON DELETE CASCADE,
+    is_read      BOOLEAN                  DEFAULT FALSE            NOT NULL,
+    created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS notification_user_created_idx ON public.notification (user_id, created_time DESC, id DESC);
+
+-- Раньше функция писала в несуществующий столбец adverts_status, и заказ падал целиком
+CREATE OR REPLACE FUNCTION set_advert_status_to_sold()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE advert
+    SET advert_status = 'Продано'
+    WHERE id = NEW.advert_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Срабатывает и на CloseAdvert, и на продажу через set_advert_status_to_sold.
+-- Продавцу и покупателю (у него уже есть заказ) уведомление не нужно.
+CREATE OR REPLACE FUNCTION notify_advert_unavailable()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notification (user_id, kind, advert_id)
+    SELECT holders.user_id,
+           CASE WHEN NEW.advert_status = 'Продано' THEN 'advert_sold' ELSE 'advert_closed' END,
+           NEW.id
+    FROM (SELECT user_id FROM favourite WHERE advert_id = NEW.id
+          UNION
+          SELECT user_id FROM cart WHERE advert_id = NEW.id) AS holders
+    WHERE holders.user_id <> NEW.user_id
+      AND NOT EXISTS (SELECT 1 FROM "order" o WHERE o.advert_id = NEW.id AND o.user_id = holders.user_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER advert_unavailable_trigger
+AFTER UPDATE OF advert_status ON advert
+FOR EACH ROW
+WHEN (OLD.advert_status = 'Активно' AND NEW.advert_status IN ('Продано', 'Скрыто'))
+EXECUTE PROCEDURE notify_advert_unavailable();
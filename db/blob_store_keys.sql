@@ -0,0 +1,13 @@
+-- файлы теперь адресуются ключами в хранилище, а не путями на локальном диске
+UPDATE public.advert_image
+SET url = regexp_replace(url, '^\./uploads/', ''),
+    url_resized = regexp_replace(url_resized, '^\./uploads/', ''),
+    renditions = COALESCE((
+        SELECT jsonb_object_agg(r.key, regexp_replace(r.value, '^\./uploads/', ''))
+        FROM jsonb_each_text(renditions) AS r
+    ), '{}'::jsonb)
+WHERE url LIKE './uploads/%' OR url_resized LIKE './uploads/%' OR renditions::text LIKE '%./uploads/%';
+
+UPDATE public.profile
+SET avatar_url = regexp_replace(avatar_url, '^\./uploads/', '')
+WHERE avatar_url LIKE './uploads/%';
@@ -0,0 +1,3 @@
+-- Способ доставки выбирает покупатель, а цену считает сервер по городам покупателя и продавца
+ALTER TABLE public."order" ADD COLUMN IF NOT EXISTS delivery_method TEXT DEFAULT 'post' NOT NULL
+    CONSTRAINT order_delivery_method CHECK (delivery_method IN ('pickup', 'courier', 'post'));
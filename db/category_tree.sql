@@ -0,0 +1,8 @@
+ALTER TABLE public.category ADD COLUMN IF NOT EXISTS parent_id BIGINT
+    REFERENCES public.category (id) ON DELETE RESTRICT;
+
+ALTER TABLE public.category DROP CONSTRAINT IF EXISTS category_parent_is_not_self;
+ALTER TABLE public.category ADD CONSTRAINT category_parent_is_not_self CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS category_parent_id_idx ON public.category (parent_id);
+CREATE UNIQUE INDEX IF NOT EXISTS category_translation_uniq ON public.category (translation);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS public.region
+(
+    id          BIGINT                                             GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    name        TEXT                                               NOT NULL CHECK (name <> '')
+        CONSTRAINT max_len_region_name CHECK (LENGTH(name) <= 255),
+    translation TEXT                                               NOT NULL UNIQUE CHECK (translation <> '')
+        CONSTRAINT max_len_region_translation CHECK (LENGTH(translation) <= 255)
+);
+
+ALTER TABLE public.city ADD COLUMN IF NOT EXISTS region_id BIGINT
+    REFERENCES public.region (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS city_region_id_idx ON public.city (region_id);
+CREATE INDEX IF NOT EXISTS advert_city_status_idx ON public.advert (city_id, advert_status);
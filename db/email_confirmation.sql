@@ -0,0 +1,6 @@
+-- Подтверждение почты: новый адрес ждёт в pending_email, пока по нему не перейдут по ссылке из письма,
+-- а вход до тех пор работает по старому
+ALTER TABLE public."user"
+    ADD COLUMN IF NOT EXISTS email_confirmed BOOLEAN DEFAULT FALSE NOT NULL,
+    ADD COLUMN IF NOT EXISTS pending_email   TEXT
+        CONSTRAINT max_len_pending_email CHECK (LENGTH(pending_email) <= 256);
@@ -0,0 +1,9 @@
+-- Какие письма пользователь согласен получать; нет строки - действуют значения по умолчанию
+DROP TABLE IF EXISTS public.email_preference CASCADE;
+CREATE TABLE IF NOT EXISTS public.email_preference
+(
+    user_id      BIGINT                                            PRIMARY KEY REFERENCES public."user" (id) ON DELETE CASCADE,
+    orders       BOOLEAN                  DEFAULT TRUE             NOT NULL,
+    payments     BOOLEAN                  DEFAULT TRUE             NOT NULL,
+    updated_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL
+);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS public.favourite_collection
+(
+    id           BIGINT                                            GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id      BIGINT                                            NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    name         TEXT                                              NOT NULL CHECK (name <> '')
+        CONSTRAINT max_len_collection_name CHECK (LENGTH(name) <= 100),
+    share_token  UUID                                              UNIQUE,
+    created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL,
+    CONSTRAINT favourite_collection_name_uniq UNIQUE (user_id, name)
+);
+
+-- Избранное по-прежнему одно на пользователя; подборка - необязательная папка для записи
+ALTER TABLE public.favourite ADD COLUMN IF NOT EXISTS collection_id BIGINT
+    REFERENCES public.favourite_collection (id) ON DELETE SET NULL;
+ALTER TABLE public.favourite ADD COLUMN IF NOT EXISTS note TEXT DEFAULT '' NOT NULL
+    CONSTRAINT max_len_favourite_note CHECK (LENGTH(note) <= 500);
+
+-- старые дубли мешают построить уникальный индекс
+DELETE FROM public.favourite f
+USING public.favourite d
+WHERE f.user_id = d.user_id AND f.advert_id = d.advert_id AND f.ctid > d.ctid;
+
+CREATE UNIQUE INDEX IF NOT EXISTS favourite_user_advert_uniq ON public.favourite (user_id, advert_id);
+CREATE INDEX IF NOT EXISTS favourite_collection_id_idx ON public.favourite (collection_id);
@@ -0,0 +1,67 @@
+-- Переписка покупателя с продавцом, одна беседа на пару (объявление, покупатель)
+CREATE TABLE IF NOT EXISTS public.conversation
+(
+    id           BIGINT                                            GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    advert_id    BIGINT                                            NOT NULL REFERENCES public.advert (id) ON DELETE CASCADE,
+    buyer_id     BIGINT                                            NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    seller_id    BIGINT                                            NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL,
+    updated_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL,
+    CONSTRAINT conversation_advert_buyer_uniq UNIQUE (advert_id, buyer_id),
+    CONSTRAINT conversation_not_self CHECK (buyer_id <> seller_id)
+);
+
+CREATE INDEX IF NOT EXISTS conversation_buyer_idx ON public.conversation (buyer_id, updated_time DESC);
+CREATE INDEX IF NOT EXISTS conversation_seller_idx ON public.conversation (seller_id, updated_time DESC);
+
+CREATE TABLE IF NOT EXISTS public.message
+(
+    id              BIGINT                                         GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    conversation_id BIGINT                                         NOT NULL REFERENCES public.conversation (id) ON DELETE CASCADE,
+    sender_id       BIGINT                                         NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    recipient_id    BIGINT                                         NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    text            TEXT                                           NOT NULL CHECK (text <> '')
+        CONSTRAINT max_len_message_text CHECK (LENGTH(text) <= 2000),
+    created_time    TIMESTAMP WITH TIME ZONE DEFAULT NOW()         NOT NULL,
+    read_time       TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS message_conversation_idx ON public.message (conversation_id, id DESC);
+CREATE INDEX IF NOT EXISTS message_unread_idx ON public.message (recipient_id) WHERE read_time IS NULL;
+
+-- Счётчик непрочитанных лежит в профиле рядом с cart_adverts_number и fav_adverts_number
+ALTER TABLE public.profile ADD COLUMN IF NOT EXISTS unread_messages_number INT DEFAULT 0 NOT NULL;
+
+CREATE OR REPLACE FUNCTION increment_unread_messages_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE profile
+    SET unread_messages_number = unread_messages_number + 1
+    WHERE user_id = NEW.recipient_id;
+    UPDATE conversation
+    SET updated_time = NEW.created_time
+    WHERE id = NEW.conversation_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION decrement_unread_messages_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE profile
+    SET unread_messages_number = GREATEST(unread_messages_number - 1, 0)
+    WHERE user_id = NEW.recipient_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER message_insert_trigger
+AFTER INSERT ON message
+FOR EACH ROW
+EXECUTE PROCEDURE increment_unread_messages_number();
+
+CREATE OR REPLACE TRIGGER message_read_trigger
+AFTER UPDATE OF read_time ON message
+FOR EACH ROW
+WHEN (OLD.read_time IS NULL AND NEW.read_time IS NOT NULL)
+EXECUTE PROCEDURE decrement_unread_messages_number();
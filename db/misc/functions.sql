@@ -52,7 +52,7 @@ CREATE OR REPLACE FUNCTION set_advert_status_to_sold()
 RETURNS TRIGGER AS $$
 BEGIN
     UPDATE advert
-    SET adverts_status = 'Продано'
+    SET advert_status = 'Продано'
     WHERE id = NEW.advert_id;
     RETURN NEW;
 END;
@@ -63,6 +63,16 @@ $$ LANGUAGE plpgsql;
 CREATE OR REPLACE FUNCTION update_advert_promotion_func()
 RETURNS TRIGGER AS $$
 BEGIN
+    IF NEW.service_type = 'bump' THEN
+        IF NEW.payment_status = 'waiting_for_capture' AND OLD.payment_status IS DISTINCT FROM NEW.payment_status THEN
+            UPDATE advert
+            SET bumped_at = now()
+            WHERE id = NEW.advert_id;
+        END IF;
+
+        RETURN NEW;
+    END IF;
+
     UPDATE advert
     SET is_promoted = true, promotion_start = now(), promotion_duration = NEW.promotion_duration
     WHERE id = NEW.advert_id;
@@ -71,3 +81,61 @@ END;
 $$ LANGUAGE plpgsql;
 
 
+
+CREATE OR REPLACE FUNCTION notify_advert_unavailable()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notification (user_id, kind, advert_id)
+    SELECT holders.user_id,
+           CASE WHEN NEW.advert_status = 'Продано' THEN 'advert_sold' ELSE 'advert_closed' END,
+           NEW.id
+    FROM (SELECT user_id FROM favourite WHERE advert_id = NEW.id
+          UNION
+          SELECT user_id FROM cart WHERE advert_id = NEW.id) AS holders
+    WHERE holders.user_id <> NEW.user_id
+      AND NOT EXISTS (SELECT 1 FROM "order" o WHERE o.advert_id = NEW.id AND o.user_id = holders.user_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION increment_unread_messages_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE profile
+    SET unread_messages_number = unread_messages_number + 1
+    WHERE user_id = NEW.recipient_id;
+    UPDATE conversation
+    SET updated_time = NEW.created_time
+    WHERE id = NEW.conversation_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION decrement_unread_messages_number()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE profile
+    SET unread_messages_number = GREATEST(unread_messages_number - 1, 0)
+    WHERE user_id = NEW.recipient_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION publish_notification()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('notification_created', json_build_object('id', NEW.id, 'userId', NEW.user_id)::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION notify_payment_succeeded()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notification (user_id, kind, advert_id)
+    SELECT a.user_id, 'payment_succeeded', a.id
+    FROM advert a
+    WHERE a.id = NEW.advert_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
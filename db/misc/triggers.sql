@@ -32,4 +32,32 @@ EXECUTE PROCEDURE set_advert_status_to_sold();
 CREATE TRIGGER update_advert_promotion
 AFTER UPDATE ON payments
 FOR EACH ROW
-EXECUTE PROCEDURE update_advert_promotion_func()
+EXECUTE PROCEDURE update_advert_promotion_func();
+
+CREATE OR REPLACE TRIGGER advert_unavailable_trigger
+AFTER UPDATE OF advert_status ON advert
+FOR EACH ROW
+WHEN (OLD.advert_status = 'Активно' AND NEW.advert_status IN ('Продано', 'Скрыто'))
+EXECUTE PROCEDURE notify_advert_unavailable();
+
+CREATE OR REPLACE TRIGGER message_insert_trigger
+AFTER INSERT ON message
+FOR EACH ROW
+EXECUTE PROCEDURE increment_unread_messages_number();
+
+CREATE OR REPLACE TRIGGER message_read_trigger
+AFTER UPDATE OF read_time ON message
+FOR EACH ROW
+WHEN (OLD.read_time IS NULL AND NEW.read_time IS NOT NULL)
+EXECUTE PROCEDURE decrement_unread_messages_number();
+
+CREATE OR REPLACE TRIGGER notification_insert_trigger
+AFTER INSERT ON notification
+FOR EACH ROW
+EXECUTE PROCEDURE publish_notification();
+
+CREATE OR REPLACE TRIGGER payment_succeeded_trigger
+AFTER UPDATE OF payment_status ON payments
+FOR EACH ROW
+WHEN (NEW.payment_status = 'waiting_for_capture' AND OLD.payment_status IS DISTINCT FROM NEW.payment_status)
+EXECUTE PROCEDURE notify_payment_succeeded()
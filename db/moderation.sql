@@ -0,0 +1,23 @@
+ALTER TYPE public.advert_status ADD VALUE IF NOT EXISTS 'На модерации';
+ALTER TYPE public.advert_status ADD VALUE IF NOT EXISTS 'Отклонено';
+
+DROP TABLE IF EXISTS public.moderator CASCADE;
+CREATE TABLE IF NOT EXISTS public.moderator
+(
+    user_id      BIGINT                                            PRIMARY KEY REFERENCES public."user" (id) ON DELETE CASCADE,
+    created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL
+);
+
+DROP TABLE IF EXISTS public.advert_moderation CASCADE;
+CREATE TABLE IF NOT EXISTS public.advert_moderation
+(
+    id           BIGINT                                            GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    advert_id    BIGINT                                            NOT NULL REFERENCES public.advert (id) ON DELETE CASCADE,
+    moderator_id BIGINT                                            REFERENCES public."user" (id) ON DELETE SET NULL,
+    decision     TEXT                                              NOT NULL
+        CONSTRAINT decision_is_known CHECK (decision IN ('pending', 'approved', 'rejected')),
+    reasons      TEXT[]                   DEFAULT ARRAY[]::TEXT[]  NOT NULL,
+    created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS advert_moderation_advert_id_idx ON public.advert_moderation (advert_id, id DESC);
@@ -0,0 +1,36 @@
+-- Центр уведомлений: кто вызвал уведомление, быстрый подсчёт непрочитанных и оповещение слушателей через NOTIFY
+ALTER TABLE public.notification ADD COLUMN IF NOT EXISTS actor_id BIGINT REFERENCES public."user" (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS notification_unread_idx ON public.notification (user_id) WHERE NOT is_read;
+
+-- Приложение слушает канал notification_created и пересылает новые уведомления в открытые SSE-потоки
+CREATE OR REPLACE FUNCTION publish_notification()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('notification_created', json_build_object('id', NEW.id, 'userId', NEW.user_id)::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER notification_insert_trigger
+AFTER INSERT ON notification
+FOR EACH ROW
+EXECUTE PROCEDURE publish_notification();
+
+-- Оплата подтверждается в YuKassaUpdateOneRecord, продавцу сообщаем о поднятии или продвижении
+CREATE OR REPLACE FUNCTION notify_payment_succeeded()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notification (user_id, kind, advert_id)
+    SELECT a.user_id, 'payment_succeeded', a.id
+    FROM advert a
+    WHERE a.id = NEW.advert_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER payment_succeeded_trigger
+AFTER UPDATE OF payment_status ON payments
+FOR EACH ROW
+WHEN (NEW.payment_status = 'waiting_for_capture' AND OLD.payment_status IS DISTINCT FROM NEW.payment_status)
+EXECUTE PROCEDURE notify_payment_succeeded();
@@ -0,0 +1,4 @@
+-- Статусы, в которые продавец переводит оплаченный заказ
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'Отправлено';
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'Получено';
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'Отменено';
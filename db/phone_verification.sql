@@ -0,0 +1,3 @@
+-- Подтверждение телефона по коду из SMS: флаг сбрасывается при смене номера, сами коды живут в Redis
+ALTER TABLE public.profile
+    ADD COLUMN IF NOT EXISTS phone_verified BOOLEAN DEFAULT FALSE NOT NULL;
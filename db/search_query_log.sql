@@ -0,0 +1,12 @@
+-- Журнал поисковых запросов для подсказок. Пользователь не сохраняется, запрос уже нормализован.
+CREATE TABLE IF NOT EXISTS public.search_query_log
+(
+    id           BIGINT                                            GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    query        TEXT                                              NOT NULL CHECK (query <> '')
+        CONSTRAINT max_len_search_query CHECK (LENGTH(query) <= 64),
+    city_id      BIGINT                                            REFERENCES public.city (id) ON DELETE SET NULL,
+    created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS search_query_log_created_time_idx ON public.search_query_log (created_time);
+CREATE INDEX IF NOT EXISTS search_query_log_query_idx ON public.search_query_log (query, created_time);
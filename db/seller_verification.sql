@@ -0,0 +1,29 @@
+-- Проверка продавцов: заявка с реквизитами и сканами документов, решение модератора выставляет profile.verified
+DROP TABLE IF EXISTS public.verification_request CASCADE;
+CREATE TABLE IF NOT EXISTS public.verification_request
+(
+    id               BIGINT                                            GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id          BIGINT                                            NOT NULL REFERENCES public."user" (id) ON DELETE CASCADE,
+    business_name    TEXT                                              NOT NULL
+        CONSTRAINT business_name_length CHECK (LENGTH(business_name) <= 256),
+    tax_id           TEXT                                              NOT NULL
+        CONSTRAINT tax_id_is_inn CHECK (tax_id ~ '^([0-9]{10}|[0-9]{12})$'),
+    business_address TEXT                     DEFAULT ''               NOT NULL
+        CONSTRAINT business_address_length CHECK (LENGTH(business_address) <= 512),
+    documents        TEXT[]                   DEFAULT ARRAY[]::TEXT[]  NOT NULL,
+    status           TEXT                     DEFAULT 'pending'        NOT NULL
+        CONSTRAINT status_is_known CHECK (status IN ('pending', 'approved', 'rejected')),
+    moderator_id     BIGINT                                            REFERENCES public."user" (id) ON DELETE SET NULL,
+    reason           TEXT                     DEFAULT ''               NOT NULL,
+    created_time     TIMESTAMP WITH TIME ZONE DEFAULT NOW()            NOT NULL,
+    decided_time     TIMESTAMP WITH TIME ZONE
+);
+
+-- у продавца может быть только одна заявка на рассмотрении
+CREATE UNIQUE INDEX IF NOT EXISTS verification_request_pending_idx ON public.verification_request (user_id)
+    WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS verification_request_user_id_idx ON public.verification_request (user_id, id DESC);
+CREATE INDEX IF NOT EXISTS verification_request_status_idx ON public.verification_request (status, id);
+
+-- фильтр «только проверенные продавцы» в выдаче
+CREATE INDEX IF NOT EXISTS profile_verified_idx ON public.profile (user_id) WHERE verified;
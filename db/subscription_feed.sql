@@ -0,0 +1,9 @@
+-- Лента подписок: у подписки появляются порядковый номер и время, чтобы листать подписки и подписчиков
+ALTER TABLE public.subscription ADD COLUMN IF NOT EXISTS id BIGINT GENERATED BY DEFAULT AS IDENTITY;
+ALTER TABLE public.subscription ADD COLUMN IF NOT EXISTS created_time TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL;
+
+CREATE INDEX IF NOT EXISTS subscription_merchant_idx ON public.subscription (user_id_merchant, id DESC);
+CREATE INDEX IF NOT EXISTS subscription_subscriber_idx ON public.subscription (user_id_subscriber, id DESC);
+
+-- Лента берёт активные объявления продавцов от новых к старым
+CREATE INDEX IF NOT EXISTS advert_active_user_idx ON public.advert (user_id, id DESC) WHERE advert_status = 'Активно';
@@ -50,23 +50,27 @@ type Advert struct {
 	InFavourites  bool      `json:"inFavourites"`
 	InCart        bool      `json:"inCart"`
 	FavouritesNum uint      `json:"favouritesNum"`
+	Reserved      bool      `json:"reserved"`
 	Deleted       bool      `json:"-"`
+	Status        string    `json:"-"`
 }
 
 type Promotion struct {
-	NeedPing          bool             `json:"needPing"`
-	IsPromoted        bool             `json:"isPromoted"`
-	PromotionStart    pgtype.Timestamp `json:"promotionStart"`
-	PromotionDuration pgtype.Interval  `json:"promotionDuration"`
+	NeedPing          bool               `json:"needPing"`
+	IsPromoted        bool               `json:"isPromoted"`
+	PromotionStart    pgtype.Timestamp   `json:"promotionStart"`
+	PromotionDuration pgtype.Interval    `json:"promotionDuration"`
+	BumpedAt          pgtype.Timestamptz `json:"bumpedAt"`
 }
 
 type ReturningAdvert struct {
-	Advert    Advert    `json:"advert"`
-	Promotion Promotion `json:"promotion"`
-	City      City      `json:"city"`
-	Category  Category  `json:"category"`
-	Photos    []string  `json:"photos"`
-	PhotosIMG []string  `json:"photosIMG"`
+	Advert     Advert             `json:"advert"`
+	Promotion  Promotion          `json:"promotion"`
+	City       City               `json:"city"`
+	Category   Category           `json:"category"`
+	Photos     []string           `json:"photos"`
+	PhotosIMG  []string           `json:"photosIMG"`
+	Moderation *ModerationVerdict `json:"moderation,omitempty"`
 }
 
 type PhotoPad struct {
@@ -99,6 +103,10 @@ type ReturningAdInList struct {
 	InCart       bool     `json:"inCart"`
 	IsPromoted   bool     `json:"isPromoted"`
 	IsActive     bool     `json:"isActive"`
+	// SellerVerified - продавец прошёл проверку, на карточке показывается значок
+	SellerVerified bool `json:"sellerVerified"`
+	// Reserved - объявление сейчас оформляет другой покупатель
+	Reserved bool `json:"reserved"`
 }
 
 type ReturningAdvertList struct {
@@ -132,3 +140,20 @@ func (advl *ReturningAdInList) Sanitize() {
 
 	advl.Title = sanitizer.Sanitize(advl.Title)
 }
+
+type AdvertImage struct {
+	ID         uint              `json:"id"`
+	AdvertID   uint              `json:"advertId"`
+	URL        string            `json:"url"`
+	URLResized string            `json:"urlResized"`
+	Position   uint              `json:"position"`
+	Renditions map[string]string `json:"renditions"`
+}
+
+type ReceivedAdvertImage struct {
+	ImageID uint `json:"imageId"`
+}
+
+type ReceivedAdvertImagesOrder struct {
+	ImageIDs []uint `json:"imageIds"`
+}
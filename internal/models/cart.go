@@ -27,3 +27,46 @@ type CartList struct {
 type Appended struct {
 	IsAppended bool `json:"isAppended"`
 }
+
+const (
+	CartItemSold   = "sold"
+	CartItemClosed = "closed"
+)
+
+const (
+	DeliveryPickup  = "pickup"
+	DeliveryCourier = "courier"
+	DeliveryPost    = "post"
+)
+
+// CartContent - корзина, разбитая по продавцам. Unavailable перечисляет проданные и снятые объявления,
+// которые клиент предлагает убрать; в суммы они не входят.
+type CartContent struct {
+	Sellers       []*CartSellerGroup     `json:"sellers"`
+	Unavailable   []*UnavailableCartItem `json:"unavailable"`
+	Subtotal      uint                   `json:"subtotal"`
+	DeliveryPrice uint                   `json:"deliveryPrice"`
+	Total         uint                   `json:"total"`
+}
+
+// CartSellerGroup - одна отправка: объявления одного продавца и варианты их доставки покупателю.
+type CartSellerGroup struct {
+	SellerID        uint               `json:"sellerId"`
+	Adverts         []*ReturningAdvert `json:"adverts"`
+	Subtotal        uint               `json:"subtotal"`
+	DeliveryOptions []*DeliveryOption  `json:"deliveryOptions"`
+	DeliveryMethod  string             `json:"deliveryMethod"`
+	DeliveryPrice   uint               `json:"deliveryPrice"`
+	Total           uint               `json:"total"`
+}
+
+type DeliveryOption struct {
+	Method string `json:"method"`
+	Price  uint   `json:"price"`
+}
+
+type UnavailableCartItem struct {
+	AdvertID uint   `json:"advertId"`
+	Title    string `json:"title"`
+	Reason   string `json:"reason"`
+}
@@ -0,0 +1,22 @@
+package models
+
+// CategoryNode - категория в дереве. AdvertsNum считает активные объявления вместе с подкатегориями.
+type CategoryNode struct {
+	ID          uint            `json:"id"`
+	ParentID    *uint           `json:"parentId"`
+	Name        string          `json:"name"`
+	Translation string          `json:"translation"`
+	AdvertsNum  uint            `json:"advertsNum"`
+	Children    []*CategoryNode `json:"children"`
+}
+
+type CategoryTree struct {
+	City       string          `json:"city"`
+	Categories []*CategoryNode `json:"categories"`
+}
+
+type ReceivedCategory struct {
+	Name        string `json:"name"`
+	Translation string `json:"translation"`
+	ParentID    *uint  `json:"parentId"`
+}
@@ -6,9 +6,26 @@ type City struct {
 	ID          uint   `json:"id"`
 	CityName    string `json:"name"`
 	Translation string `json:"translation"`
+	RegionID    *uint  `json:"regionId,omitempty"`
+	AdvertsNum  uint   `json:"advertsNum"`
 }
 
+type Region struct {
+	ID          uint    `json:"id"`
+	Name        string  `json:"name"`
+	Translation string  `json:"translation"`
+	Cities      []*City `json:"cities"`
+}
+
+// CityList - справочник городов: CityItems отсортированы по числу активных объявлений,
+// Regions группируют те же города по регионам.
 type CityList struct {
 	CityItems []*City
+	Regions   []*Region `json:"regions"`
 	Mux       sync.RWMutex
 }
+
+type CitySuggestions struct {
+	Query  string  `json:"query"`
+	Cities []*City `json:"cities"`
+}
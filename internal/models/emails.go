@@ -0,0 +1,19 @@
+package models
+
+// EmailPreferences - какие письма шлём пользователю. Приветственное письмо отправляется всегда.
+type EmailPreferences struct {
+	Orders   bool `json:"orders"`
+	Payments bool `json:"payments"`
+}
+
+func DefaultEmailPreferences() EmailPreferences {
+	return EmailPreferences{
+		Orders:   true,
+		Payments: true,
+	}
+}
+
+type EmailRecipient struct {
+	Email       string
+	Preferences EmailPreferences
+}
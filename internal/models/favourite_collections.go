@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+type FavouriteCollection struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	AdvertsNum  uint      `json:"advertsNum"`
+	ShareToken  *string   `json:"shareToken,omitempty"`
+	CreatedTime time.Time `json:"created"`
+}
+
+type FavouriteItem struct {
+	Advert       *ReturningAdInList `json:"advert"`
+	CollectionID *uint              `json:"collectionId"`
+	Note         string             `json:"note,omitempty"`
+}
+
+type FavouriteCollectionView struct {
+	Collection FavouriteCollection `json:"collection"`
+	Items      []*FavouriteItem    `json:"items"`
+}
+
+type ReceivedFavouriteCollection struct {
+	Name string `json:"name"`
+}
+
+// ReceivedFavouriteMove кладёт объявление в подборку (и в избранное, если его там не было);
+// пустой CollectionID убирает объявление из подборок, оставляя в избранном.
+type ReceivedFavouriteMove struct {
+	AdvertID     uint  `json:"advertId"`
+	CollectionID *uint `json:"collectionId"`
+}
+
+type ReceivedFavouriteNote struct {
+	AdvertID uint   `json:"advertId"`
+	Note     string `json:"note"`
+}
+
+type ReceivedCollectionShare struct {
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+const (
+	ChatEventMessage = "message"
+	ChatEventRead    = "read"
+	ChatEventPing    = "ping"
+)
+
+type Conversation struct {
+	ID          uint      `json:"id"`
+	AdvertID    uint      `json:"advertId"`
+	AdvertTitle string    `json:"advertTitle"`
+	BuyerID     uint      `json:"buyerId"`
+	SellerID    uint      `json:"sellerId"`
+	LastMessage *Message  `json:"lastMessage,omitempty"`
+	UnreadCount uint      `json:"unreadCount"`
+	CreatedTime time.Time `json:"created"`
+	UpdatedTime time.Time `json:"updated"`
+}
+
+type Message struct {
+	ID             uint       `json:"id"`
+	ConversationID uint       `json:"conversationId"`
+	SenderID       uint       `json:"senderId"`
+	RecipientID    uint       `json:"recipientId"`
+	Text           string     `json:"text"`
+	CreatedTime    time.Time  `json:"created"`
+	ReadTime       *time.Time `json:"readTime,omitempty"`
+}
+
+type ReceivedConversation struct {
+	AdvertID uint `json:"advertId"`
+}
+
+type ReceivedMessage struct {
+	Text string `json:"text"`
+}
+
+type ReceivedMessagesRead struct {
+	// LastMessageID - последнее сообщение, которое увидел пользователь; всё до него включительно считается прочитанным.
+	LastMessageID uint `json:"lastMessageId"`
+}
+
+type MessagesPage struct {
+	Messages []*Message `json:"messages"`
+	// NextBefore передаётся в следующий запрос как before, nil - история кончилась.
+	NextBefore *uint `json:"nextBefore,omitempty"`
+}
+
+// MessagesRead уходит отправителю, когда собеседник прочитал его сообщения.
+type MessagesRead struct {
+	ConversationID uint      `json:"conversationId"`
+	ReaderID       uint      `json:"readerId"`
+	LastMessageID  uint      `json:"lastMessageId"`
+	ReadTime       time.Time `json:"readTime"`
+}
+
+// ChatEvent - кадр, который получает клиент по вебсокету.
+type ChatEvent struct {
+	Type    string        `json:"type"`
+	Message *Message      `json:"message,omitempty"`
+	Read    *MessagesRead `json:"read,omitempty"`
+}
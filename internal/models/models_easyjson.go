@@ -452,7 +452,7 @@ func (v *WaitingForCapturePayment) UnmarshalJSON(data []byte) error {
 func (v *WaitingForCapturePayment) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels4(in *jlexer.Lexer, out *User) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels4(in *jlexer.Lexer, out *VerificationRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -473,8 +473,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels4(in *jle
 		switch key {
 		case "id":
 			out.ID = uint(in.Uint())
-		case "email":
-			out.Email = string(in.String())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "businessName":
+			out.BusinessName = string(in.String())
+		case "taxId":
+			out.TaxID = string(in.String())
+		case "businessAddress":
+			out.BusinessAddress = string(in.String())
+		case "documents":
+			if in.IsNull() {
+				in.Skip()
+				out.Documents = nil
+			} else {
+				in.Delim('[')
+				if out.Documents == nil {
+					if !in.IsDelim(']') {
+						out.Documents = make([]string, 0, 4)
+					} else {
+						out.Documents = []string{}
+					}
+				} else {
+					out.Documents = (out.Documents)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Documents = append(out.Documents, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "status":
+			out.Status = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedTime).UnmarshalJSON(data))
+			}
+		case "decided":
+			if in.IsNull() {
+				in.Skip()
+				out.DecidedTime = nil
+			} else {
+				if out.DecidedTime == nil {
+					out.DecidedTime = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DecidedTime).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -485,7 +534,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels4(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels4(out *jwriter.Writer, in User) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels4(out *jwriter.Writer, in VerificationRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -495,37 +544,88 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels4(out *jw
 		out.Uint(uint(in.ID))
 	}
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"userId\":"
 		out.RawString(prefix)
-		out.String(string(in.Email))
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"businessName\":"
+		out.RawString(prefix)
+		out.String(string(in.BusinessName))
+	}
+	{
+		const prefix string = ",\"taxId\":"
+		out.RawString(prefix)
+		out.String(string(in.TaxID))
+	}
+	{
+		const prefix string = ",\"businessAddress\":"
+		out.RawString(prefix)
+		out.String(string(in.BusinessAddress))
+	}
+	{
+		const prefix string = ",\"documents\":"
+		out.RawString(prefix)
+		if in.Documents == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Documents {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedTime).MarshalJSON())
+	}
+	if in.DecidedTime != nil {
+		const prefix string = ",\"decided\":"
+		out.RawString(prefix)
+		out.Raw((*in.DecidedTime).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v User) MarshalJSON() ([]byte, error) {
+func (v VerificationRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VerificationRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *User) UnmarshalJSON(data []byte) error {
+func (v *VerificationRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VerificationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels5(in *jlexer.Lexer, out *UnauthorizedUser) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels5(in *jlexer.Lexer, out *User) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -544,12 +644,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels5(in *jle
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
 		case "email":
 			out.Email = string(in.String())
-		case "password":
-			out.Password = string(in.String())
-		case "passwordRepeat":
-			out.PasswordRepeat = string(in.String())
+		case "emailConfirmed":
+			out.EmailConfirmed = bool(in.Bool())
+		case "pendingEmail":
+			out.PendingEmail = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -560,52 +662,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels5(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels5(out *jwriter.Writer, in UnauthorizedUser) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels5(out *jwriter.Writer, in User) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
+		out.Uint(uint(in.ID))
 	}
 	{
-		const prefix string = ",\"password\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"passwordRepeat\":"
+		const prefix string = ",\"emailConfirmed\":"
 		out.RawString(prefix)
-		out.String(string(in.PasswordRepeat))
+		out.Bool(bool(in.EmailConfirmed))
+	}
+	if in.PendingEmail != "" {
+		const prefix string = ",\"pendingEmail\":"
+		out.RawString(prefix)
+		out.String(string(in.PendingEmail))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UnauthorizedUser) MarshalJSON() ([]byte, error) {
+func (v User) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UnauthorizedUser) MarshalEasyJSON(w *jwriter.Writer) {
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UnauthorizedUser) UnmarshalJSON(data []byte) error {
+func (v *User) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UnauthorizedUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels6(in *jlexer.Lexer, out *ThreeDSecure) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels6(in *jlexer.Lexer, out *UploadError) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -624,14 +731,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels6(in *jle
 			continue
 		}
 		switch key {
-		case "applied":
-			out.Applied = bool(in.Bool())
-		case "protocol":
-			out.Protocol = string(in.String())
-		case "method_completed":
-			out.MethodCompleted = bool(in.Bool())
-		case "challenge_completed":
-			out.ChallengeCompleted = bool(in.Bool())
+		case "field":
+			out.Field = string(in.String())
+		case "file":
+			out.File = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		case "message":
+			out.Message = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -642,57 +749,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels6(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels6(out *jwriter.Writer, in ThreeDSecure) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels6(out *jwriter.Writer, in UploadError) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"applied\":"
+		const prefix string = ",\"field\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Applied))
+		out.String(string(in.Field))
 	}
-	{
-		const prefix string = ",\"protocol\":"
+	if in.File != "" {
+		const prefix string = ",\"file\":"
 		out.RawString(prefix)
-		out.String(string(in.Protocol))
+		out.String(string(in.File))
 	}
 	{
-		const prefix string = ",\"method_completed\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.MethodCompleted))
+		out.String(string(in.Code))
 	}
 	{
-		const prefix string = ",\"challenge_completed\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.ChallengeCompleted))
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ThreeDSecure) MarshalJSON() ([]byte, error) {
+func (v UploadError) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ThreeDSecure) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UploadError) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ThreeDSecure) UnmarshalJSON(data []byte) error {
+func (v *UploadError) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ThreeDSecure) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UploadError) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels7(in *jlexer.Lexer, out *SurveyResults) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels7(in *jlexer.Lexer, out *UnavailableCartItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -711,41 +818,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels7(in *jle
 			continue
 		}
 		switch key {
-		case "surveyTitle":
-			out.SurveyTitle = string(in.String())
-		case "surveyDescription":
-			out.SurveyDescription = string(in.String())
-		case "results":
-			if in.IsNull() {
-				in.Skip()
-				out.Results = nil
-			} else {
-				in.Delim('[')
-				if out.Results == nil {
-					if !in.IsDelim(']') {
-						out.Results = make([]*QuestionResults, 0, 8)
-					} else {
-						out.Results = []*QuestionResults{}
-					}
-				} else {
-					out.Results = (out.Results)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v3 *QuestionResults
-					if in.IsNull() {
-						in.Skip()
-						v3 = nil
-					} else {
-						if v3 == nil {
-							v3 = new(QuestionResults)
-						}
-						(*v3).UnmarshalEasyJSON(in)
-					}
-					out.Results = append(out.Results, v3)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "title":
+			out.Title = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -756,67 +834,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels7(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels7(out *jwriter.Writer, in SurveyResults) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels7(out *jwriter.Writer, in UnavailableCartItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"surveyTitle\":"
+		const prefix string = ",\"advertId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.SurveyTitle))
+		out.Uint(uint(in.AdvertID))
 	}
 	{
-		const prefix string = ",\"surveyDescription\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		out.String(string(in.SurveyDescription))
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"reason\":"
 		out.RawString(prefix)
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v4, v5 := range in.Results {
-				if v4 > 0 {
-					out.RawByte(',')
-				}
-				if v5 == nil {
-					out.RawString("null")
-				} else {
-					(*v5).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Reason))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SurveyResults) MarshalJSON() ([]byte, error) {
+func (v UnavailableCartItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SurveyResults) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UnavailableCartItem) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SurveyResults) UnmarshalJSON(data []byte) error {
+func (v *UnavailableCartItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SurveyResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UnavailableCartItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels7(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels8(in *jlexer.Lexer, out *SurveyCheckResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels8(in *jlexer.Lexer, out *UnauthorizedUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -835,8 +898,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels8(in *jle
 			continue
 		}
 		switch key {
-		case "isChecked":
-			out.IsChecked = bool(in.Bool())
+		case "email":
+			out.Email = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "passwordRepeat":
+			out.PasswordRepeat = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -847,42 +914,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels8(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels8(out *jwriter.Writer, in SurveyCheckResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels8(out *jwriter.Writer, in UnauthorizedUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"isChecked\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsChecked))
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"passwordRepeat\":"
+		out.RawString(prefix)
+		out.String(string(in.PasswordRepeat))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SurveyCheckResponse) MarshalJSON() ([]byte, error) {
+func (v UnauthorizedUser) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SurveyCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UnauthorizedUser) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SurveyCheckResponse) UnmarshalJSON(data []byte) error {
+func (v *UnauthorizedUser) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SurveyCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UnauthorizedUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels8(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels9(in *jlexer.Lexer, out *SurveyAnswersList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels9(in *jlexer.Lexer, out *TrendingSearches) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -901,37 +978,27 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels9(in *jle
 			continue
 		}
 		switch key {
-		case "userId":
-			out.UserID = uint(in.Uint())
-		case "surveyId":
-			out.SurveyID = uint(in.Uint())
-		case "survey":
+		case "city":
+			out.City = string(in.String())
+		case "queries":
 			if in.IsNull() {
 				in.Skip()
-				out.Survey = nil
+				out.Queries = nil
 			} else {
 				in.Delim('[')
-				if out.Survey == nil {
+				if out.Queries == nil {
 					if !in.IsDelim(']') {
-						out.Survey = make([]*SurveyAnswer, 0, 8)
+						out.Queries = make([]string, 0, 4)
 					} else {
-						out.Survey = []*SurveyAnswer{}
+						out.Queries = []string{}
 					}
 				} else {
-					out.Survey = (out.Survey)[:0]
+					out.Queries = (out.Queries)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v6 *SurveyAnswer
-					if in.IsNull() {
-						in.Skip()
-						v6 = nil
-					} else {
-						if v6 == nil {
-							v6 = new(SurveyAnswer)
-						}
-						(*v6).UnmarshalEasyJSON(in)
-					}
-					out.Survey = append(out.Survey, v6)
+					var v6 string
+					v6 = string(in.String())
+					out.Queries = append(out.Queries, v6)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -946,36 +1013,27 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels9(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels9(out *jwriter.Writer, in SurveyAnswersList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels9(out *jwriter.Writer, in TrendingSearches) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"userId\":"
+		const prefix string = ",\"city\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.UserID))
-	}
-	{
-		const prefix string = ",\"surveyId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.SurveyID))
+		out.String(string(in.City))
 	}
 	{
-		const prefix string = ",\"survey\":"
+		const prefix string = ",\"queries\":"
 		out.RawString(prefix)
-		if in.Survey == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v7, v8 := range in.Survey {
+			for v7, v8 := range in.Queries {
 				if v7 > 0 {
 					out.RawByte(',')
 				}
-				if v8 == nil {
-					out.RawString("null")
-				} else {
-					(*v8).MarshalEasyJSON(out)
-				}
+				out.String(string(v8))
 			}
 			out.RawByte(']')
 		}
@@ -984,29 +1042,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels9(out *jw
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SurveyAnswersList) MarshalJSON() ([]byte, error) {
+func (v TrendingSearches) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SurveyAnswersList) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrendingSearches) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SurveyAnswersList) UnmarshalJSON(data []byte) error {
+func (v *TrendingSearches) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SurveyAnswersList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrendingSearches) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels9(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels10(in *jlexer.Lexer, out *SurveyAnswer) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels10(in *jlexer.Lexer, out *TrendingQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1025,10 +1083,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels10(in *jl
 			continue
 		}
 		switch key {
-		case "answerNum":
-			out.AnswerNum = uint(in.Uint())
-		case "answerValue":
-			out.AnswerValue = uint(in.Uint())
+		case "city":
+			out.City = string(in.String())
+		case "query":
+			out.Query = string(in.String())
+		case "frequency":
+			out.Frequency = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -1039,47 +1099,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels10(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels10(out *jwriter.Writer, in SurveyAnswer) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels10(out *jwriter.Writer, in TrendingQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"answerNum\":"
+		const prefix string = ",\"city\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.AnswerNum))
+		out.String(string(in.City))
 	}
 	{
-		const prefix string = ",\"answerValue\":"
+		const prefix string = ",\"query\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.AnswerValue))
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"frequency\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Frequency))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SurveyAnswer) MarshalJSON() ([]byte, error) {
+func (v TrendingQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SurveyAnswer) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrendingQuery) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SurveyAnswer) UnmarshalJSON(data []byte) error {
+func (v *TrendingQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SurveyAnswer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrendingQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels10(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels11(in *jlexer.Lexer, out *Survey) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels11(in *jlexer.Lexer, out *ThreeDSecure) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1098,12 +1163,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels11(in *jl
 			continue
 		}
 		switch key {
-		case "surveyTitle":
-			out.SurveyTitle = string(in.String())
-		case "surveyDescription":
-			out.SurveyDescription = string(in.String())
-		case "questionNumber":
-			out.QuestionNumber = uint(in.Uint())
+		case "applied":
+			out.Applied = bool(in.Bool())
+		case "protocol":
+			out.Protocol = string(in.String())
+		case "method_completed":
+			out.MethodCompleted = bool(in.Bool())
+		case "challenge_completed":
+			out.ChallengeCompleted = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1114,52 +1181,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels11(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels11(out *jwriter.Writer, in Survey) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels11(out *jwriter.Writer, in ThreeDSecure) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"surveyTitle\":"
+		const prefix string = ",\"applied\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.SurveyTitle))
+		out.Bool(bool(in.Applied))
 	}
 	{
-		const prefix string = ",\"surveyDescription\":"
+		const prefix string = ",\"protocol\":"
 		out.RawString(prefix)
-		out.String(string(in.SurveyDescription))
+		out.String(string(in.Protocol))
 	}
 	{
-		const prefix string = ",\"questionNumber\":"
+		const prefix string = ",\"method_completed\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.QuestionNumber))
+		out.Bool(bool(in.MethodCompleted))
+	}
+	{
+		const prefix string = ",\"challenge_completed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ChallengeCompleted))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Survey) MarshalJSON() ([]byte, error) {
+func (v ThreeDSecure) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Survey) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ThreeDSecure) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Survey) UnmarshalJSON(data []byte) error {
+func (v *ThreeDSecure) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Survey) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ThreeDSecure) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels11(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels12(in *jlexer.Lexer, out *Status) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels12(in *jlexer.Lexer, out *SurveyResults) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1178,10 +1250,41 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels12(in *jl
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint(in.Uint())
-		case "name":
-			out.Name = string(in.String())
+		case "surveyTitle":
+			out.SurveyTitle = string(in.String())
+		case "surveyDescription":
+			out.SurveyDescription = string(in.String())
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]*QuestionResults, 0, 8)
+					} else {
+						out.Results = []*QuestionResults{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 *QuestionResults
+					if in.IsNull() {
+						in.Skip()
+						v9 = nil
+					} else {
+						if v9 == nil {
+							v9 = new(QuestionResults)
+						}
+						(*v9).UnmarshalEasyJSON(in)
+					}
+					out.Results = append(out.Results, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1192,47 +1295,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels12(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels12(out *jwriter.Writer, in Status) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels12(out *jwriter.Writer, in SurveyResults) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"surveyTitle\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
+		out.String(string(in.SurveyTitle))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"surveyDescription\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.SurveyDescription))
+	}
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix)
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.Results {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				if v11 == nil {
+					out.RawString("null")
+				} else {
+					(*v11).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Status) MarshalJSON() ([]byte, error) {
+func (v SurveyResults) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Status) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SurveyResults) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Status) UnmarshalJSON(data []byte) error {
+func (v *SurveyResults) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Status) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SurveyResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels12(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels13(in *jlexer.Lexer, out *SetProfileRatingNec) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels13(in *jlexer.Lexer, out *SurveyCheckResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1251,8 +1374,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels13(in *jl
 			continue
 		}
 		switch key {
-		case "reaction":
-			out.Reaction = float64(in.Float64())
+		case "isChecked":
+			out.IsChecked = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1263,42 +1386,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels13(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels13(out *jwriter.Writer, in SetProfileRatingNec) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels13(out *jwriter.Writer, in SurveyCheckResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"reaction\":"
+		const prefix string = ",\"isChecked\":"
 		out.RawString(prefix[1:])
-		out.Float64(float64(in.Reaction))
+		out.Bool(bool(in.IsChecked))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetProfileRatingNec) MarshalJSON() ([]byte, error) {
+func (v SurveyCheckResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetProfileRatingNec) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SurveyCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetProfileRatingNec) UnmarshalJSON(data []byte) error {
+func (v *SurveyCheckResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetProfileRatingNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SurveyCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels13(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels14(in *jlexer.Lexer, out *SetProfilePhoneNec) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels14(in *jlexer.Lexer, out *SurveyAnswersList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1317,8 +1440,41 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels14(in *jl
 			continue
 		}
 		switch key {
-		case "phone":
-			out.Phone = string(in.String())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "surveyId":
+			out.SurveyID = uint(in.Uint())
+		case "survey":
+			if in.IsNull() {
+				in.Skip()
+				out.Survey = nil
+			} else {
+				in.Delim('[')
+				if out.Survey == nil {
+					if !in.IsDelim(']') {
+						out.Survey = make([]*SurveyAnswer, 0, 8)
+					} else {
+						out.Survey = []*SurveyAnswer{}
+					}
+				} else {
+					out.Survey = (out.Survey)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 *SurveyAnswer
+					if in.IsNull() {
+						in.Skip()
+						v12 = nil
+					} else {
+						if v12 == nil {
+							v12 = new(SurveyAnswer)
+						}
+						(*v12).UnmarshalEasyJSON(in)
+					}
+					out.Survey = append(out.Survey, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1329,42 +1485,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels14(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels14(out *jwriter.Writer, in SetProfilePhoneNec) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels14(out *jwriter.Writer, in SurveyAnswersList) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"phone\":"
+		const prefix string = ",\"userId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Phone))
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"surveyId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.SurveyID))
+	}
+	{
+		const prefix string = ",\"survey\":"
+		out.RawString(prefix)
+		if in.Survey == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v13, v14 := range in.Survey {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				if v14 == nil {
+					out.RawString("null")
+				} else {
+					(*v14).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetProfilePhoneNec) MarshalJSON() ([]byte, error) {
+func (v SurveyAnswersList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetProfilePhoneNec) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SurveyAnswersList) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetProfilePhoneNec) UnmarshalJSON(data []byte) error {
+func (v *SurveyAnswersList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetProfilePhoneNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SurveyAnswersList) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels14(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels15(in *jlexer.Lexer, out *SetProfileNec) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels15(in *jlexer.Lexer, out *SurveyAnswer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1383,12 +1564,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels15(in *jl
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
-		case "avatar":
-			(out.Avatar).UnmarshalEasyJSON(in)
+		case "answerNum":
+			out.AnswerNum = uint(in.Uint())
+		case "answerValue":
+			out.AnswerValue = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -1399,52 +1578,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels15(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels15(out *jwriter.Writer, in SetProfileNec) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels15(out *jwriter.Writer, in SurveyAnswer) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"answerNum\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"surname\":"
-		out.RawString(prefix)
-		out.String(string(in.Surname))
+		out.Uint(uint(in.AnswerNum))
 	}
 	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"answerValue\":"
 		out.RawString(prefix)
-		(in.Avatar).MarshalEasyJSON(out)
+		out.Uint(uint(in.AnswerValue))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetProfileNec) MarshalJSON() ([]byte, error) {
+func (v SurveyAnswer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetProfileNec) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SurveyAnswer) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetProfileNec) UnmarshalJSON(data []byte) error {
+func (v *SurveyAnswer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetProfileNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SurveyAnswer) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels15(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels16(in *jlexer.Lexer, out *SetProfileCityNec) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels16(in *jlexer.Lexer, out *Survey) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1463,8 +1637,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels16(in *jl
 			continue
 		}
 		switch key {
-		case "city":
-			(out.City).UnmarshalEasyJSON(in)
+		case "surveyTitle":
+			out.SurveyTitle = string(in.String())
+		case "surveyDescription":
+			out.SurveyDescription = string(in.String())
+		case "questionNumber":
+			out.QuestionNumber = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -1475,42 +1653,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels16(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels16(out *jwriter.Writer, in SetProfileCityNec) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels16(out *jwriter.Writer, in Survey) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"city\":"
+		const prefix string = ",\"surveyTitle\":"
 		out.RawString(prefix[1:])
-		(in.City).MarshalEasyJSON(out)
+		out.String(string(in.SurveyTitle))
+	}
+	{
+		const prefix string = ",\"surveyDescription\":"
+		out.RawString(prefix)
+		out.String(string(in.SurveyDescription))
+	}
+	{
+		const prefix string = ",\"questionNumber\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.QuestionNumber))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetProfileCityNec) MarshalJSON() ([]byte, error) {
+func (v Survey) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetProfileCityNec) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Survey) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetProfileCityNec) UnmarshalJSON(data []byte) error {
+func (v *Survey) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetProfileCityNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Survey) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels16(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels17(in *jlexer.Lexer, out *Session) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels17(in *jlexer.Lexer, out *SubscriptionsPage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1529,10 +1717,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels17(in *jl
 			continue
 		}
 		switch key {
-		case "UserID":
-			out.UserID = uint32(in.Uint32())
-		case "Value":
-			out.Value = string(in.String())
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]*SubscriptionProfile, 0, 8)
+					} else {
+						out.Profiles = []*SubscriptionProfile{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 *SubscriptionProfile
+					if in.IsNull() {
+						in.Skip()
+						v15 = nil
+					} else {
+						if v15 == nil {
+							v15 = new(SubscriptionProfile)
+						}
+						(*v15).UnmarshalEasyJSON(in)
+					}
+					out.Profiles = append(out.Profiles, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextBefore":
+			if in.IsNull() {
+				in.Skip()
+				out.NextBefore = nil
+			} else {
+				if out.NextBefore == nil {
+					out.NextBefore = new(uint)
+				}
+				*out.NextBefore = uint(in.Uint())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1543,47 +1768,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels17(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels17(out *jwriter.Writer, in Session) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels17(out *jwriter.Writer, in SubscriptionsPage) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"UserID\":"
+		const prefix string = ",\"profiles\":"
 		out.RawString(prefix[1:])
-		out.Uint32(uint32(in.UserID))
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Profiles {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				if v17 == nil {
+					out.RawString("null")
+				} else {
+					(*v17).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"Value\":"
+	if in.NextBefore != nil {
+		const prefix string = ",\"nextBefore\":"
 		out.RawString(prefix)
-		out.String(string(in.Value))
+		out.Uint(uint(*in.NextBefore))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Session) MarshalJSON() ([]byte, error) {
+func (v SubscriptionsPage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Session) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SubscriptionsPage) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Session) UnmarshalJSON(data []byte) error {
+func (v *SubscriptionsPage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Session) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SubscriptionsPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels17(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels18(in *jlexer.Lexer, out *ReturningOrder) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels18(in *jlexer.Lexer, out *SubscriptionsFeed) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1602,10 +1842,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels18(in *jl
 			continue
 		}
 		switch key {
-		case "orderItem":
-			(out.OrderItem).UnmarshalEasyJSON(in)
-		case "advert":
-			(out.ReturningAdvert).UnmarshalEasyJSON(in)
+		case "adverts":
+			if in.IsNull() {
+				in.Skip()
+				out.Adverts = nil
+			} else {
+				in.Delim('[')
+				if out.Adverts == nil {
+					if !in.IsDelim(']') {
+						out.Adverts = make([]*ReturningAdInList, 0, 8)
+					} else {
+						out.Adverts = []*ReturningAdInList{}
+					}
+				} else {
+					out.Adverts = (out.Adverts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 *ReturningAdInList
+					if in.IsNull() {
+						in.Skip()
+						v18 = nil
+					} else {
+						if v18 == nil {
+							v18 = new(ReturningAdInList)
+						}
+						(*v18).UnmarshalEasyJSON(in)
+					}
+					out.Adverts = append(out.Adverts, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextBefore":
+			if in.IsNull() {
+				in.Skip()
+				out.NextBefore = nil
+			} else {
+				if out.NextBefore == nil {
+					out.NextBefore = new(uint)
+				}
+				*out.NextBefore = uint(in.Uint())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1616,47 +1893,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels18(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels18(out *jwriter.Writer, in ReturningOrder) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels18(out *jwriter.Writer, in SubscriptionsFeed) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"orderItem\":"
+		const prefix string = ",\"adverts\":"
 		out.RawString(prefix[1:])
-		(in.OrderItem).MarshalEasyJSON(out)
+		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.Adverts {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				if v20 == nil {
+					out.RawString("null")
+				} else {
+					(*v20).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"advert\":"
+	if in.NextBefore != nil {
+		const prefix string = ",\"nextBefore\":"
 		out.RawString(prefix)
-		(in.ReturningAdvert).MarshalEasyJSON(out)
+		out.Uint(uint(*in.NextBefore))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReturningOrder) MarshalJSON() ([]byte, error) {
+func (v SubscriptionsFeed) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReturningOrder) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SubscriptionsFeed) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReturningOrder) UnmarshalJSON(data []byte) error {
+func (v *SubscriptionsFeed) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReturningOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SubscriptionsFeed) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels18(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels19(in *jlexer.Lexer, out *ReturningAdvertList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels19(in *jlexer.Lexer, out *SubscriptionProfile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1675,39 +1967,22 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels19(in *jl
 			continue
 		}
 		switch key {
-		case "AdvertItems":
-			if in.IsNull() {
-				in.Skip()
-				out.AdvertItems = nil
-			} else {
-				in.Delim('[')
-				if out.AdvertItems == nil {
-					if !in.IsDelim(']') {
-						out.AdvertItems = make([]*ReturningAdvert, 0, 8)
-					} else {
-						out.AdvertItems = []*ReturningAdvert{}
-					}
-				} else {
-					out.AdvertItems = (out.AdvertItems)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v9 *ReturningAdvert
-					if in.IsNull() {
-						in.Skip()
-						v9 = nil
-					} else {
-						if v9 == nil {
-							v9 = new(ReturningAdvert)
-						}
-						(*v9).UnmarshalEasyJSON(in)
-					}
-					out.AdvertItems = append(out.AdvertItems, v9)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "id":
+			out.UserID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "avatarImg":
+			out.AvatarIMG = string(in.String())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "since":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Since).UnmarshalJSON(data))
 			}
-		case "Mux":
-			easyjsonD2b7633eDecodeSync(in, &out.Mux)
 		default:
 			in.SkipRecursive()
 		}
@@ -1718,62 +1993,77 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels19(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels19(out *jwriter.Writer, in ReturningAdvertList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels19(out *jwriter.Writer, in SubscriptionProfile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"AdvertItems\":"
-		out.RawString(prefix[1:])
-		if in.AdvertItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v10, v11 := range in.AdvertItems {
-				if v10 > 0 {
-					out.RawByte(',')
-				}
-				if v11 == nil {
-					out.RawString("null")
-				} else {
-					(*v11).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
 		}
+		out.Uint(uint(in.UserID))
 	}
 	{
-		const prefix string = ",\"Mux\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		easyjsonD2b7633eEncodeSync(out, in.Mux)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		out.String(string(in.Surname))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"avatarImg\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarIMG))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	{
+		const prefix string = ",\"since\":"
+		out.RawString(prefix)
+		out.Raw((in.Since).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReturningAdvertList) MarshalJSON() ([]byte, error) {
+func (v SubscriptionProfile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReturningAdvertList) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SubscriptionProfile) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReturningAdvertList) UnmarshalJSON(data []byte) error {
+func (v *SubscriptionProfile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReturningAdvertList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SubscriptionProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels19(l, v)
 }
-func easyjsonD2b7633eDecodeSync(in *jlexer.Lexer, out *sync.RWMutex) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(in *jlexer.Lexer, out *Status) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1792,6 +2082,10 @@ func easyjsonD2b7633eDecodeSync(in *jlexer.Lexer, out *sync.RWMutex) {
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1802,13 +2096,47 @@ func easyjsonD2b7633eDecodeSync(in *jlexer.Lexer, out *sync.RWMutex) {
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeSync(out *jwriter.Writer, in sync.RWMutex) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(out *jwriter.Writer, in Status) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
 	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(in *jlexer.Lexer, out *ReturningAdvert) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v Status) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Status) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Status) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Status) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(in *jlexer.Lexer, out *SetProfileRatingNec) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1827,60 +2155,4936 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(in *jl
 			continue
 		}
 		switch key {
-		case "advert":
-			(out.Advert).UnmarshalEasyJSON(in)
-		case "promotion":
-			(out.Promotion).UnmarshalEasyJSON(in)
-		case "city":
-			(out.City).UnmarshalEasyJSON(in)
-		case "category":
-			(out.Category).UnmarshalEasyJSON(in)
-		case "photos":
-			if in.IsNull() {
-				in.Skip()
-				out.Photos = nil
-			} else {
-				in.Delim('[')
-				if out.Photos == nil {
-					if !in.IsDelim(']') {
+		case "reaction":
+			out.Reaction = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels21(out *jwriter.Writer, in SetProfileRatingNec) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reaction\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Reaction))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetProfileRatingNec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetProfileRatingNec) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetProfileRatingNec) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetProfileRatingNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(in *jlexer.Lexer, out *SetProfilePhoneNec) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "phone":
+			out.Phone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels22(out *jwriter.Writer, in SetProfilePhoneNec) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Phone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetProfilePhoneNec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetProfilePhoneNec) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetProfilePhoneNec) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetProfilePhoneNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(in *jlexer.Lexer, out *SetProfileNec) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
+		case "avatar":
+			(out.Avatar).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels23(out *jwriter.Writer, in SetProfileNec) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		out.String(string(in.Surname))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		(in.Avatar).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetProfileNec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetProfileNec) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetProfileNec) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetProfileNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(in *jlexer.Lexer, out *SetProfileCityNec) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "city":
+			(out.City).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(out *jwriter.Writer, in SetProfileCityNec) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix[1:])
+		(in.City).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetProfileCityNec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetProfileCityNec) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetProfileCityNec) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetProfileCityNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(in *jlexer.Lexer, out *SessionClient) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "IP":
+			out.IP = string(in.String())
+		case "UserAgent":
+			out.UserAgent = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels25(out *jwriter.Writer, in SessionClient) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"IP\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"UserAgent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionClient) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionClient) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionClient) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionClient) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(in *jlexer.Lexer, out *Session) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserID":
+			out.UserID = uint32(in.Uint32())
+		case "Value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels26(out *jwriter.Writer, in Session) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.UserID))
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Session) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Session) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Session) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Session) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(in *jlexer.Lexer, out *SearchQueryStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "frequency":
+			out.Frequency = uint(in.Uint())
+		case "results":
+			out.Results = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels27(out *jwriter.Writer, in SearchQueryStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"frequency\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Frequency))
+	}
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Results))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchQueryStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchQueryStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchQueryStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchQueryStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(in *jlexer.Lexer, out *ReturningOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "orderItem":
+			(out.OrderItem).UnmarshalEasyJSON(in)
+		case "advert":
+			(out.ReturningAdvert).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels28(out *jwriter.Writer, in ReturningOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"orderItem\":"
+		out.RawString(prefix[1:])
+		(in.OrderItem).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"advert\":"
+		out.RawString(prefix)
+		(in.ReturningAdvert).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReturningOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReturningOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReturningOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReturningOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(in *jlexer.Lexer, out *ReturningAdvertList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "AdvertItems":
+			if in.IsNull() {
+				in.Skip()
+				out.AdvertItems = nil
+			} else {
+				in.Delim('[')
+				if out.AdvertItems == nil {
+					if !in.IsDelim(']') {
+						out.AdvertItems = make([]*ReturningAdvert, 0, 8)
+					} else {
+						out.AdvertItems = []*ReturningAdvert{}
+					}
+				} else {
+					out.AdvertItems = (out.AdvertItems)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 *ReturningAdvert
+					if in.IsNull() {
+						in.Skip()
+						v21 = nil
+					} else {
+						if v21 == nil {
+							v21 = new(ReturningAdvert)
+						}
+						(*v21).UnmarshalEasyJSON(in)
+					}
+					out.AdvertItems = append(out.AdvertItems, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Mux":
+			easyjsonD2b7633eDecodeSync(in, &out.Mux)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels29(out *jwriter.Writer, in ReturningAdvertList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"AdvertItems\":"
+		out.RawString(prefix[1:])
+		if in.AdvertItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.AdvertItems {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				if v23 == nil {
+					out.RawString("null")
+				} else {
+					(*v23).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Mux\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeSync(out, in.Mux)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReturningAdvertList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReturningAdvertList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReturningAdvertList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReturningAdvertList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(l, v)
+}
+func easyjsonD2b7633eDecodeSync(in *jlexer.Lexer, out *sync.RWMutex) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeSync(out *jwriter.Writer, in sync.RWMutex) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(in *jlexer.Lexer, out *ReturningAdvert) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advert":
+			(out.Advert).UnmarshalEasyJSON(in)
+		case "promotion":
+			(out.Promotion).UnmarshalEasyJSON(in)
+		case "city":
+			(out.City).UnmarshalEasyJSON(in)
+		case "category":
+			(out.Category).UnmarshalEasyJSON(in)
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 string
+					v24 = string(in.String())
+					out.Photos = append(out.Photos, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photosIMG":
+			if in.IsNull() {
+				in.Skip()
+				out.PhotosIMG = nil
+			} else {
+				in.Delim('[')
+				if out.PhotosIMG == nil {
+					if !in.IsDelim(']') {
+						out.PhotosIMG = make([]string, 0, 4)
+					} else {
+						out.PhotosIMG = []string{}
+					}
+				} else {
+					out.PhotosIMG = (out.PhotosIMG)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.PhotosIMG = append(out.PhotosIMG, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "moderation":
+			if in.IsNull() {
+				in.Skip()
+				out.Moderation = nil
+			} else {
+				if out.Moderation == nil {
+					out.Moderation = new(ModerationVerdict)
+				}
+				(*out.Moderation).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels30(out *jwriter.Writer, in ReturningAdvert) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advert\":"
+		out.RawString(prefix[1:])
+		(in.Advert).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"promotion\":"
+		out.RawString(prefix)
+		(in.Promotion).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		(in.City).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		(in.Category).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Photos {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photosIMG\":"
+		out.RawString(prefix)
+		if in.PhotosIMG == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v28, v29 := range in.PhotosIMG {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v29))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Moderation != nil {
+		const prefix string = ",\"moderation\":"
+		out.RawString(prefix)
+		(*in.Moderation).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReturningAdvert) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReturningAdvert) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReturningAdvert) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReturningAdvert) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels31(in *jlexer.Lexer, out *ReturningAdInList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "title":
+			out.Title = string(in.String())
+		case "price":
+			out.Price = uint(in.Uint())
+		case "city":
+			out.City = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
 						out.Photos = make([]string, 0, 4)
 					} else {
-						out.Photos = []string{}
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 string
+					v30 = string(in.String())
+					out.Photos = append(out.Photos, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photosIMG":
+			if in.IsNull() {
+				in.Skip()
+				out.PhotosIMG = nil
+			} else {
+				in.Delim('[')
+				if out.PhotosIMG == nil {
+					if !in.IsDelim(']') {
+						out.PhotosIMG = make([]string, 0, 4)
+					} else {
+						out.PhotosIMG = []string{}
+					}
+				} else {
+					out.PhotosIMG = (out.PhotosIMG)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 string
+					v31 = string(in.String())
+					out.PhotosIMG = append(out.PhotosIMG, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "inFavourites":
+			out.InFavourites = bool(in.Bool())
+		case "inCart":
+			out.InCart = bool(in.Bool())
+		case "isPromoted":
+			out.IsPromoted = bool(in.Bool())
+		case "isActive":
+			out.IsActive = bool(in.Bool())
+		case "sellerVerified":
+			out.SellerVerified = bool(in.Bool())
+		case "reserved":
+			out.Reserved = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels31(out *jwriter.Writer, in ReturningAdInList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Price))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Photos {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v33))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photosIMG\":"
+		out.RawString(prefix)
+		if in.PhotosIMG == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v34, v35 := range in.PhotosIMG {
+				if v34 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v35))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"inFavourites\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InFavourites))
+	}
+	{
+		const prefix string = ",\"inCart\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InCart))
+	}
+	{
+		const prefix string = ",\"isPromoted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPromoted))
+	}
+	{
+		const prefix string = ",\"isActive\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsActive))
+	}
+	{
+		const prefix string = ",\"sellerVerified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SellerVerified))
+	}
+	{
+		const prefix string = ",\"reserved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reserved))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReturningAdInList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReturningAdInList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReturningAdInList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReturningAdInList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels32(in *jlexer.Lexer, out *Reservation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "reservedUntil":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReservedUntil).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels32(out *jwriter.Writer, in Reservation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"reservedUntil\":"
+		out.RawString(prefix)
+		out.Raw((in.ReservedUntil).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Reservation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Reservation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Reservation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Reservation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels32(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(in *jlexer.Lexer, out *Region) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "translation":
+			out.Translation = string(in.String())
+		case "cities":
+			if in.IsNull() {
+				in.Skip()
+				out.Cities = nil
+			} else {
+				in.Delim('[')
+				if out.Cities == nil {
+					if !in.IsDelim(']') {
+						out.Cities = make([]*City, 0, 8)
+					} else {
+						out.Cities = []*City{}
+					}
+				} else {
+					out.Cities = (out.Cities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v36 *City
+					if in.IsNull() {
+						in.Skip()
+						v36 = nil
+					} else {
+						if v36 == nil {
+							v36 = new(City)
+						}
+						(*v36).UnmarshalEasyJSON(in)
+					}
+					out.Cities = append(out.Cities, v36)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels33(out *jwriter.Writer, in Region) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"translation\":"
+		out.RawString(prefix)
+		out.String(string(in.Translation))
+	}
+	{
+		const prefix string = ",\"cities\":"
+		out.RawString(prefix)
+		if in.Cities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Cities {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				if v38 == nil {
+					out.RawString("null")
+				} else {
+					(*v38).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Region) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Region) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Region) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Region) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(in *jlexer.Lexer, out *Recipient) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "account_id":
+			out.AccountID = string(in.String())
+		case "gateway_id":
+			out.GatewayID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels34(out *jwriter.Writer, in Recipient) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"account_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccountID))
+	}
+	{
+		const prefix string = ",\"gateway_id\":"
+		out.RawString(prefix)
+		out.String(string(in.GatewayID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Recipient) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Recipient) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Recipient) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Recipient) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(in *jlexer.Lexer, out *ReceivedVerificationRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "businessName":
+			out.BusinessName = string(in.String())
+		case "taxId":
+			out.TaxID = string(in.String())
+		case "businessAddress":
+			out.BusinessAddress = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels35(out *jwriter.Writer, in ReceivedVerificationRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"businessName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.BusinessName))
+	}
+	{
+		const prefix string = ",\"taxId\":"
+		out.RawString(prefix)
+		out.String(string(in.TaxID))
+	}
+	{
+		const prefix string = ",\"businessAddress\":"
+		out.RawString(prefix)
+		out.String(string(in.BusinessAddress))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedVerificationRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedVerificationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedVerificationRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedVerificationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(in *jlexer.Lexer, out *ReceivedVerificationDecision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "requestId":
+			out.RequestID = uint(in.Uint())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(out *jwriter.Writer, in ReceivedVerificationDecision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"requestId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.RequestID))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedVerificationDecision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedVerificationDecision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedVerificationDecision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedVerificationDecision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(in *jlexer.Lexer, out *ReceivedSessionRevoke) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels37(out *jwriter.Writer, in ReceivedSessionRevoke) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedSessionRevoke) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedSessionRevoke) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedSessionRevoke) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedSessionRevoke) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(in *jlexer.Lexer, out *ReceivedReservation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.AdvertIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AdvertIDs == nil {
+					if !in.IsDelim(']') {
+						out.AdvertIDs = make([]uint, 0, 8)
+					} else {
+						out.AdvertIDs = []uint{}
+					}
+				} else {
+					out.AdvertIDs = (out.AdvertIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 uint
+					v39 = uint(in.Uint())
+					out.AdvertIDs = append(out.AdvertIDs, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels38(out *jwriter.Writer, in ReceivedReservation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertIDs\":"
+		out.RawString(prefix[1:])
+		if in.AdvertIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v40, v41 := range in.AdvertIDs {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v41))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedReservation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedReservation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedReservation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedReservation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(in *jlexer.Lexer, out *ReceivedPhoneNumber) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "phone":
+			out.Phone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels39(out *jwriter.Writer, in ReceivedPhoneNumber) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Phone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedPhoneNumber) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedPhoneNumber) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedPhoneNumber) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedPhoneNumber) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(in *jlexer.Lexer, out *ReceivedPhoneCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels40(out *jwriter.Writer, in ReceivedPhoneCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedPhoneCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedPhoneCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedPhoneCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedPhoneCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(in *jlexer.Lexer, out *ReceivedPaymentFormItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "rate":
+			out.Rate = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels41(out *jwriter.Writer, in ReceivedPaymentFormItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"rate\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Rate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedPaymentFormItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedPaymentFormItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedPaymentFormItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedPaymentFormItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(in *jlexer.Lexer, out *ReceivedPasswordResetRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels42(out *jwriter.Writer, in ReceivedPasswordResetRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedPasswordResetRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedPasswordResetRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedPasswordResetRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedPasswordResetRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(in *jlexer.Lexer, out *ReceivedPasswordReset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "passwordRepeat":
+			out.PasswordRepeat = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels43(out *jwriter.Writer, in ReceivedPasswordReset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"passwordRepeat\":"
+		out.RawString(prefix)
+		out.String(string(in.PasswordRepeat))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedPasswordReset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedPasswordReset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedPasswordReset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedPasswordReset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(in *jlexer.Lexer, out *ReceivedPasswordChange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oldPassword":
+			out.OldPassword = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "passwordRepeat":
+			out.PasswordRepeat = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels44(out *jwriter.Writer, in ReceivedPasswordChange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"oldPassword\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OldPassword))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"passwordRepeat\":"
+		out.RawString(prefix)
+		out.String(string(in.PasswordRepeat))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedPasswordChange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedPasswordChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedPasswordChange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedPasswordChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(in *jlexer.Lexer, out *ReceivedOrderStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "orderId":
+			out.OrderID = uint(in.Uint())
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(out *jwriter.Writer, in ReceivedOrderStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"orderId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.OrderID))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedOrderStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedOrderStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedOrderStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedOrderStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(in *jlexer.Lexer, out *ReceivedOrderItems) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "adverts":
+			if in.IsNull() {
+				in.Skip()
+				out.Adverts = nil
+			} else {
+				in.Delim('[')
+				if out.Adverts == nil {
+					if !in.IsDelim(']') {
+						out.Adverts = make([]*ReceivedOrderItem, 0, 8)
+					} else {
+						out.Adverts = []*ReceivedOrderItem{}
+					}
+				} else {
+					out.Adverts = (out.Adverts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v42 *ReceivedOrderItem
+					if in.IsNull() {
+						in.Skip()
+						v42 = nil
+					} else {
+						if v42 == nil {
+							v42 = new(ReceivedOrderItem)
+						}
+						(*v42).UnmarshalEasyJSON(in)
+					}
+					out.Adverts = append(out.Adverts, v42)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels46(out *jwriter.Writer, in ReceivedOrderItems) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"adverts\":"
+		out.RawString(prefix[1:])
+		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v43, v44 := range in.Adverts {
+				if v43 > 0 {
+					out.RawByte(',')
+				}
+				if v44 == nil {
+					out.RawString("null")
+				} else {
+					(*v44).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedOrderItems) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedOrderItems) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedOrderItems) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedOrderItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(in *jlexer.Lexer, out *ReceivedOrderItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertID":
+			out.AdvertID = uint(in.Uint())
+		case "phone":
+			out.Phone = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "adress":
+			out.Adress = string(in.String())
+		case "deliveryMethod":
+			out.DeliveryMethod = string(in.String())
+		case "address":
+			out.DeliveryAddress = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels47(out *jwriter.Writer, in ReceivedOrderItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertID\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"adress\":"
+		out.RawString(prefix)
+		out.String(string(in.Adress))
+	}
+	{
+		const prefix string = ",\"deliveryMethod\":"
+		out.RawString(prefix)
+		out.String(string(in.DeliveryMethod))
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		out.String(string(in.DeliveryAddress))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedOrderItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedOrderItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedOrderItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedOrderItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(in *jlexer.Lexer, out *ReceivedModerationDecision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "reasons":
+			if in.IsNull() {
+				in.Skip()
+				out.Reasons = nil
+			} else {
+				in.Delim('[')
+				if out.Reasons == nil {
+					if !in.IsDelim(']') {
+						out.Reasons = make([]string, 0, 4)
+					} else {
+						out.Reasons = []string{}
+					}
+				} else {
+					out.Reasons = (out.Reasons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v45 string
+					v45 = string(in.String())
+					out.Reasons = append(out.Reasons, v45)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels48(out *jwriter.Writer, in ReceivedModerationDecision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	{
+		const prefix string = ",\"reasons\":"
+		out.RawString(prefix)
+		if in.Reasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v46, v47 := range in.Reasons {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v47))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedModerationDecision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedModerationDecision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedModerationDecision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedModerationDecision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(in *jlexer.Lexer, out *ReceivedMessagesRead) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lastMessageId":
+			out.LastMessageID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels49(out *jwriter.Writer, in ReceivedMessagesRead) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lastMessageId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.LastMessageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedMessagesRead) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedMessagesRead) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedMessagesRead) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedMessagesRead) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(in *jlexer.Lexer, out *ReceivedMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels50(out *jwriter.Writer, in ReceivedMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(in *jlexer.Lexer, out *ReceivedMerchantItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "merchantId":
+			out.MerchantID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels51(out *jwriter.Writer, in ReceivedMerchantItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"merchantId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.MerchantID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedMerchantItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedMerchantItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedMerchantItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedMerchantItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(in *jlexer.Lexer, out *ReceivedFavouriteNote) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels52(out *jwriter.Writer, in ReceivedFavouriteNote) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedFavouriteNote) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedFavouriteNote) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedFavouriteNote) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedFavouriteNote) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(in *jlexer.Lexer, out *ReceivedFavouriteMove) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "collectionId":
+			if in.IsNull() {
+				in.Skip()
+				out.CollectionID = nil
+			} else {
+				if out.CollectionID == nil {
+					out.CollectionID = new(uint)
+				}
+				*out.CollectionID = uint(in.Uint())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels53(out *jwriter.Writer, in ReceivedFavouriteMove) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"collectionId\":"
+		out.RawString(prefix)
+		if in.CollectionID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint(uint(*in.CollectionID))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedFavouriteMove) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedFavouriteMove) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedFavouriteMove) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedFavouriteMove) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(in *jlexer.Lexer, out *ReceivedFavouriteCollection) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels54(out *jwriter.Writer, in ReceivedFavouriteCollection) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedFavouriteCollection) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedFavouriteCollection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedFavouriteCollection) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedFavouriteCollection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(in *jlexer.Lexer, out *ReceivedEmailToken) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels55(out *jwriter.Writer, in ReceivedEmailToken) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedEmailToken) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedEmailToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedEmailToken) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedEmailToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(in *jlexer.Lexer, out *ReceivedConversation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels56(out *jwriter.Writer, in ReceivedConversation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedConversation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedConversation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedConversation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedConversation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(in *jlexer.Lexer, out *ReceivedCollectionShare) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels57(out *jwriter.Writer, in ReceivedCollectionShare) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedCollectionShare) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedCollectionShare) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedCollectionShare) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedCollectionShare) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(in *jlexer.Lexer, out *ReceivedCategory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "translation":
+			out.Translation = string(in.String())
+		case "parentId":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uint)
+				}
+				*out.ParentID = uint(in.Uint())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels58(out *jwriter.Writer, in ReceivedCategory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"translation\":"
+		out.RawString(prefix)
+		out.String(string(in.Translation))
+	}
+	{
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		if in.ParentID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint(uint(*in.ParentID))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedCategory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedCategory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedCategory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedCategory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(in *jlexer.Lexer, out *ReceivedCartItems) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertIDs":
+			if in.IsNull() {
+				in.Skip()
+				out.AdvertIDs = nil
+			} else {
+				in.Delim('[')
+				if out.AdvertIDs == nil {
+					if !in.IsDelim(']') {
+						out.AdvertIDs = make([]uint, 0, 8)
+					} else {
+						out.AdvertIDs = []uint{}
+					}
+				} else {
+					out.AdvertIDs = (out.AdvertIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 uint
+					v48 = uint(in.Uint())
+					out.AdvertIDs = append(out.AdvertIDs, v48)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels59(out *jwriter.Writer, in ReceivedCartItems) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertIDs\":"
+		out.RawString(prefix[1:])
+		if in.AdvertIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v49, v50 := range in.AdvertIDs {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v50))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedCartItems) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedCartItems) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedCartItems) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedCartItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(in *jlexer.Lexer, out *ReceivedCartItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels60(out *jwriter.Writer, in ReceivedCartItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.AdvertID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedCartItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedCartItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedCartItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedCartItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(in *jlexer.Lexer, out *ReceivedAdvertImagesOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "imageIds":
+			if in.IsNull() {
+				in.Skip()
+				out.ImageIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ImageIDs == nil {
+					if !in.IsDelim(']') {
+						out.ImageIDs = make([]uint, 0, 8)
+					} else {
+						out.ImageIDs = []uint{}
+					}
+				} else {
+					out.ImageIDs = (out.ImageIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v51 uint
+					v51 = uint(in.Uint())
+					out.ImageIDs = append(out.ImageIDs, v51)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels61(out *jwriter.Writer, in ReceivedAdvertImagesOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"imageIds\":"
+		out.RawString(prefix[1:])
+		if in.ImageIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v52, v53 := range in.ImageIDs {
+				if v52 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v53))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedAdvertImagesOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedAdvertImagesOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedAdvertImagesOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedAdvertImagesOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(in *jlexer.Lexer, out *ReceivedAdvertImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "imageId":
+			out.ImageID = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels62(out *jwriter.Writer, in ReceivedAdvertImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"imageId\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ImageID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedAdvertImage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedAdvertImage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedAdvertImage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedAdvertImage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(in *jlexer.Lexer, out *ReceivedAdData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.ID = uint(in.Uint())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "city":
+			out.City = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "price":
+			out.Price = uint(in.Uint())
+		case "isUsed":
+			out.IsUsed = bool(in.Bool())
+		case "phone":
+			out.Phone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels63(out *jwriter.Writer, in ReceivedAdData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Price))
+	}
+	{
+		const prefix string = ",\"isUsed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsUsed))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReceivedAdData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReceivedAdData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReceivedAdData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReceivedAdData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(in *jlexer.Lexer, out *QuestionResults) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "QuestionResults":
+			if in.IsNull() {
+				in.Skip()
+				out.QuestionResults = nil
+			} else {
+				in.Delim('[')
+				if out.QuestionResults == nil {
+					if !in.IsDelim(']') {
+						out.QuestionResults = make([]uint, 0, 8)
+					} else {
+						out.QuestionResults = []uint{}
+					}
+				} else {
+					out.QuestionResults = (out.QuestionResults)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v54 uint
+					v54 = uint(in.Uint())
+					out.QuestionResults = append(out.QuestionResults, v54)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(out *jwriter.Writer, in QuestionResults) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"QuestionResults\":"
+		out.RawString(prefix[1:])
+		if in.QuestionResults == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.QuestionResults {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint(uint(v56))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuestionResults) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuestionResults) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuestionResults) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuestionResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(in *jlexer.Lexer, out *Promotion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "needPing":
+			out.NeedPing = bool(in.Bool())
+		case "isPromoted":
+			out.IsPromoted = bool(in.Bool())
+		case "promotionStart":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PromotionStart).UnmarshalJSON(data))
+			}
+		case "promotionDuration":
+			easyjsonD2b7633eDecodeGithubComJackcPgxV5Pgtype(in, &out.PromotionDuration)
+		case "bumpedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BumpedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels65(out *jwriter.Writer, in Promotion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"needPing\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.NeedPing))
+	}
+	{
+		const prefix string = ",\"isPromoted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPromoted))
+	}
+	{
+		const prefix string = ",\"promotionStart\":"
+		out.RawString(prefix)
+		out.Raw((in.PromotionStart).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"promotionDuration\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComJackcPgxV5Pgtype(out, in.PromotionDuration)
+	}
+	{
+		const prefix string = ",\"bumpedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.BumpedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Promotion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Promotion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Promotion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Promotion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComJackcPgxV5Pgtype(in *jlexer.Lexer, out *pgtype.Interval) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Microseconds":
+			out.Microseconds = int64(in.Int64())
+		case "Days":
+			out.Days = int32(in.Int32())
+		case "Months":
+			out.Months = int32(in.Int32())
+		case "Valid":
+			out.Valid = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComJackcPgxV5Pgtype(out *jwriter.Writer, in pgtype.Interval) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Microseconds\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Microseconds))
+	}
+	{
+		const prefix string = ",\"Days\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Days))
+	}
+	{
+		const prefix string = ",\"Months\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Months))
+	}
+	{
+		const prefix string = ",\"Valid\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Valid))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(in *jlexer.Lexer, out *ProfilePad) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+				out.Name = nil
+			} else {
+				if out.Name == nil {
+					out.Name = new(string)
+				}
+				*out.Name = string(in.String())
+			}
+		case "surname":
+			if in.IsNull() {
+				in.Skip()
+				out.Surname = nil
+			} else {
+				if out.Surname == nil {
+					out.Surname = new(string)
+				}
+				*out.Surname = string(in.String())
+			}
+		case "phoneNumber":
+			if in.IsNull() {
+				in.Skip()
+				out.Phone = nil
+			} else {
+				if out.Phone == nil {
+					out.Phone = new(string)
+				}
+				*out.Phone = string(in.String())
+			}
+		case "avatar":
+			if in.IsNull() {
+				in.Skip()
+				out.Avatar = nil
+			} else {
+				if out.Avatar == nil {
+					out.Avatar = new(string)
+				}
+				*out.Avatar = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(out *jwriter.Writer, in ProfilePad) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		if in.Name == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Name))
+		}
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		if in.Surname == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Surname))
+		}
+	}
+	{
+		const prefix string = ",\"phoneNumber\":"
+		out.RawString(prefix)
+		if in.Phone == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Phone))
+		}
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		if in.Avatar == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Avatar))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfilePad) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfilePad) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfilePad) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfilePad) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(in *jlexer.Lexer, out *ProfileList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Profiles":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Profiles = make(map[uint]*Profile)
+				for !in.IsDelim('}') {
+					key := uint(in.UintStr())
+					in.WantColon()
+					var v57 *Profile
+					if in.IsNull() {
+						in.Skip()
+						v57 = nil
+					} else {
+						if v57 == nil {
+							v57 = new(Profile)
+						}
+						(*v57).UnmarshalEasyJSON(in)
 					}
+					(out.Profiles)[key] = v57
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "Mux":
+			easyjsonD2b7633eDecodeSync(in, &out.Mux)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(out *jwriter.Writer, in ProfileList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Profiles\":"
+		out.RawString(prefix[1:])
+		if in.Profiles == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v58First := true
+			for v58Name, v58Value := range in.Profiles {
+				if v58First {
+					v58First = false
 				} else {
-					out.Photos = (out.Photos)[:0]
+					out.RawByte(',')
 				}
-				for !in.IsDelim(']') {
-					var v12 string
-					v12 = string(in.String())
-					out.Photos = append(out.Photos, v12)
-					in.WantComma()
+				out.UintStr(uint(v58Name))
+				out.RawByte(':')
+				if v58Value == nil {
+					out.RawString("null")
+				} else {
+					(*v58Value).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"Mux\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeSync(out, in.Mux)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(in *jlexer.Lexer, out *ProfileAppended) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "isAppended":
+			out.IsAppended = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(out *jwriter.Writer, in ProfileAppended) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"isAppended\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsAppended))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileAppended) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileAppended) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileAppended) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileAppended) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(in *jlexer.Lexer, out *ProfileAdvertsNec) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "filter":
+			out.Filter = AdvertsFilter(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(out *jwriter.Writer, in ProfileAdvertsNec) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Filter))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileAdvertsNec) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileAdvertsNec) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileAdvertsNec) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileAdvertsNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(in *jlexer.Lexer, out *Profile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
+		case "city":
+			(out.City).UnmarshalEasyJSON(in)
+		case "phoneNumber":
+			out.Phone = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "regTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RegisterTime).UnmarshalJSON(data))
+			}
+		case "rating":
+			out.Rating = float32(in.Float32())
+		case "reactionsCount":
+			out.ReactionsCount = float32(in.Float32())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "phoneVerified":
+			out.PhoneVerified = bool(in.Bool())
+		case "merchantsName":
+			out.MerchantsName = string(in.String())
+		case "subersCount":
+			out.SubersCount = int(in.Int())
+		case "subonsCount":
+			out.SubonsCount = int(in.Int())
+		case "avatarImg":
+			out.AvatarIMG = string(in.String())
+		case "activeAddsCount":
+			out.ActiveAddsCount = int(in.Int())
+		case "soldAddsCount":
+			out.SoldAddsCount = int(in.Int())
+		case "cartNum":
+			out.CartNum = uint(in.Uint())
+		case "favNum":
+			out.FavNum = uint(in.Uint())
+		case "unreadMessagesNum":
+			out.UnreadMessages = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels70(out *jwriter.Writer, in Profile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		out.String(string(in.Surname))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		(in.City).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"phoneNumber\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"regTime\":"
+		out.RawString(prefix)
+		out.Raw((in.RegisterTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Rating))
+	}
+	{
+		const prefix string = ",\"reactionsCount\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.ReactionsCount))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Approved))
+	}
+	{
+		const prefix string = ",\"phoneVerified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PhoneVerified))
+	}
+	{
+		const prefix string = ",\"merchantsName\":"
+		out.RawString(prefix)
+		out.String(string(in.MerchantsName))
+	}
+	{
+		const prefix string = ",\"subersCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.SubersCount))
+	}
+	{
+		const prefix string = ",\"subonsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.SubonsCount))
+	}
+	{
+		const prefix string = ",\"avatarImg\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarIMG))
+	}
+	{
+		const prefix string = ",\"activeAddsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.ActiveAddsCount))
+	}
+	{
+		const prefix string = ",\"soldAddsCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.SoldAddsCount))
+	}
+	{
+		const prefix string = ",\"cartNum\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.CartNum))
+	}
+	{
+		const prefix string = ",\"favNum\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.FavNum))
+	}
+	{
+		const prefix string = ",\"unreadMessagesNum\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UnreadMessages))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Profile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Profile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(in *jlexer.Lexer, out *PriceHistoryItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "updatedTime":
+			out.UpdatedTime = string(in.String())
+		case "newPrice":
+			out.NewPrice = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(out *jwriter.Writer, in PriceHistoryItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"updatedTime\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UpdatedTime))
+	}
+	{
+		const prefix string = ",\"newPrice\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.NewPrice))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceHistoryItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceHistoryItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceHistoryItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceHistoryItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(in *jlexer.Lexer, out *PriceAndDescription) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "price":
+			out.Price = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "urlEnding":
+			out.URLEnding = string(in.String())
+		case "duration":
+			out.Duration = string(in.String())
+		case "serviceType":
+			out.ServiceType = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(out *jwriter.Writer, in PriceAndDescription) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Price))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"urlEnding\":"
+		out.RawString(prefix)
+		out.String(string(in.URLEnding))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.String(string(in.Duration))
+	}
+	{
+		const prefix string = ",\"serviceType\":"
+		out.RawString(prefix)
+		out.String(string(in.ServiceType))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PriceAndDescription) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PriceAndDescription) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PriceAndDescription) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PriceAndDescription) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(in *jlexer.Lexer, out *PhotoPadSoloImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photo":
+			if in.IsNull() {
+				in.Skip()
+				out.Photo = nil
+			} else {
+				if out.Photo == nil {
+					out.Photo = new(string)
 				}
-				in.Delim(']')
+				*out.Photo = string(in.String())
 			}
-		case "photosIMG":
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(out *jwriter.Writer, in PhotoPadSoloImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix[1:])
+		if in.Photo == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Photo))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoPadSoloImage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoPadSoloImage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoPadSoloImage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoPadSoloImage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(in *jlexer.Lexer, out *PhotoPad) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photo":
 			if in.IsNull() {
 				in.Skip()
-				out.PhotosIMG = nil
+				out.Photo = nil
 			} else {
 				in.Delim('[')
-				if out.PhotosIMG == nil {
+				if out.Photo == nil {
 					if !in.IsDelim(']') {
-						out.PhotosIMG = make([]string, 0, 4)
+						out.Photo = make([]*string, 0, 8)
 					} else {
-						out.PhotosIMG = []string{}
+						out.Photo = []*string{}
 					}
 				} else {
-					out.PhotosIMG = (out.PhotosIMG)[:0]
+					out.Photo = (out.Photo)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 *string
+					if in.IsNull() {
+						in.Skip()
+						v59 = nil
+					} else {
+						if v59 == nil {
+							v59 = new(string)
+						}
+						*v59 = string(in.String())
+					}
+					out.Photo = append(out.Photo, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(out *jwriter.Writer, in PhotoPad) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix[1:])
+		if in.Photo == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v60, v61 := range in.Photo {
+				if v60 > 0 {
+					out.RawByte(',')
 				}
-				for !in.IsDelim(']') {
-					var v13 string
-					v13 = string(in.String())
-					out.PhotosIMG = append(out.PhotosIMG, v13)
-					in.WantComma()
+				if v61 == nil {
+					out.RawString("null")
+				} else {
+					out.String(string(*v61))
 				}
-				in.Delim(']')
 			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoPad) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoPad) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoPad) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoPad) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(in *jlexer.Lexer, out *PhoneStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "phone":
+			out.Phone = string(in.String())
+		case "verified":
+			out.Verified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1891,58 +7095,286 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(out *jwriter.Writer, in ReturningAdvert) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(out *jwriter.Writer, in PhoneStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"advert\":"
+		const prefix string = ",\"phone\":"
 		out.RawString(prefix[1:])
-		(in.Advert).MarshalEasyJSON(out)
+		out.String(string(in.Phone))
 	}
 	{
-		const prefix string = ",\"promotion\":"
+		const prefix string = ",\"verified\":"
 		out.RawString(prefix)
-		(in.Promotion).MarshalEasyJSON(out)
+		out.Bool(bool(in.Verified))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhoneStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhoneStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhoneStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhoneStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(in *jlexer.Lexer, out *PhoneCodeSent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "phone":
+			out.Phone = string(in.String())
+		case "expiresIn":
+			out.ExpiresIn = int(in.Int())
+		case "resendIn":
+			out.ResendIn = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(out *jwriter.Writer, in PhoneCodeSent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Phone))
 	}
 	{
-		const prefix string = ",\"city\":"
+		const prefix string = ",\"expiresIn\":"
 		out.RawString(prefix)
-		(in.City).MarshalEasyJSON(out)
+		out.Int(int(in.ExpiresIn))
 	}
 	{
-		const prefix string = ",\"category\":"
+		const prefix string = ",\"resendIn\":"
 		out.RawString(prefix)
-		(in.Category).MarshalEasyJSON(out)
+		out.Int(int(in.ResendIn))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhoneCodeSent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhoneCodeSent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhoneCodeSent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhoneCodeSent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(in *jlexer.Lexer, out *PhoneCode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Phone":
+			out.Phone = string(in.String())
+		case "CodeHash":
+			out.CodeHash = string(in.String())
+		case "Attempts":
+			out.Attempts = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(out *jwriter.Writer, in PhoneCode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Phone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Phone))
 	}
 	{
-		const prefix string = ",\"photos\":"
+		const prefix string = ",\"CodeHash\":"
 		out.RawString(prefix)
-		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Photos {
-				if v14 > 0 {
-					out.RawByte(',')
+		out.String(string(in.CodeHash))
+	}
+	{
+		const prefix string = ",\"Attempts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attempts))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhoneCode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhoneCode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhoneCode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhoneCode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels78(in *jlexer.Lexer, out *PaymnetUUIDListPad) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pad":
+			if in.IsNull() {
+				in.Skip()
+				out.Pad = nil
+			} else {
+				in.Delim('[')
+				if out.Pad == nil {
+					if !in.IsDelim(']') {
+						out.Pad = make([]*string, 0, 8)
+					} else {
+						out.Pad = []*string{}
+					}
+				} else {
+					out.Pad = (out.Pad)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v62 *string
+					if in.IsNull() {
+						in.Skip()
+						v62 = nil
+					} else {
+						if v62 == nil {
+							v62 = new(string)
+						}
+						*v62 = string(in.String())
+					}
+					out.Pad = append(out.Pad, v62)
+					in.WantComma()
 				}
-				out.String(string(v15))
+				in.Delim(']')
 			}
-			out.RawByte(']')
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels78(out *jwriter.Writer, in PaymnetUUIDListPad) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"photosIMG\":"
-		out.RawString(prefix)
-		if in.PhotosIMG == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		const prefix string = ",\"pad\":"
+		out.RawString(prefix[1:])
+		if in.Pad == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v16, v17 := range in.PhotosIMG {
-				if v16 > 0 {
+			for v63, v64 := range in.Pad {
+				if v63 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v17))
+				if v64 == nil {
+					out.RawString("null")
+				} else {
+					out.String(string(*v64))
+				}
 			}
 			out.RawByte(']')
 		}
@@ -1951,29 +7383,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(out *j
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReturningAdvert) MarshalJSON() ([]byte, error) {
+func (v PaymnetUUIDListPad) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReturningAdvert) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels20(w, v)
+func (v PaymnetUUIDListPad) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReturningAdvert) UnmarshalJSON(data []byte) error {
+func (v *PaymnetUUIDListPad) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReturningAdvert) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels20(l, v)
+func (v *PaymnetUUIDListPad) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels78(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(in *jlexer.Lexer, out *ReturningAdInList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels79(in *jlexer.Lexer, out *PaymnetUUIDList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1992,70 +7424,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(in *jl
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint(in.Uint())
-		case "title":
-			out.Title = string(in.String())
-		case "price":
-			out.Price = uint(in.Uint())
-		case "city":
-			out.City = string(in.String())
-		case "category":
-			out.Category = string(in.String())
-		case "photos":
-			if in.IsNull() {
-				in.Skip()
-				out.Photos = nil
-			} else {
-				in.Delim('[')
-				if out.Photos == nil {
-					if !in.IsDelim(']') {
-						out.Photos = make([]string, 0, 4)
-					} else {
-						out.Photos = []string{}
-					}
-				} else {
-					out.Photos = (out.Photos)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v18 string
-					v18 = string(in.String())
-					out.Photos = append(out.Photos, v18)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "photosIMG":
+		case "uuidList":
 			if in.IsNull() {
 				in.Skip()
-				out.PhotosIMG = nil
+				out.UUIDList = nil
 			} else {
 				in.Delim('[')
-				if out.PhotosIMG == nil {
+				if out.UUIDList == nil {
 					if !in.IsDelim(']') {
-						out.PhotosIMG = make([]string, 0, 4)
+						out.UUIDList = make([]string, 0, 4)
 					} else {
-						out.PhotosIMG = []string{}
+						out.UUIDList = []string{}
 					}
 				} else {
-					out.PhotosIMG = (out.PhotosIMG)[:0]
+					out.UUIDList = (out.UUIDList)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 string
-					v19 = string(in.String())
-					out.PhotosIMG = append(out.PhotosIMG, v19)
+					var v65 string
+					v65 = string(in.String())
+					out.UUIDList = append(out.UUIDList, v65)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "inFavourites":
-			out.InFavourites = bool(in.Bool())
-		case "inCart":
-			out.InCart = bool(in.Bool())
-		case "isPromoted":
-			out.IsPromoted = bool(in.Bool())
-		case "isActive":
-			out.IsActive = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -2066,114 +7457,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels21(out *jwriter.Writer, in ReturningAdInList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels79(out *jwriter.Writer, in PaymnetUUIDList) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"uuidList\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"price\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.Price))
-	}
-	{
-		const prefix string = ",\"city\":"
-		out.RawString(prefix)
-		out.String(string(in.City))
-	}
-	{
-		const prefix string = ",\"category\":"
-		out.RawString(prefix)
-		out.String(string(in.Category))
-	}
-	{
-		const prefix string = ",\"photos\":"
-		out.RawString(prefix)
-		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v20, v21 := range in.Photos {
-				if v20 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v21))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"photosIMG\":"
-		out.RawString(prefix)
-		if in.PhotosIMG == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.UUIDList == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v22, v23 := range in.PhotosIMG {
-				if v22 > 0 {
+			for v66, v67 := range in.UUIDList {
+				if v66 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v23))
+				out.String(string(v67))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"inFavourites\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.InFavourites))
-	}
-	{
-		const prefix string = ",\"inCart\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.InCart))
-	}
-	{
-		const prefix string = ",\"isPromoted\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPromoted))
-	}
-	{
-		const prefix string = ",\"isActive\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsActive))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReturningAdInList) MarshalJSON() ([]byte, error) {
+func (v PaymnetUUIDList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels21(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReturningAdInList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels21(w, v)
+func (v PaymnetUUIDList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReturningAdInList) UnmarshalJSON(data []byte) error {
+func (v *PaymnetUUIDList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReturningAdInList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels21(l, v)
+func (v *PaymnetUUIDList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels79(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(in *jlexer.Lexer, out *Recipient) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels80(in *jlexer.Lexer, out *PaymentsDatesList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2192,10 +7522,39 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(in *jl
 			continue
 		}
 		switch key {
-		case "account_id":
-			out.AccountID = string(in.String())
-		case "gateway_id":
-			out.GatewayID = string(in.String())
+		case "List":
+			if in.IsNull() {
+				in.Skip()
+				out.List = nil
+			} else {
+				in.Delim('[')
+				if out.List == nil {
+					if !in.IsDelim(']') {
+						out.List = make([]*time.Time, 0, 8)
+					} else {
+						out.List = []*time.Time{}
+					}
+				} else {
+					out.List = (out.List)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v68 *time.Time
+					if in.IsNull() {
+						in.Skip()
+						v68 = nil
+					} else {
+						if v68 == nil {
+							v68 = new(time.Time)
+						}
+						if data := in.Raw(); in.Ok() {
+							in.AddError((*v68).UnmarshalJSON(data))
+						}
+					}
+					out.List = append(out.List, v68)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2206,47 +7565,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels22(out *jwriter.Writer, in Recipient) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels80(out *jwriter.Writer, in PaymentsDatesList) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"account_id\":"
+		const prefix string = ",\"List\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.AccountID))
-	}
-	{
-		const prefix string = ",\"gateway_id\":"
-		out.RawString(prefix)
-		out.String(string(in.GatewayID))
+		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v69, v70 := range in.List {
+				if v69 > 0 {
+					out.RawByte(',')
+				}
+				if v70 == nil {
+					out.RawString("null")
+				} else {
+					out.Raw((*v70).MarshalJSON())
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Recipient) MarshalJSON() ([]byte, error) {
+func (v PaymentsDatesList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels22(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Recipient) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels22(w, v)
+func (v PaymentsDatesList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Recipient) UnmarshalJSON(data []byte) error {
+func (v *PaymentsDatesList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Recipient) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels22(l, v)
+func (v *PaymentsDatesList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels80(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(in *jlexer.Lexer, out *ReceivedPaymentFormItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels81(in *jlexer.Lexer, out *PaymentMethod) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2265,10 +7634,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(in *jl
 			continue
 		}
 		switch key {
-		case "advertId":
-			out.AdvertID = uint(in.Uint())
-		case "rate":
-			out.Rate = uint(in.Uint())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "saved":
+			out.Saved = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -2279,47 +7650,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels23(out *jwriter.Writer, in ReceivedPaymentFormItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels81(out *jwriter.Writer, in PaymentMethod) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"advertId\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.AdvertID))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"rate\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Rate))
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"saved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Saved))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedPaymentFormItem) MarshalJSON() ([]byte, error) {
+func (v PaymentMethod) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels23(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedPaymentFormItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels23(w, v)
+func (v PaymentMethod) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedPaymentFormItem) UnmarshalJSON(data []byte) error {
+func (v *PaymentMethod) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedPaymentFormItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels23(l, v)
+func (v *PaymentMethod) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels81(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(in *jlexer.Lexer, out *ReceivedOrderItems) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels82(in *jlexer.Lexer, out *PaymentList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2338,33 +7714,27 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(in *jl
 			continue
 		}
 		switch key {
-		case "adverts":
+		case "type":
+			out.Type = string(in.String())
+		case "items":
 			if in.IsNull() {
 				in.Skip()
-				out.Adverts = nil
+				out.Items = nil
 			} else {
 				in.Delim('[')
-				if out.Adverts == nil {
+				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.Adverts = make([]*ReceivedOrderItem, 0, 8)
+						out.Items = make([]WaitingForCapturePayment, 0, 0)
 					} else {
-						out.Adverts = []*ReceivedOrderItem{}
+						out.Items = []WaitingForCapturePayment{}
 					}
 				} else {
-					out.Adverts = (out.Adverts)[:0]
+					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v24 *ReceivedOrderItem
-					if in.IsNull() {
-						in.Skip()
-						v24 = nil
-					} else {
-						if v24 == nil {
-							v24 = new(ReceivedOrderItem)
-						}
-						(*v24).UnmarshalEasyJSON(in)
-					}
-					out.Adverts = append(out.Adverts, v24)
+					var v71 WaitingForCapturePayment
+					(v71).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v71)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2379,26 +7749,27 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(out *jwriter.Writer, in ReceivedOrderItems) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels82(out *jwriter.Writer, in PaymentList) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"adverts\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Adverts {
-				if v25 > 0 {
+			for v72, v73 := range in.Items {
+				if v72 > 0 {
 					out.RawByte(',')
 				}
-				if v26 == nil {
-					out.RawString("null")
-				} else {
-					(*v26).MarshalEasyJSON(out)
-				}
+				(v73).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2407,29 +7778,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(out *j
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedOrderItems) MarshalJSON() ([]byte, error) {
+func (v PaymentList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedOrderItems) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels24(w, v)
+func (v PaymentList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedOrderItems) UnmarshalJSON(data []byte) error {
+func (v *PaymentList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedOrderItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels24(l, v)
+func (v *PaymentList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels82(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(in *jlexer.Lexer, out *ReceivedOrderItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels83(in *jlexer.Lexer, out *PaymentInitPaymentMethodData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2448,20 +7819,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(in *jl
 			continue
 		}
 		switch key {
-		case "advertID":
-			out.AdvertID = uint(in.Uint())
-		case "phone":
-			out.Phone = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "adress":
-			out.Adress = string(in.String())
-		case "deliveryPrice":
-			out.DeliveryPrice = uint(in.Uint())
-		case "address":
-			out.DeliveryAddress = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2472,72 +7831,129 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels25(out *jwriter.Writer, in ReceivedOrderItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels83(out *jwriter.Writer, in PaymentInitPaymentMethodData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"advertID\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.AdvertID))
+		out.String(string(in.Type))
 	}
-	{
-		const prefix string = ",\"phone\":"
-		out.RawString(prefix)
-		out.String(string(in.Phone))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PaymentInitPaymentMethodData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels83(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PaymentInitPaymentMethodData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels83(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PaymentInitPaymentMethodData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels83(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PaymentInitPaymentMethodData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels83(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels84(in *jlexer.Lexer, out *PaymentInitData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "payment_method_data":
+			(out.PaymentMethodData).UnmarshalEasyJSON(in)
+		case "confirmation":
+			(out.Confirmation).UnmarshalEasyJSON(in)
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels84(out *jwriter.Writer, in PaymentInitData) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix[1:])
+		(in.Amount).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"adress\":"
+		const prefix string = ",\"payment_method_data\":"
 		out.RawString(prefix)
-		out.String(string(in.Adress))
+		(in.PaymentMethodData).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"deliveryPrice\":"
+		const prefix string = ",\"confirmation\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.DeliveryPrice))
+		(in.Confirmation).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"address\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.String(string(in.DeliveryAddress))
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedOrderItem) MarshalJSON() ([]byte, error) {
+func (v PaymentInitData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels25(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedOrderItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels25(w, v)
+func (v PaymentInitData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedOrderItem) UnmarshalJSON(data []byte) error {
+func (v *PaymentInitData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedOrderItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels25(l, v)
+func (v *PaymentInitData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels84(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(in *jlexer.Lexer, out *ReceivedMerchantItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels85(in *jlexer.Lexer, out *PaymentInitConfirmation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2556,8 +7972,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(in *jl
 			continue
 		}
 		switch key {
-		case "merchantId":
-			out.MerchantID = uint(in.Uint())
+		case "type":
+			out.Type = string(in.String())
+		case "return_url":
+			out.ReturnURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2568,42 +7986,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels26(out *jwriter.Writer, in ReceivedMerchantItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels85(out *jwriter.Writer, in PaymentInitConfirmation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"merchantId\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.MerchantID))
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"return_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ReturnURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedMerchantItem) MarshalJSON() ([]byte, error) {
+func (v PaymentInitConfirmation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels26(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedMerchantItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels26(w, v)
+func (v PaymentInitConfirmation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedMerchantItem) UnmarshalJSON(data []byte) error {
+func (v *PaymentInitConfirmation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedMerchantItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels26(l, v)
+func (v *PaymentInitConfirmation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels85(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(in *jlexer.Lexer, out *ReceivedCartItems) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels86(in *jlexer.Lexer, out *PaymentInitAmount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2622,29 +8045,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(in *jl
 			continue
 		}
 		switch key {
-		case "advertIDs":
-			if in.IsNull() {
-				in.Skip()
-				out.AdvertIDs = nil
-			} else {
-				in.Delim('[')
-				if out.AdvertIDs == nil {
-					if !in.IsDelim(']') {
-						out.AdvertIDs = make([]uint, 0, 8)
-					} else {
-						out.AdvertIDs = []uint{}
-					}
-				} else {
-					out.AdvertIDs = (out.AdvertIDs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v27 uint
-					v27 = uint(in.Uint())
-					out.AdvertIDs = append(out.AdvertIDs, v27)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "value":
+			out.Value = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2655,53 +8059,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels27(out *jwriter.Writer, in ReceivedCartItems) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels86(out *jwriter.Writer, in PaymentInitAmount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"advertIDs\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix[1:])
-		if in.AdvertIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v28, v29 := range in.AdvertIDs {
-				if v28 > 0 {
-					out.RawByte(',')
-				}
-				out.Uint(uint(v29))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedCartItems) MarshalJSON() ([]byte, error) {
+func (v PaymentInitAmount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels27(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedCartItems) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels27(w, v)
+func (v PaymentInitAmount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedCartItems) UnmarshalJSON(data []byte) error {
+func (v *PaymentInitAmount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedCartItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels27(l, v)
+func (v *PaymentInitAmount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels86(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(in *jlexer.Lexer, out *ReceivedCartItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels87(in *jlexer.Lexer, out *PaymentFormResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2720,8 +8118,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(in *jl
 			continue
 		}
 		switch key {
-		case "advertId":
-			out.AdvertID = uint(in.Uint())
+		case "paymentFormUrl":
+			out.PaymentFormURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2732,42 +8130,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels28(out *jwriter.Writer, in ReceivedCartItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels87(out *jwriter.Writer, in PaymentFormResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"advertId\":"
+		const prefix string = ",\"paymentFormUrl\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.AdvertID))
+		out.String(string(in.PaymentFormURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedCartItem) MarshalJSON() ([]byte, error) {
+func (v PaymentFormResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels28(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedCartItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels28(w, v)
+func (v PaymentFormResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedCartItem) UnmarshalJSON(data []byte) error {
+func (v *PaymentFormResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedCartItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels28(l, v)
+func (v *PaymentFormResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels87(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(in *jlexer.Lexer, out *ReceivedAdData) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels88(in *jlexer.Lexer, out *Payment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2786,24 +8184,50 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(in *jl
 			continue
 		}
 		switch key {
-		case "Id":
-			out.ID = uint(in.Uint())
-		case "userId":
-			out.UserID = uint(in.Uint())
-		case "city":
-			out.City = string(in.String())
-		case "category":
-			out.Category = string(in.String())
-		case "title":
-			out.Title = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalEasyJSON(in)
 		case "description":
 			out.Description = string(in.String())
-		case "price":
-			out.Price = uint(in.Uint())
-		case "isUsed":
-			out.IsUsed = bool(in.Bool())
-		case "phone":
-			out.Phone = string(in.String())
+		case "recipient":
+			(out.Recipient).UnmarshalEasyJSON(in)
+		case "payment_method":
+			(out.PaymentMethod).UnmarshalEasyJSON(in)
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "confirmation":
+			(out.Confirmation).UnmarshalEasyJSON(in)
+		case "test":
+			out.Test = bool(in.Bool())
+		case "paid":
+			out.Paid = bool(in.Bool())
+		case "refundable":
+			out.Refundable = bool(in.Bool())
+		case "metadata":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Metadata = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v74 interface{}
+					if m, ok := v74.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v74.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v74 = in.Interface()
+					}
+					(out.Metadata)[key] = v74
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2814,82 +8238,119 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels29(out *jwriter.Writer, in ReceivedAdData) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels88(out *jwriter.Writer, in Payment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"userId\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.UserID))
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"city\":"
+		const prefix string = ",\"amount\":"
 		out.RawString(prefix)
-		out.String(string(in.City))
+		(in.Amount).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"category\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.String(string(in.Category))
+		out.String(string(in.Description))
 	}
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"recipient\":"
 		out.RawString(prefix)
-		out.String(string(in.Title))
+		(in.Recipient).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"payment_method\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		(in.PaymentMethod).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"price\":"
+		const prefix string = ",\"created_at\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Price))
+		out.String(string(in.CreatedAt))
 	}
 	{
-		const prefix string = ",\"isUsed\":"
+		const prefix string = ",\"confirmation\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsUsed))
+		(in.Confirmation).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"phone\":"
+		const prefix string = ",\"test\":"
 		out.RawString(prefix)
-		out.String(string(in.Phone))
+		out.Bool(bool(in.Test))
+	}
+	{
+		const prefix string = ",\"paid\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Paid))
+	}
+	{
+		const prefix string = ",\"refundable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Refundable))
+	}
+	{
+		const prefix string = ",\"metadata\":"
+		out.RawString(prefix)
+		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v75First := true
+			for v75Name, v75Value := range in.Metadata {
+				if v75First {
+					v75First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v75Name))
+				out.RawByte(':')
+				if m, ok := v75Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v75Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v75Value))
+				}
+			}
+			out.RawByte('}')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReceivedAdData) MarshalJSON() ([]byte, error) {
+func (v Payment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels29(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReceivedAdData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels29(w, v)
+func (v Payment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReceivedAdData) UnmarshalJSON(data []byte) error {
+func (v *Payment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReceivedAdData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels29(l, v)
+func (v *Payment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels88(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(in *jlexer.Lexer, out *QuestionResults) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels89(in *jlexer.Lexer, out *OrderList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2908,29 +8369,39 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(in *jl
 			continue
 		}
 		switch key {
-		case "QuestionResults":
+		case "Items":
 			if in.IsNull() {
 				in.Skip()
-				out.QuestionResults = nil
+				out.Items = nil
 			} else {
 				in.Delim('[')
-				if out.QuestionResults == nil {
+				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.QuestionResults = make([]uint, 0, 8)
+						out.Items = make([]*OrderItem, 0, 8)
 					} else {
-						out.QuestionResults = []uint{}
+						out.Items = []*OrderItem{}
 					}
 				} else {
-					out.QuestionResults = (out.QuestionResults)[:0]
+					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v30 uint
-					v30 = uint(in.Uint())
-					out.QuestionResults = append(out.QuestionResults, v30)
+					var v76 *OrderItem
+					if in.IsNull() {
+						in.Skip()
+						v76 = nil
+					} else {
+						if v76 == nil {
+							v76 = new(OrderItem)
+						}
+						(*v76).UnmarshalEasyJSON(in)
+					}
+					out.Items = append(out.Items, v76)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "Mux":
+			easyjsonD2b7633eDecodeSync(in, &out.Mux)
 		default:
 			in.SkipRecursive()
 		}
@@ -2941,142 +8412,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels30(out *jwriter.Writer, in QuestionResults) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels89(out *jwriter.Writer, in OrderList) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"QuestionResults\":"
+		const prefix string = ",\"Items\":"
 		out.RawString(prefix[1:])
-		if in.QuestionResults == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v31, v32 := range in.QuestionResults {
-				if v31 > 0 {
+			for v77, v78 := range in.Items {
+				if v77 > 0 {
 					out.RawByte(',')
 				}
-				out.Uint(uint(v32))
-			}
-			out.RawByte(']')
-		}
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v QuestionResults) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels30(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QuestionResults) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels30(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *QuestionResults) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QuestionResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels30(l, v)
-}
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels31(in *jlexer.Lexer, out *Promotion) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "needPing":
-			out.NeedPing = bool(in.Bool())
-		case "isPromoted":
-			out.IsPromoted = bool(in.Bool())
-		case "promotionStart":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.PromotionStart).UnmarshalJSON(data))
-			}
-		case "promotionDuration":
-			easyjsonD2b7633eDecodeGithubComJackcPgxV5Pgtype(in, &out.PromotionDuration)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels31(out *jwriter.Writer, in Promotion) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"needPing\":"
-		out.RawString(prefix[1:])
-		out.Bool(bool(in.NeedPing))
-	}
-	{
-		const prefix string = ",\"isPromoted\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPromoted))
-	}
-	{
-		const prefix string = ",\"promotionStart\":"
-		out.RawString(prefix)
-		out.Raw((in.PromotionStart).MarshalJSON())
+				if v78 == nil {
+					out.RawString("null")
+				} else {
+					(*v78).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"promotionDuration\":"
+		const prefix string = ",\"Mux\":"
 		out.RawString(prefix)
-		easyjsonD2b7633eEncodeGithubComJackcPgxV5Pgtype(out, in.PromotionDuration)
+		easyjsonD2b7633eEncodeSync(out, in.Mux)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Promotion) MarshalJSON() ([]byte, error) {
+func (v OrderList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels31(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Promotion) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels31(w, v)
+func (v OrderList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Promotion) UnmarshalJSON(data []byte) error {
+func (v *OrderList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels31(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Promotion) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels31(l, v)
+func (v *OrderList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels89(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComJackcPgxV5Pgtype(in *jlexer.Lexer, out *pgtype.Interval) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels90(in *jlexer.Lexer, out *OrderItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3095,14 +8486,38 @@ func easyjsonD2b7633eDecodeGithubComJackcPgxV5Pgtype(in *jlexer.Lexer, out *pgty
 			continue
 		}
 		switch key {
-		case "Microseconds":
-			out.Microseconds = int64(in.Int64())
-		case "Days":
-			out.Days = int32(in.Int32())
-		case "Months":
-			out.Months = int32(in.Int32())
-		case "Valid":
-			out.Valid = bool(in.Bool())
+		case "id":
+			out.ID = uint(in.Uint())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "status":
+			out.Status = string(in.String())
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Created).UnmarshalJSON(data))
+			}
+		case "updated":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Updated).UnmarshalJSON(data))
+			}
+		case "closed":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Closed).UnmarshalJSON(data))
+			}
+		case "phone":
+			out.Phone = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "address":
+			out.Address = string(in.String())
+		case "deliveryPrice":
+			out.DeliveryPrice = uint(in.Uint())
+		case "deliveryMethod":
+			out.DeliveryMethod = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3113,168 +8528,102 @@ func easyjsonD2b7633eDecodeGithubComJackcPgxV5Pgtype(in *jlexer.Lexer, out *pgty
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComJackcPgxV5Pgtype(out *jwriter.Writer, in pgtype.Interval) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels90(out *jwriter.Writer, in OrderItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Microseconds\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Microseconds))
+		out.Uint(uint(in.ID))
 	}
 	{
-		const prefix string = ",\"Days\":"
+		const prefix string = ",\"userId\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Days))
+		out.Uint(uint(in.UserID))
 	}
 	{
-		const prefix string = ",\"Months\":"
+		const prefix string = ",\"advertId\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Months))
+		out.Uint(uint(in.AdvertID))
 	}
 	{
-		const prefix string = ",\"Valid\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Valid))
+		out.String(string(in.Status))
 	}
-	out.RawByte('}')
-}
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels32(in *jlexer.Lexer, out *ProfilePad) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.Created).MarshalJSON())
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "name":
-			if in.IsNull() {
-				in.Skip()
-				out.Name = nil
-			} else {
-				if out.Name == nil {
-					out.Name = new(string)
-				}
-				*out.Name = string(in.String())
-			}
-		case "surname":
-			if in.IsNull() {
-				in.Skip()
-				out.Surname = nil
-			} else {
-				if out.Surname == nil {
-					out.Surname = new(string)
-				}
-				*out.Surname = string(in.String())
-			}
-		case "phoneNumber":
-			if in.IsNull() {
-				in.Skip()
-				out.Phone = nil
-			} else {
-				if out.Phone == nil {
-					out.Phone = new(string)
-				}
-				*out.Phone = string(in.String())
-			}
-		case "avatar":
-			if in.IsNull() {
-				in.Skip()
-				out.Avatar = nil
-			} else {
-				if out.Avatar == nil {
-					out.Avatar = new(string)
-				}
-				*out.Avatar = string(in.String())
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Raw((in.Updated).MarshalJSON())
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Raw((in.Closed).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
 	}
-}
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels32(out *jwriter.Writer, in ProfilePad) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
 		const prefix string = ",\"name\":"
-		out.RawString(prefix[1:])
-		if in.Name == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Name))
-		}
+		out.RawString(prefix)
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"surname\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix)
-		if in.Surname == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Surname))
-		}
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"phoneNumber\":"
+		const prefix string = ",\"address\":"
 		out.RawString(prefix)
-		if in.Phone == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Phone))
-		}
+		out.String(string(in.Address))
 	}
 	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"deliveryPrice\":"
 		out.RawString(prefix)
-		if in.Avatar == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.Avatar))
-		}
+		out.Uint(uint(in.DeliveryPrice))
+	}
+	{
+		const prefix string = ",\"deliveryMethod\":"
+		out.RawString(prefix)
+		out.String(string(in.DeliveryMethod))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfilePad) MarshalJSON() ([]byte, error) {
+func (v OrderItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels32(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfilePad) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels32(w, v)
+func (v OrderItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfilePad) UnmarshalJSON(data []byte) error {
+func (v *OrderItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels32(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfilePad) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels32(l, v)
+func (v *OrderItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels90(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(in *jlexer.Lexer, out *ProfileList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels91(in *jlexer.Lexer, out *OrderCreated) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3293,103 +8642,54 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(in *jl
 			continue
 		}
 		switch key {
-		case "Profiles":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.Profiles = make(map[uint]*Profile)
-				for !in.IsDelim('}') {
-					key := uint(in.UintStr())
-					in.WantColon()
-					var v33 *Profile
-					if in.IsNull() {
-						in.Skip()
-						v33 = nil
-					} else {
-						if v33 == nil {
-							v33 = new(Profile)
-						}
-						(*v33).UnmarshalEasyJSON(in)
-					}
-					(out.Profiles)[key] = v33
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
-		case "Mux":
-			easyjsonD2b7633eDecodeSync(in, &out.Mux)
+		case "isCreated":
+			out.IsCreated = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels33(out *jwriter.Writer, in ProfileList) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"Profiles\":"
-		out.RawString(prefix[1:])
-		if in.Profiles == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v34First := true
-			for v34Name, v34Value := range in.Profiles {
-				if v34First {
-					v34First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.UintStr(uint(v34Name))
-				out.RawByte(':')
-				if v34Value == nil {
-					out.RawString("null")
-				} else {
-					(*v34Value).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte('}')
-		}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels91(out *jwriter.Writer, in OrderCreated) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"Mux\":"
-		out.RawString(prefix)
-		easyjsonD2b7633eEncodeSync(out, in.Mux)
+		const prefix string = ",\"isCreated\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsCreated))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileList) MarshalJSON() ([]byte, error) {
+func (v OrderCreated) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels33(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels33(w, v)
+func (v OrderCreated) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileList) UnmarshalJSON(data []byte) error {
+func (v *OrderCreated) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels33(l, v)
+func (v *OrderCreated) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels91(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(in *jlexer.Lexer, out *ProfileAppended) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels92(in *jlexer.Lexer, out *OkResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3408,8 +8708,16 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(in *jl
 			continue
 		}
 		switch key {
-		case "isAppended":
-			out.IsAppended = bool(in.Bool())
+		case "code":
+			out.Code = int(in.Int())
+		case "items":
+			if m, ok := out.Items.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Items.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Items = in.Interface()
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3420,42 +8728,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels34(out *jwriter.Writer, in ProfileAppended) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels92(out *jwriter.Writer, in OkResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"isAppended\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsAppended))
+		out.Int(int(in.Code))
+	}
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix)
+		if m, ok := in.Items.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Items.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Items))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileAppended) MarshalJSON() ([]byte, error) {
+func (v OkResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels34(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileAppended) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels34(w, v)
+func (v OkResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileAppended) UnmarshalJSON(data []byte) error {
+func (v *OkResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileAppended) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels34(l, v)
+func (v *OkResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels92(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(in *jlexer.Lexer, out *ProfileAdvertsNec) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels93(in *jlexer.Lexer, out *NotificationsUnread) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3474,8 +8793,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(in *jl
 			continue
 		}
 		switch key {
-		case "filter":
-			out.Filter = AdvertsFilter(in.Int())
+		case "unread":
+			out.Unread = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -3486,42 +8805,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels35(out *jwriter.Writer, in ProfileAdvertsNec) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels93(out *jwriter.Writer, in NotificationsUnread) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"filter\":"
+		const prefix string = ",\"unread\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Filter))
+		out.Uint(uint(in.Unread))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileAdvertsNec) MarshalJSON() ([]byte, error) {
+func (v NotificationsUnread) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels35(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileAdvertsNec) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels35(w, v)
+func (v NotificationsUnread) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileAdvertsNec) UnmarshalJSON(data []byte) error {
+func (v *NotificationsUnread) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileAdvertsNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels35(l, v)
+func (v *NotificationsUnread) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels93(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(in *jlexer.Lexer, out *Profile) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels94(in *jlexer.Lexer, out *Notification) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3542,44 +8861,36 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(in *jl
 		switch key {
 		case "id":
 			out.ID = uint(in.Uint())
-		case "userId":
-			out.UserID = uint(in.Uint())
-		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
-		case "city":
-			(out.City).UnmarshalEasyJSON(in)
-		case "phoneNumber":
-			out.Phone = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "regTime":
+		case "kind":
+			out.Kind = string(in.String())
+		case "advertId":
+			if in.IsNull() {
+				in.Skip()
+				out.AdvertID = nil
+			} else {
+				if out.AdvertID == nil {
+					out.AdvertID = new(uint)
+				}
+				*out.AdvertID = uint(in.Uint())
+			}
+		case "advertTitle":
+			out.AdvertTitle = string(in.String())
+		case "actorId":
+			if in.IsNull() {
+				in.Skip()
+				out.ActorID = nil
+			} else {
+				if out.ActorID == nil {
+					out.ActorID = new(uint)
+				}
+				*out.ActorID = uint(in.Uint())
+			}
+		case "isRead":
+			out.IsRead = bool(in.Bool())
+		case "created":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.RegisterTime).UnmarshalJSON(data))
+				in.AddError((out.CreatedTime).UnmarshalJSON(data))
 			}
-		case "rating":
-			out.Rating = float32(in.Float32())
-		case "reactionsCount":
-			out.ReactionsCount = float32(in.Float32())
-		case "approved":
-			out.Approved = bool(in.Bool())
-		case "merchantsName":
-			out.MerchantsName = string(in.String())
-		case "subersCount":
-			out.SubersCount = int(in.Int())
-		case "subonsCount":
-			out.SubonsCount = int(in.Int())
-		case "avatarImg":
-			out.AvatarIMG = string(in.String())
-		case "activeAddsCount":
-			out.ActiveAddsCount = int(in.Int())
-		case "soldAddsCount":
-			out.SoldAddsCount = int(in.Int())
-		case "cartNum":
-			out.CartNum = uint(in.Uint())
-		case "favNum":
-			out.FavNum = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -3590,7 +8901,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(out *jwriter.Writer, in Profile) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels94(out *jwriter.Writer, in Notification) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3600,122 +8911,173 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(out *j
 		out.Uint(uint(in.ID))
 	}
 	{
-		const prefix string = ",\"userId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.UserID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"surname\":"
-		out.RawString(prefix)
-		out.String(string(in.Surname))
-	}
-	{
-		const prefix string = ",\"city\":"
-		out.RawString(prefix)
-		(in.City).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"phoneNumber\":"
-		out.RawString(prefix)
-		out.String(string(in.Phone))
-	}
-	{
-		const prefix string = ",\"avatar\":"
+		const prefix string = ",\"kind\":"
 		out.RawString(prefix)
-		out.String(string(in.Avatar))
+		out.String(string(in.Kind))
 	}
-	{
-		const prefix string = ",\"regTime\":"
+	if in.AdvertID != nil {
+		const prefix string = ",\"advertId\":"
 		out.RawString(prefix)
-		out.Raw((in.RegisterTime).MarshalJSON())
+		out.Uint(uint(*in.AdvertID))
 	}
-	{
-		const prefix string = ",\"rating\":"
+	if in.AdvertTitle != "" {
+		const prefix string = ",\"advertTitle\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.Rating))
+		out.String(string(in.AdvertTitle))
 	}
-	{
-		const prefix string = ",\"reactionsCount\":"
+	if in.ActorID != nil {
+		const prefix string = ",\"actorId\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.ReactionsCount))
+		out.Uint(uint(*in.ActorID))
 	}
 	{
-		const prefix string = ",\"approved\":"
+		const prefix string = ",\"isRead\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Approved))
+		out.Bool(bool(in.IsRead))
 	}
 	{
-		const prefix string = ",\"merchantsName\":"
+		const prefix string = ",\"created\":"
 		out.RawString(prefix)
-		out.String(string(in.MerchantsName))
+		out.Raw((in.CreatedTime).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"subersCount\":"
-		out.RawString(prefix)
-		out.Int(int(in.SubersCount))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels94(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels94(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels94(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels94(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels95(in *jlexer.Lexer, out *NewNotification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"subonsCount\":"
-		out.RawString(prefix)
-		out.Int(int(in.SubonsCount))
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "UserID":
+			out.UserID = uint(in.Uint())
+		case "Kind":
+			out.Kind = string(in.String())
+		case "AdvertID":
+			if in.IsNull() {
+				in.Skip()
+				out.AdvertID = nil
+			} else {
+				if out.AdvertID == nil {
+					out.AdvertID = new(uint)
+				}
+				*out.AdvertID = uint(in.Uint())
+			}
+		case "ActorID":
+			if in.IsNull() {
+				in.Skip()
+				out.ActorID = nil
+			} else {
+				if out.ActorID == nil {
+					out.ActorID = new(uint)
+				}
+				*out.ActorID = uint(in.Uint())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"avatarImg\":"
-		out.RawString(prefix)
-		out.String(string(in.AvatarIMG))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels95(out *jwriter.Writer, in NewNotification) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"activeAddsCount\":"
-		out.RawString(prefix)
-		out.Int(int(in.ActiveAddsCount))
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.UserID))
 	}
 	{
-		const prefix string = ",\"soldAddsCount\":"
+		const prefix string = ",\"Kind\":"
 		out.RawString(prefix)
-		out.Int(int(in.SoldAddsCount))
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"cartNum\":"
+		const prefix string = ",\"AdvertID\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.CartNum))
+		if in.AdvertID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint(uint(*in.AdvertID))
+		}
 	}
 	{
-		const prefix string = ",\"favNum\":"
+		const prefix string = ",\"ActorID\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.FavNum))
+		if in.ActorID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint(uint(*in.ActorID))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Profile) MarshalJSON() ([]byte, error) {
+func (v NewNotification) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels36(w, v)
+func (v NewNotification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Profile) UnmarshalJSON(data []byte) error {
+func (v *NewNotification) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels36(l, v)
+func (v *NewNotification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels95(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(in *jlexer.Lexer, out *PriceHistoryItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels96(in *jlexer.Lexer, out *ModerationVerdict) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3734,10 +9096,31 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(in *jl
 			continue
 		}
 		switch key {
-		case "updatedTime":
-			out.UpdatedTime = string(in.String())
-		case "newPrice":
-			out.NewPrice = float64(in.Float64())
+		case "approved":
+			out.Approved = bool(in.Bool())
+		case "reasons":
+			if in.IsNull() {
+				in.Skip()
+				out.Reasons = nil
+			} else {
+				in.Delim('[')
+				if out.Reasons == nil {
+					if !in.IsDelim(']') {
+						out.Reasons = make([]string, 0, 4)
+					} else {
+						out.Reasons = []string{}
+					}
+				} else {
+					out.Reasons = (out.Reasons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 string
+					v79 = string(in.String())
+					out.Reasons = append(out.Reasons, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3748,47 +9131,58 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels37(out *jwriter.Writer, in PriceHistoryItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels96(out *jwriter.Writer, in ModerationVerdict) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"updatedTime\":"
+		const prefix string = ",\"approved\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.UpdatedTime))
+		out.Bool(bool(in.Approved))
 	}
 	{
-		const prefix string = ",\"newPrice\":"
+		const prefix string = ",\"reasons\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.NewPrice))
+		if in.Reasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Reasons {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v81))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PriceHistoryItem) MarshalJSON() ([]byte, error) {
+func (v ModerationVerdict) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels37(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PriceHistoryItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels37(w, v)
+func (v ModerationVerdict) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PriceHistoryItem) UnmarshalJSON(data []byte) error {
+func (v *ModerationVerdict) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PriceHistoryItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels37(l, v)
+func (v *ModerationVerdict) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels96(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(in *jlexer.Lexer, out *PriceAndDescription) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels97(in *jlexer.Lexer, out *ModerationItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3807,14 +9201,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(in *jl
 			continue
 		}
 		switch key {
-		case "price":
-			out.Price = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "urlEnding":
-			out.URLEnding = string(in.String())
-		case "duration":
-			out.Duration = string(in.String())
+		case "advert":
+			(out.Advert).UnmarshalEasyJSON(in)
+		case "decision":
+			(out.Decision).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -3825,57 +9215,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels38(out *jwriter.Writer, in PriceAndDescription) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels97(out *jwriter.Writer, in ModerationItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"price\":"
+		const prefix string = ",\"advert\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Price))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"urlEnding\":"
-		out.RawString(prefix)
-		out.String(string(in.URLEnding))
+		(in.Advert).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"duration\":"
+		const prefix string = ",\"decision\":"
 		out.RawString(prefix)
-		out.String(string(in.Duration))
+		(in.Decision).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PriceAndDescription) MarshalJSON() ([]byte, error) {
+func (v ModerationItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels38(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PriceAndDescription) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels38(w, v)
+func (v ModerationItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PriceAndDescription) UnmarshalJSON(data []byte) error {
+func (v *ModerationItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PriceAndDescription) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels38(l, v)
+func (v *ModerationItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels97(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(in *jlexer.Lexer, out *PhotoPadSoloImage) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels98(in *jlexer.Lexer, out *ModerationDecision) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3894,15 +9274,38 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(in *jl
 			continue
 		}
 		switch key {
-		case "photo":
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "moderatorId":
+			out.ModeratorID = uint(in.Uint())
+		case "decision":
+			out.Decision = string(in.String())
+		case "reasons":
 			if in.IsNull() {
 				in.Skip()
-				out.Photo = nil
+				out.Reasons = nil
 			} else {
-				if out.Photo == nil {
-					out.Photo = new(string)
+				in.Delim('[')
+				if out.Reasons == nil {
+					if !in.IsDelim(']') {
+						out.Reasons = make([]string, 0, 4)
+					} else {
+						out.Reasons = []string{}
+					}
+				} else {
+					out.Reasons = (out.Reasons)[:0]
 				}
-				*out.Photo = string(in.String())
+				for !in.IsDelim(']') {
+					var v82 string
+					v82 = string(in.String())
+					out.Reasons = append(out.Reasons, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedTime).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -3914,46 +9317,73 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels39(out *jwriter.Writer, in PhotoPadSoloImage) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels98(out *jwriter.Writer, in ModerationDecision) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"photo\":"
+		const prefix string = ",\"advertId\":"
 		out.RawString(prefix[1:])
-		if in.Photo == nil {
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"moderatorId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ModeratorID))
+	}
+	{
+		const prefix string = ",\"decision\":"
+		out.RawString(prefix)
+		out.String(string(in.Decision))
+	}
+	{
+		const prefix string = ",\"reasons\":"
+		out.RawString(prefix)
+		if in.Reasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.String(string(*in.Photo))
+			out.RawByte('[')
+			for v83, v84 := range in.Reasons {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v84))
+			}
+			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedTime).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PhotoPadSoloImage) MarshalJSON() ([]byte, error) {
+func (v ModerationDecision) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels39(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PhotoPadSoloImage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels39(w, v)
+func (v ModerationDecision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PhotoPadSoloImage) UnmarshalJSON(data []byte) error {
+func (v *ModerationDecision) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PhotoPadSoloImage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels39(l, v)
+func (v *ModerationDecision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels98(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(in *jlexer.Lexer, out *PhotoPad) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels99(in *jlexer.Lexer, out *MessagesRead) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3972,36 +9402,15 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(in *jl
 			continue
 		}
 		switch key {
-		case "photo":
-			if in.IsNull() {
-				in.Skip()
-				out.Photo = nil
-			} else {
-				in.Delim('[')
-				if out.Photo == nil {
-					if !in.IsDelim(']') {
-						out.Photo = make([]*string, 0, 8)
-					} else {
-						out.Photo = []*string{}
-					}
-				} else {
-					out.Photo = (out.Photo)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v35 *string
-					if in.IsNull() {
-						in.Skip()
-						v35 = nil
-					} else {
-						if v35 == nil {
-							v35 = new(string)
-						}
-						*v35 = string(in.String())
-					}
-					out.Photo = append(out.Photo, v35)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "conversationId":
+			out.ConversationID = uint(in.Uint())
+		case "readerId":
+			out.ReaderID = uint(in.Uint())
+		case "lastMessageId":
+			out.LastMessageID = uint(in.Uint())
+		case "readTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReadTime).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -4013,57 +9422,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels40(out *jwriter.Writer, in PhotoPad) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels99(out *jwriter.Writer, in MessagesRead) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"photo\":"
+		const prefix string = ",\"conversationId\":"
 		out.RawString(prefix[1:])
-		if in.Photo == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v36, v37 := range in.Photo {
-				if v36 > 0 {
-					out.RawByte(',')
-				}
-				if v37 == nil {
-					out.RawString("null")
-				} else {
-					out.String(string(*v37))
-				}
-			}
-			out.RawByte(']')
-		}
+		out.Uint(uint(in.ConversationID))
+	}
+	{
+		const prefix string = ",\"readerId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ReaderID))
+	}
+	{
+		const prefix string = ",\"lastMessageId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.LastMessageID))
+	}
+	{
+		const prefix string = ",\"readTime\":"
+		out.RawString(prefix)
+		out.Raw((in.ReadTime).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PhotoPad) MarshalJSON() ([]byte, error) {
+func (v MessagesRead) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels40(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PhotoPad) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels40(w, v)
+func (v MessagesRead) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PhotoPad) UnmarshalJSON(data []byte) error {
+func (v *MessagesRead) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PhotoPad) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels40(l, v)
+func (v *MessagesRead) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels99(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(in *jlexer.Lexer, out *PaymnetUUIDListPad) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels100(in *jlexer.Lexer, out *MessagesPage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4082,37 +9491,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(in *jl
 			continue
 		}
 		switch key {
-		case "pad":
+		case "messages":
 			if in.IsNull() {
 				in.Skip()
-				out.Pad = nil
+				out.Messages = nil
 			} else {
 				in.Delim('[')
-				if out.Pad == nil {
+				if out.Messages == nil {
 					if !in.IsDelim(']') {
-						out.Pad = make([]*string, 0, 8)
+						out.Messages = make([]*Message, 0, 8)
 					} else {
-						out.Pad = []*string{}
+						out.Messages = []*Message{}
 					}
 				} else {
-					out.Pad = (out.Pad)[:0]
+					out.Messages = (out.Messages)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v38 *string
+					var v85 *Message
 					if in.IsNull() {
 						in.Skip()
-						v38 = nil
+						v85 = nil
 					} else {
-						if v38 == nil {
-							v38 = new(string)
+						if v85 == nil {
+							v85 = new(Message)
 						}
-						*v38 = string(in.String())
+						(*v85).UnmarshalEasyJSON(in)
 					}
-					out.Pad = append(out.Pad, v38)
+					out.Messages = append(out.Messages, v85)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "nextBefore":
+			if in.IsNull() {
+				in.Skip()
+				out.NextBefore = nil
+			} else {
+				if out.NextBefore == nil {
+					out.NextBefore = new(uint)
+				}
+				*out.NextBefore = uint(in.Uint())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4123,57 +9542,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels41(out *jwriter.Writer, in PaymnetUUIDListPad) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels100(out *jwriter.Writer, in MessagesPage) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"pad\":"
+		const prefix string = ",\"messages\":"
 		out.RawString(prefix[1:])
-		if in.Pad == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Messages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v39, v40 := range in.Pad {
-				if v39 > 0 {
+			for v86, v87 := range in.Messages {
+				if v86 > 0 {
 					out.RawByte(',')
 				}
-				if v40 == nil {
+				if v87 == nil {
 					out.RawString("null")
 				} else {
-					out.String(string(*v40))
+					(*v87).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.NextBefore != nil {
+		const prefix string = ",\"nextBefore\":"
+		out.RawString(prefix)
+		out.Uint(uint(*in.NextBefore))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymnetUUIDListPad) MarshalJSON() ([]byte, error) {
+func (v MessagesPage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels41(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymnetUUIDListPad) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels41(w, v)
+func (v MessagesPage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymnetUUIDListPad) UnmarshalJSON(data []byte) error {
+func (v *MessagesPage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymnetUUIDListPad) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels41(l, v)
+func (v *MessagesPage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels100(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(in *jlexer.Lexer, out *PaymnetUUIDList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels101(in *jlexer.Lexer, out *Message) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4192,28 +9616,31 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(in *jl
 			continue
 		}
 		switch key {
-		case "uuidList":
+		case "id":
+			out.ID = uint(in.Uint())
+		case "conversationId":
+			out.ConversationID = uint(in.Uint())
+		case "senderId":
+			out.SenderID = uint(in.Uint())
+		case "recipientId":
+			out.RecipientID = uint(in.Uint())
+		case "text":
+			out.Text = string(in.String())
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedTime).UnmarshalJSON(data))
+			}
+		case "readTime":
 			if in.IsNull() {
 				in.Skip()
-				out.UUIDList = nil
+				out.ReadTime = nil
 			} else {
-				in.Delim('[')
-				if out.UUIDList == nil {
-					if !in.IsDelim(']') {
-						out.UUIDList = make([]string, 0, 4)
-					} else {
-						out.UUIDList = []string{}
-					}
-				} else {
-					out.UUIDList = (out.UUIDList)[:0]
+				if out.ReadTime == nil {
+					out.ReadTime = new(time.Time)
 				}
-				for !in.IsDelim(']') {
-					var v41 string
-					v41 = string(in.String())
-					out.UUIDList = append(out.UUIDList, v41)
-					in.WantComma()
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ReadTime).UnmarshalJSON(data))
 				}
-				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -4225,53 +9652,72 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels42(out *jwriter.Writer, in PaymnetUUIDList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels101(out *jwriter.Writer, in Message) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"uuidList\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.UUIDList == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v42, v43 := range in.UUIDList {
-				if v42 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v43))
-			}
-			out.RawByte(']')
-		}
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"conversationId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ConversationID))
+	}
+	{
+		const prefix string = ",\"senderId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.SenderID))
+	}
+	{
+		const prefix string = ",\"recipientId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.RecipientID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedTime).MarshalJSON())
+	}
+	if in.ReadTime != nil {
+		const prefix string = ",\"readTime\":"
+		out.RawString(prefix)
+		out.Raw((*in.ReadTime).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymnetUUIDList) MarshalJSON() ([]byte, error) {
+func (v Message) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels42(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymnetUUIDList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels42(w, v)
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymnetUUIDList) UnmarshalJSON(data []byte) error {
+func (v *Message) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymnetUUIDList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels42(l, v)
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels101(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(in *jlexer.Lexer, out *PaymentsDatesList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels102(in *jlexer.Lexer, out *Image) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4290,39 +9736,6 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(in *jl
 			continue
 		}
 		switch key {
-		case "List":
-			if in.IsNull() {
-				in.Skip()
-				out.List = nil
-			} else {
-				in.Delim('[')
-				if out.List == nil {
-					if !in.IsDelim(']') {
-						out.List = make([]*time.Time, 0, 8)
-					} else {
-						out.List = []*time.Time{}
-					}
-				} else {
-					out.List = (out.List)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v44 *time.Time
-					if in.IsNull() {
-						in.Skip()
-						v44 = nil
-					} else {
-						if v44 == nil {
-							v44 = new(time.Time)
-						}
-						if data := in.Raw(); in.Ok() {
-							in.AddError((*v44).UnmarshalJSON(data))
-						}
-					}
-					out.List = append(out.List, v44)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4333,57 +9746,37 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels43(out *jwriter.Writer, in PaymentsDatesList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels102(out *jwriter.Writer, in Image) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"List\":"
-		out.RawString(prefix[1:])
-		if in.List == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v45, v46 := range in.List {
-				if v45 > 0 {
-					out.RawByte(',')
-				}
-				if v46 == nil {
-					out.RawString("null")
-				} else {
-					out.Raw((*v46).MarshalJSON())
-				}
-			}
-			out.RawByte(']')
-		}
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentsDatesList) MarshalJSON() ([]byte, error) {
+func (v Image) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels43(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentsDatesList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels43(w, v)
+func (v Image) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentsDatesList) UnmarshalJSON(data []byte) error {
+func (v *Image) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentsDatesList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels43(l, v)
+func (v *Image) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels102(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(in *jlexer.Lexer, out *PaymentMethod) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels103(in *jlexer.Lexer, out *FavouriteItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4402,12 +9795,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(in *jl
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "id":
-			out.ID = string(in.String())
-		case "saved":
-			out.Saved = bool(in.Bool())
+		case "advert":
+			if in.IsNull() {
+				in.Skip()
+				out.Advert = nil
+			} else {
+				if out.Advert == nil {
+					out.Advert = new(ReturningAdInList)
+				}
+				(*out.Advert).UnmarshalEasyJSON(in)
+			}
+		case "collectionId":
+			if in.IsNull() {
+				in.Skip()
+				out.CollectionID = nil
+			} else {
+				if out.CollectionID == nil {
+					out.CollectionID = new(uint)
+				}
+				*out.CollectionID = uint(in.Uint())
+			}
+		case "note":
+			out.Note = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4418,52 +9827,60 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels44(out *jwriter.Writer, in PaymentMethod) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels103(out *jwriter.Writer, in FavouriteItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"advert\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		if in.Advert == nil {
+			out.RawString("null")
+		} else {
+			(*in.Advert).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"collectionId\":"
 		out.RawString(prefix)
-		out.String(string(in.ID))
+		if in.CollectionID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint(uint(*in.CollectionID))
+		}
 	}
-	{
-		const prefix string = ",\"saved\":"
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Saved))
+		out.String(string(in.Note))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentMethod) MarshalJSON() ([]byte, error) {
+func (v FavouriteItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels44(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels103(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentMethod) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels44(w, v)
+func (v FavouriteItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels103(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentMethod) UnmarshalJSON(data []byte) error {
+func (v *FavouriteItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels103(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentMethod) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels44(l, v)
+func (v *FavouriteItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels103(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(in *jlexer.Lexer, out *PaymentList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels104(in *jlexer.Lexer, out *FavouriteCollectionView) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4482,8 +9899,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(in *jl
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
+		case "collection":
+			(out.Collection).UnmarshalEasyJSON(in)
 		case "items":
 			if in.IsNull() {
 				in.Skip()
@@ -4492,17 +9909,25 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(in *jl
 				in.Delim('[')
 				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.Items = make([]WaitingForCapturePayment, 0, 0)
+						out.Items = make([]*FavouriteItem, 0, 8)
 					} else {
-						out.Items = []WaitingForCapturePayment{}
+						out.Items = []*FavouriteItem{}
 					}
 				} else {
 					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v47 WaitingForCapturePayment
-					(v47).UnmarshalEasyJSON(in)
-					out.Items = append(out.Items, v47)
+					var v88 *FavouriteItem
+					if in.IsNull() {
+						in.Skip()
+						v88 = nil
+					} else {
+						if v88 == nil {
+							v88 = new(FavouriteItem)
+						}
+						(*v88).UnmarshalEasyJSON(in)
+					}
+					out.Items = append(out.Items, v88)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4517,14 +9942,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(out *jwriter.Writer, in PaymentList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels104(out *jwriter.Writer, in FavouriteCollectionView) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"collection\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		(in.Collection).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"items\":"
@@ -4533,11 +9958,15 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(out *j
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v48, v49 := range in.Items {
-				if v48 > 0 {
+			for v89, v90 := range in.Items {
+				if v89 > 0 {
 					out.RawByte(',')
 				}
-				(v49).MarshalEasyJSON(out)
+				if v90 == nil {
+					out.RawString("null")
+				} else {
+					(*v90).MarshalEasyJSON(out)
+				}
 			}
 			out.RawByte(']')
 		}
@@ -4546,29 +9975,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(out *j
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentList) MarshalJSON() ([]byte, error) {
+func (v FavouriteCollectionView) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels104(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels45(w, v)
+func (v FavouriteCollectionView) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels104(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentList) UnmarshalJSON(data []byte) error {
+func (v *FavouriteCollectionView) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels104(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels45(l, v)
+func (v *FavouriteCollectionView) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels104(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(in *jlexer.Lexer, out *PaymentInitPaymentMethodData) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels105(in *jlexer.Lexer, out *FavouriteCollection) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4587,8 +10016,26 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(in *jl
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
+		case "id":
+			out.ID = uint(in.Uint())
+		case "name":
+			out.Name = string(in.String())
+		case "advertsNum":
+			out.AdvertsNum = uint(in.Uint())
+		case "shareToken":
+			if in.IsNull() {
+				in.Skip()
+				out.ShareToken = nil
+			} else {
+				if out.ShareToken == nil {
+					out.ShareToken = new(string)
+				}
+				*out.ShareToken = string(in.String())
+			}
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedTime).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4599,42 +10046,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels46(out *jwriter.Writer, in PaymentInitPaymentMethodData) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels105(out *jwriter.Writer, in FavouriteCollection) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"advertsNum\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.AdvertsNum))
+	}
+	if in.ShareToken != nil {
+		const prefix string = ",\"shareToken\":"
+		out.RawString(prefix)
+		out.String(string(*in.ShareToken))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedTime).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentInitPaymentMethodData) MarshalJSON() ([]byte, error) {
+func (v FavouriteCollection) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels46(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels105(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentInitPaymentMethodData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels46(w, v)
+func (v FavouriteCollection) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels105(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentInitPaymentMethodData) UnmarshalJSON(data []byte) error {
+func (v *FavouriteCollection) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels105(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentInitPaymentMethodData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels46(l, v)
+func (v *FavouriteCollection) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels105(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(in *jlexer.Lexer, out *PaymentInitData) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels106(in *jlexer.Lexer, out *ErrResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4653,14 +10120,41 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(in *jl
 			continue
 		}
 		switch key {
-		case "amount":
-			(out.Amount).UnmarshalEasyJSON(in)
-		case "payment_method_data":
-			(out.PaymentMethodData).UnmarshalEasyJSON(in)
-		case "confirmation":
-			(out.Confirmation).UnmarshalEasyJSON(in)
-		case "description":
-			out.Description = string(in.String())
+		case "code":
+			out.Code = int(in.Int())
+		case "status":
+			out.Status = string(in.String())
+		case "errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]*UploadError, 0, 8)
+					} else {
+						out.Errors = []*UploadError{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 *UploadError
+					if in.IsNull() {
+						in.Skip()
+						v91 = nil
+					} else {
+						if v91 == nil {
+							v91 = new(UploadError)
+						}
+						(*v91).UnmarshalEasyJSON(in)
+					}
+					out.Errors = append(out.Errors, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4671,57 +10165,65 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels47(out *jwriter.Writer, in PaymentInitData) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels106(out *jwriter.Writer, in ErrResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"amount\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix[1:])
-		(in.Amount).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"payment_method_data\":"
-		out.RawString(prefix)
-		(in.PaymentMethodData).MarshalEasyJSON(out)
+		out.Int(int(in.Code))
 	}
 	{
-		const prefix string = ",\"confirmation\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		(in.Confirmation).MarshalEasyJSON(out)
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"description\":"
+	if len(in.Errors) != 0 {
+		const prefix string = ",\"errors\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		{
+			out.RawByte('[')
+			for v92, v93 := range in.Errors {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				if v93 == nil {
+					out.RawString("null")
+				} else {
+					(*v93).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentInitData) MarshalJSON() ([]byte, error) {
+func (v ErrResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels47(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels106(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentInitData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels47(w, v)
+func (v ErrResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels106(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentInitData) UnmarshalJSON(data []byte) error {
+func (v *ErrResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels106(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentInitData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels47(l, v)
+func (v *ErrResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels106(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(in *jlexer.Lexer, out *PaymentInitConfirmation) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels107(in *jlexer.Lexer, out *EmailRecipient) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4740,10 +10242,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(in *jl
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "return_url":
-			out.ReturnURL = string(in.String())
+		case "Email":
+			out.Email = string(in.String())
+		case "Preferences":
+			(out.Preferences).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -4754,47 +10256,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels48(out *jwriter.Writer, in PaymentInitConfirmation) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels107(out *jwriter.Writer, in EmailRecipient) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"Email\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"return_url\":"
+		const prefix string = ",\"Preferences\":"
 		out.RawString(prefix)
-		out.String(string(in.ReturnURL))
+		(in.Preferences).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentInitConfirmation) MarshalJSON() ([]byte, error) {
+func (v EmailRecipient) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels48(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels107(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentInitConfirmation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels48(w, v)
+func (v EmailRecipient) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels107(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentInitConfirmation) UnmarshalJSON(data []byte) error {
+func (v *EmailRecipient) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels107(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentInitConfirmation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels48(l, v)
+func (v *EmailRecipient) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels107(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(in *jlexer.Lexer, out *PaymentInitAmount) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels108(in *jlexer.Lexer, out *EmailPreferences) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4813,10 +10315,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(in *jl
 			continue
 		}
 		switch key {
-		case "value":
-			out.Value = string(in.String())
-		case "currency":
-			out.Currency = string(in.String())
+		case "orders":
+			out.Orders = bool(in.Bool())
+		case "payments":
+			out.Payments = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -4827,47 +10329,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels49(out *jwriter.Writer, in PaymentInitAmount) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels108(out *jwriter.Writer, in EmailPreferences) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"value\":"
+		const prefix string = ",\"orders\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Value))
+		out.Bool(bool(in.Orders))
 	}
 	{
-		const prefix string = ",\"currency\":"
+		const prefix string = ",\"payments\":"
 		out.RawString(prefix)
-		out.String(string(in.Currency))
+		out.Bool(bool(in.Payments))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentInitAmount) MarshalJSON() ([]byte, error) {
+func (v EmailPreferences) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels49(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels108(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentInitAmount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels49(w, v)
+func (v EmailPreferences) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels108(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentInitAmount) UnmarshalJSON(data []byte) error {
+func (v *EmailPreferences) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels108(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentInitAmount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels49(l, v)
+func (v *EmailPreferences) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels108(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(in *jlexer.Lexer, out *PaymentFormResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels109(in *jlexer.Lexer, out *EmailConfirmationSent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4886,8 +10388,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(in *jl
 			continue
 		}
 		switch key {
-		case "paymentFormUrl":
-			out.PaymentFormURL = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "resendIn":
+			out.ResendIn = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4898,42 +10402,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels50(out *jwriter.Writer, in PaymentFormResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels109(out *jwriter.Writer, in EmailConfirmationSent) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"paymentFormUrl\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.PaymentFormURL))
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"resendIn\":"
+		out.RawString(prefix)
+		out.Int(int(in.ResendIn))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentFormResponse) MarshalJSON() ([]byte, error) {
+func (v EmailConfirmationSent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels50(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels109(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentFormResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels50(w, v)
+func (v EmailConfirmationSent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels109(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentFormResponse) UnmarshalJSON(data []byte) error {
+func (v *EmailConfirmationSent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels109(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentFormResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels50(l, v)
+func (v *EmailConfirmationSent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels109(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(in *jlexer.Lexer, out *Payment) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels110(in *jlexer.Lexer, out *EditProfileNec) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4952,50 +10461,22 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(in *jl
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "status":
-			out.Status = string(in.String())
-		case "amount":
-			(out.Amount).UnmarshalEasyJSON(in)
-		case "description":
-			out.Description = string(in.String())
-		case "recipient":
-			(out.Recipient).UnmarshalEasyJSON(in)
-		case "payment_method":
-			(out.PaymentMethod).UnmarshalEasyJSON(in)
-		case "created_at":
-			out.CreatedAt = string(in.String())
-		case "confirmation":
-			(out.Confirmation).UnmarshalEasyJSON(in)
-		case "test":
-			out.Test = bool(in.Bool())
-		case "paid":
-			out.Paid = bool(in.Bool())
-		case "refundable":
-			out.Refundable = bool(in.Bool())
-		case "metadata":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.Metadata = make(map[string]interface{})
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v50 interface{}
-					if m, ok := v50.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v50.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v50 = in.Interface()
-					}
-					(out.Metadata)[key] = v50
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "city":
+			(out.City).UnmarshalEasyJSON(in)
+		case "phone":
+			out.Phone = string(in.String())
+		case "merchantsName":
+			out.MerchantsName = string(in.String())
+		case "subersCount":
+			out.SubersCount = int(in.Int())
+		case "subonsCount":
+			out.SubonsCount = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -5006,119 +10487,77 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels51(out *jwriter.Writer, in Payment) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels110(out *jwriter.Writer, in EditProfileNec) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.String(string(in.Status))
-	}
-	{
-		const prefix string = ",\"amount\":"
-		out.RawString(prefix)
-		(in.Amount).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"recipient\":"
-		out.RawString(prefix)
-		(in.Recipient).MarshalEasyJSON(out)
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"payment_method\":"
+		const prefix string = ",\"surname\":"
 		out.RawString(prefix)
-		(in.PaymentMethod).MarshalEasyJSON(out)
+		out.String(string(in.Surname))
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"avatar\":"
 		out.RawString(prefix)
-		out.String(string(in.CreatedAt))
+		out.String(string(in.Avatar))
 	}
 	{
-		const prefix string = ",\"confirmation\":"
+		const prefix string = ",\"city\":"
 		out.RawString(prefix)
-		(in.Confirmation).MarshalEasyJSON(out)
+		(in.City).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"test\":"
+		const prefix string = ",\"phone\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Test))
+		out.String(string(in.Phone))
 	}
 	{
-		const prefix string = ",\"paid\":"
+		const prefix string = ",\"merchantsName\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Paid))
+		out.String(string(in.MerchantsName))
 	}
 	{
-		const prefix string = ",\"refundable\":"
+		const prefix string = ",\"subersCount\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Refundable))
+		out.Int(int(in.SubersCount))
 	}
 	{
-		const prefix string = ",\"metadata\":"
+		const prefix string = ",\"subonsCount\":"
 		out.RawString(prefix)
-		if in.Metadata == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v51First := true
-			for v51Name, v51Value := range in.Metadata {
-				if v51First {
-					v51First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v51Name))
-				out.RawByte(':')
-				if m, ok := v51Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v51Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v51Value))
-				}
-			}
-			out.RawByte('}')
-		}
+		out.Int(int(in.SubonsCount))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Payment) MarshalJSON() ([]byte, error) {
+func (v EditProfileNec) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels51(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels110(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Payment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels51(w, v)
+func (v EditProfileNec) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels110(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Payment) UnmarshalJSON(data []byte) error {
+func (v *EditProfileNec) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels110(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Payment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels51(l, v)
+func (v *EditProfileNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels110(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(in *jlexer.Lexer, out *OrderList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels111(in *jlexer.Lexer, out *DeliveryOption) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5137,39 +10576,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(in *jl
 			continue
 		}
 		switch key {
-		case "Items":
-			if in.IsNull() {
-				in.Skip()
-				out.Items = nil
-			} else {
-				in.Delim('[')
-				if out.Items == nil {
-					if !in.IsDelim(']') {
-						out.Items = make([]*OrderItem, 0, 8)
-					} else {
-						out.Items = []*OrderItem{}
-					}
-				} else {
-					out.Items = (out.Items)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v52 *OrderItem
-					if in.IsNull() {
-						in.Skip()
-						v52 = nil
-					} else {
-						if v52 == nil {
-							v52 = new(OrderItem)
-						}
-						(*v52).UnmarshalEasyJSON(in)
-					}
-					out.Items = append(out.Items, v52)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "Mux":
-			easyjsonD2b7633eDecodeSync(in, &out.Mux)
+		case "method":
+			out.Method = string(in.String())
+		case "price":
+			out.Price = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -5180,62 +10590,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels52(out *jwriter.Writer, in OrderList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels111(out *jwriter.Writer, in DeliveryOption) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Items\":"
+		const prefix string = ",\"method\":"
 		out.RawString(prefix[1:])
-		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v53, v54 := range in.Items {
-				if v53 > 0 {
-					out.RawByte(',')
-				}
-				if v54 == nil {
-					out.RawString("null")
-				} else {
-					(*v54).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Method))
 	}
 	{
-		const prefix string = ",\"Mux\":"
+		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		easyjsonD2b7633eEncodeSync(out, in.Mux)
+		out.Uint(uint(in.Price))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderList) MarshalJSON() ([]byte, error) {
+func (v DeliveryOption) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels52(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels111(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels52(w, v)
+func (v DeliveryOption) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels111(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderList) UnmarshalJSON(data []byte) error {
+func (v *DeliveryOption) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels111(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels52(l, v)
+func (v *DeliveryOption) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels111(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(in *jlexer.Lexer, out *OrderItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels112(in *jlexer.Lexer, out *DBInsertionUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5251,39 +10646,11 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(in *jl
 		if in.IsNull() {
 			in.Skip()
 			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = uint(in.Uint())
-		case "userId":
-			out.UserID = uint(in.Uint())
-		case "advertId":
-			out.AdvertID = uint(in.Uint())
-		case "status":
-			out.Status = string(in.String())
-		case "created":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Created).UnmarshalJSON(data))
-			}
-		case "updated":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Updated).UnmarshalJSON(data))
-			}
-		case "closed":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Closed).UnmarshalJSON(data))
-			}
-		case "phone":
-			out.Phone = string(in.String())
-		case "name":
-			out.Name = string(in.String())
+			continue
+		}
+		switch key {
 		case "email":
 			out.Email = string(in.String())
-		case "address":
-			out.Address = string(in.String())
-		case "deliveryPrice":
-			out.DeliveryPrice = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -5294,97 +10661,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels53(out *jwriter.Writer, in OrderItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels112(out *jwriter.Writer, in DBInsertionUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
-	}
-	{
-		const prefix string = ",\"userId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.UserID))
-	}
-	{
-		const prefix string = ",\"advertId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.AdvertID))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.String(string(in.Status))
-	}
-	{
-		const prefix string = ",\"created\":"
-		out.RawString(prefix)
-		out.Raw((in.Created).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updated\":"
-		out.RawString(prefix)
-		out.Raw((in.Updated).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"closed\":"
-		out.RawString(prefix)
-		out.Raw((in.Closed).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"phone\":"
-		out.RawString(prefix)
-		out.String(string(in.Phone))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
 	{
 		const prefix string = ",\"email\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		out.String(string(in.Email))
 	}
-	{
-		const prefix string = ",\"address\":"
-		out.RawString(prefix)
-		out.String(string(in.Address))
-	}
-	{
-		const prefix string = ",\"deliveryPrice\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.DeliveryPrice))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderItem) MarshalJSON() ([]byte, error) {
+func (v DBInsertionUser) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels53(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels112(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels53(w, v)
+func (v DBInsertionUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels112(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderItem) UnmarshalJSON(data []byte) error {
+func (v *DBInsertionUser) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels112(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels53(l, v)
+func (v *DBInsertionUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels112(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(in *jlexer.Lexer, out *OrderCreated) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels113(in *jlexer.Lexer, out *DBInsertionProfile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5403,8 +10715,16 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(in *jl
 			continue
 		}
 		switch key {
-		case "isCreated":
-			out.IsCreated = bool(in.Bool())
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "cityId":
+			out.CityID = uint(in.Uint())
+		case "phone":
+			out.Phone = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "surname":
+			out.Surname = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5415,42 +10735,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels54(out *jwriter.Writer, in OrderCreated) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels113(out *jwriter.Writer, in DBInsertionProfile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"isCreated\":"
+		const prefix string = ",\"userId\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsCreated))
+		out.Uint(uint(in.UserID))
+	}
+	{
+		const prefix string = ",\"cityId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.CityID))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"surname\":"
+		out.RawString(prefix)
+		out.String(string(in.Surname))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderCreated) MarshalJSON() ([]byte, error) {
+func (v DBInsertionProfile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels54(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels113(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderCreated) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels54(w, v)
+func (v DBInsertionProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels113(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderCreated) UnmarshalJSON(data []byte) error {
+func (v *DBInsertionProfile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels113(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderCreated) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels54(l, v)
+func (v *DBInsertionProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels113(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(in *jlexer.Lexer, out *OkResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels114(in *jlexer.Lexer, out *DBInsertionAdvert) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5469,16 +10809,18 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(in *jl
 			continue
 		}
 		switch key {
-		case "code":
-			out.Code = int(in.Int())
-		case "items":
-			if m, ok := out.Items.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Items.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Items = in.Interface()
-			}
+		case "userId":
+			out.UserID = uint(in.Uint())
+		case "cityId":
+			out.CityID = uint(in.Uint())
+		case "categoryId":
+			out.CategoryID = uint(in.Uint())
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "price":
+			out.Price = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -5489,53 +10831,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels55(out *jwriter.Writer, in OkResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels114(out *jwriter.Writer, in DBInsertionAdvert) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"code\":"
+		const prefix string = ",\"userId\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Code))
+		out.Uint(uint(in.UserID))
 	}
 	{
-		const prefix string = ",\"items\":"
+		const prefix string = ",\"cityId\":"
 		out.RawString(prefix)
-		if m, ok := in.Items.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Items.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Items))
-		}
+		out.Uint(uint(in.CityID))
+	}
+	{
+		const prefix string = ",\"categoryId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.CategoryID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Price))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OkResponse) MarshalJSON() ([]byte, error) {
+func (v DBInsertionAdvert) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels55(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels114(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OkResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels55(w, v)
+func (v DBInsertionAdvert) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels114(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OkResponse) UnmarshalJSON(data []byte) error {
+func (v *DBInsertionAdvert) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels114(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OkResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels55(l, v)
+func (v *DBInsertionAdvert) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels114(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(in *jlexer.Lexer, out *Image) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels115(in *jlexer.Lexer, out *Conversation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5554,6 +10910,36 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(in *jl
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "advertTitle":
+			out.AdvertTitle = string(in.String())
+		case "buyerId":
+			out.BuyerID = uint(in.Uint())
+		case "sellerId":
+			out.SellerID = uint(in.Uint())
+		case "lastMessage":
+			if in.IsNull() {
+				in.Skip()
+				out.LastMessage = nil
+			} else {
+				if out.LastMessage == nil {
+					out.LastMessage = new(Message)
+				}
+				(*out.LastMessage).UnmarshalEasyJSON(in)
+			}
+		case "unreadCount":
+			out.UnreadCount = uint(in.Uint())
+		case "created":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedTime).UnmarshalJSON(data))
+			}
+		case "updated":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedTime).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5564,37 +10950,82 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels56(out *jwriter.Writer, in Image) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels115(out *jwriter.Writer, in Conversation) {
 	out.RawByte('{')
 	first := true
 	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"advertTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.AdvertTitle))
+	}
+	{
+		const prefix string = ",\"buyerId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.BuyerID))
+	}
+	{
+		const prefix string = ",\"sellerId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.SellerID))
+	}
+	if in.LastMessage != nil {
+		const prefix string = ",\"lastMessage\":"
+		out.RawString(prefix)
+		(*in.LastMessage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"unreadCount\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UnreadCount))
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updated\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedTime).MarshalJSON())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Image) MarshalJSON() ([]byte, error) {
+func (v Conversation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels56(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels115(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Image) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels56(w, v)
+func (v Conversation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels115(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Image) UnmarshalJSON(data []byte) error {
+func (v *Conversation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels115(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Image) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels56(l, v)
+func (v *Conversation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels115(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(in *jlexer.Lexer, out *ErrResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels116(in *jlexer.Lexer, out *Confirmation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5610,13 +11041,15 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(in *jl
 		if in.IsNull() {
 			in.Skip()
 			in.WantComma()
-			continue
-		}
-		switch key {
-		case "code":
-			out.Code = int(in.Int())
-		case "status":
-			out.Status = string(in.String())
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "return_url":
+			out.ReturnURL = string(in.String())
+		case "confirmation_url":
+			out.ConfirmationURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5627,47 +11060,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels57(out *jwriter.Writer, in ErrResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels116(out *jwriter.Writer, in Confirmation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"code\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Code))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"return_url\":"
 		out.RawString(prefix)
-		out.String(string(in.Status))
+		out.String(string(in.ReturnURL))
+	}
+	{
+		const prefix string = ",\"confirmation_url\":"
+		out.RawString(prefix)
+		out.String(string(in.ConfirmationURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ErrResponse) MarshalJSON() ([]byte, error) {
+func (v Confirmation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels57(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels116(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ErrResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels57(w, v)
+func (v Confirmation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels116(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ErrResponse) UnmarshalJSON(data []byte) error {
+func (v *Confirmation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels116(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ErrResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels57(l, v)
+func (v *Confirmation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels116(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(in *jlexer.Lexer, out *EditProfileNec) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels117(in *jlexer.Lexer, out *CitySuggestions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5686,22 +11124,39 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(in *jl
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
-		case "avatar":
-			out.Avatar = string(in.String())
-		case "city":
-			(out.City).UnmarshalEasyJSON(in)
-		case "phone":
-			out.Phone = string(in.String())
-		case "merchantsName":
-			out.MerchantsName = string(in.String())
-		case "subersCount":
-			out.SubersCount = int(in.Int())
-		case "subonsCount":
-			out.SubonsCount = int(in.Int())
+		case "query":
+			out.Query = string(in.String())
+		case "cities":
+			if in.IsNull() {
+				in.Skip()
+				out.Cities = nil
+			} else {
+				in.Delim('[')
+				if out.Cities == nil {
+					if !in.IsDelim(']') {
+						out.Cities = make([]*City, 0, 8)
+					} else {
+						out.Cities = []*City{}
+					}
+				} else {
+					out.Cities = (out.Cities)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 *City
+					if in.IsNull() {
+						in.Skip()
+						v94 = nil
+					} else {
+						if v94 == nil {
+							v94 = new(City)
+						}
+						(*v94).UnmarshalEasyJSON(in)
+					}
+					out.Cities = append(out.Cities, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5712,77 +11167,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels58(out *jwriter.Writer, in EditProfileNec) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels117(out *jwriter.Writer, in CitySuggestions) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"query\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"surname\":"
-		out.RawString(prefix)
-		out.String(string(in.Surname))
-	}
-	{
-		const prefix string = ",\"avatar\":"
-		out.RawString(prefix)
-		out.String(string(in.Avatar))
-	}
-	{
-		const prefix string = ",\"city\":"
-		out.RawString(prefix)
-		(in.City).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"phone\":"
-		out.RawString(prefix)
-		out.String(string(in.Phone))
+		out.String(string(in.Query))
 	}
 	{
-		const prefix string = ",\"merchantsName\":"
-		out.RawString(prefix)
-		out.String(string(in.MerchantsName))
-	}
-	{
-		const prefix string = ",\"subersCount\":"
-		out.RawString(prefix)
-		out.Int(int(in.SubersCount))
-	}
-	{
-		const prefix string = ",\"subonsCount\":"
+		const prefix string = ",\"cities\":"
 		out.RawString(prefix)
-		out.Int(int(in.SubonsCount))
+		if in.Cities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.Cities {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				if v96 == nil {
+					out.RawString("null")
+				} else {
+					(*v96).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EditProfileNec) MarshalJSON() ([]byte, error) {
+func (v CitySuggestions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels58(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels117(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EditProfileNec) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels58(w, v)
+func (v CitySuggestions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels117(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EditProfileNec) UnmarshalJSON(data []byte) error {
+func (v *CitySuggestions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels117(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EditProfileNec) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels58(l, v)
+func (v *CitySuggestions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels117(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(in *jlexer.Lexer, out *DBInsertionUser) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels118(in *jlexer.Lexer, out *CityList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5801,8 +11241,70 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(in *jl
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
+		case "CityItems":
+			if in.IsNull() {
+				in.Skip()
+				out.CityItems = nil
+			} else {
+				in.Delim('[')
+				if out.CityItems == nil {
+					if !in.IsDelim(']') {
+						out.CityItems = make([]*City, 0, 8)
+					} else {
+						out.CityItems = []*City{}
+					}
+				} else {
+					out.CityItems = (out.CityItems)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 *City
+					if in.IsNull() {
+						in.Skip()
+						v97 = nil
+					} else {
+						if v97 == nil {
+							v97 = new(City)
+						}
+						(*v97).UnmarshalEasyJSON(in)
+					}
+					out.CityItems = append(out.CityItems, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "regions":
+			if in.IsNull() {
+				in.Skip()
+				out.Regions = nil
+			} else {
+				in.Delim('[')
+				if out.Regions == nil {
+					if !in.IsDelim(']') {
+						out.Regions = make([]*Region, 0, 8)
+					} else {
+						out.Regions = []*Region{}
+					}
+				} else {
+					out.Regions = (out.Regions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v98 *Region
+					if in.IsNull() {
+						in.Skip()
+						v98 = nil
+					} else {
+						if v98 == nil {
+							v98 = new(Region)
+						}
+						(*v98).UnmarshalEasyJSON(in)
+					}
+					out.Regions = append(out.Regions, v98)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Mux":
+			easyjsonD2b7633eDecodeSync(in, &out.Mux)
 		default:
 			in.SkipRecursive()
 		}
@@ -5813,42 +11315,82 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels59(out *jwriter.Writer, in DBInsertionUser) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels118(out *jwriter.Writer, in CityList) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"CityItems\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
+		if in.CityItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v99, v100 := range in.CityItems {
+				if v99 > 0 {
+					out.RawByte(',')
+				}
+				if v100 == nil {
+					out.RawString("null")
+				} else {
+					(*v100).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"regions\":"
+		out.RawString(prefix)
+		if in.Regions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Regions {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				if v102 == nil {
+					out.RawString("null")
+				} else {
+					(*v102).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Mux\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeSync(out, in.Mux)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DBInsertionUser) MarshalJSON() ([]byte, error) {
+func (v CityList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels59(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels118(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DBInsertionUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels59(w, v)
+func (v CityList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels118(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DBInsertionUser) UnmarshalJSON(data []byte) error {
+func (v *CityList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels118(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DBInsertionUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels59(l, v)
+func (v *CityList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels118(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(in *jlexer.Lexer, out *DBInsertionProfile) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels119(in *jlexer.Lexer, out *City) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5867,16 +11409,24 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(in *jl
 			continue
 		}
 		switch key {
-		case "userId":
-			out.UserID = uint(in.Uint())
-		case "cityId":
-			out.CityID = uint(in.Uint())
-		case "phone":
-			out.Phone = string(in.String())
+		case "id":
+			out.ID = uint(in.Uint())
 		case "name":
-			out.Name = string(in.String())
-		case "surname":
-			out.Surname = string(in.String())
+			out.CityName = string(in.String())
+		case "translation":
+			out.Translation = string(in.String())
+		case "regionId":
+			if in.IsNull() {
+				in.Skip()
+				out.RegionID = nil
+			} else {
+				if out.RegionID == nil {
+					out.RegionID = new(uint)
+				}
+				*out.RegionID = uint(in.Uint())
+			}
+		case "advertsNum":
+			out.AdvertsNum = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -5887,62 +11437,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels60(out *jwriter.Writer, in DBInsertionProfile) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels119(out *jwriter.Writer, in City) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"userId\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.UserID))
+		out.Uint(uint(in.ID))
 	}
 	{
-		const prefix string = ",\"cityId\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.CityID))
+		out.String(string(in.CityName))
 	}
 	{
-		const prefix string = ",\"phone\":"
+		const prefix string = ",\"translation\":"
 		out.RawString(prefix)
-		out.String(string(in.Phone))
-	}
-	{
-		const prefix string = ",\"name\":"
+		out.String(string(in.Translation))
+	}
+	if in.RegionID != nil {
+		const prefix string = ",\"regionId\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.Uint(uint(*in.RegionID))
 	}
 	{
-		const prefix string = ",\"surname\":"
+		const prefix string = ",\"advertsNum\":"
 		out.RawString(prefix)
-		out.String(string(in.Surname))
+		out.Uint(uint(in.AdvertsNum))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DBInsertionProfile) MarshalJSON() ([]byte, error) {
+func (v City) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels60(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels119(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DBInsertionProfile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels60(w, v)
+func (v City) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels119(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DBInsertionProfile) UnmarshalJSON(data []byte) error {
+func (v *City) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels119(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DBInsertionProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels60(l, v)
+func (v *City) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels119(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(in *jlexer.Lexer, out *DBInsertionAdvert) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels120(in *jlexer.Lexer, out *ChatEvent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5961,18 +11511,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(in *jl
 			continue
 		}
 		switch key {
-		case "userId":
-			out.UserID = uint(in.Uint())
-		case "cityId":
-			out.CityID = uint(in.Uint())
-		case "categoryId":
-			out.CategoryID = uint(in.Uint())
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "price":
-			out.Price = uint(in.Uint())
+		case "type":
+			out.Type = string(in.String())
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+				out.Message = nil
+			} else {
+				if out.Message == nil {
+					out.Message = new(Message)
+				}
+				(*out.Message).UnmarshalEasyJSON(in)
+			}
+		case "read":
+			if in.IsNull() {
+				in.Skip()
+				out.Read = nil
+			} else {
+				if out.Read == nil {
+					out.Read = new(MessagesRead)
+				}
+				(*out.Read).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5983,67 +11543,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels61(out *jwriter.Writer, in DBInsertionAdvert) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels120(out *jwriter.Writer, in ChatEvent) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"userId\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.UserID))
-	}
-	{
-		const prefix string = ",\"cityId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.CityID))
-	}
-	{
-		const prefix string = ",\"categoryId\":"
-		out.RawString(prefix)
-		out.Uint(uint(in.CategoryID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.String(string(in.Type))
 	}
-	{
-		const prefix string = ",\"description\":"
+	if in.Message != nil {
+		const prefix string = ",\"message\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		(*in.Message).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"price\":"
+	if in.Read != nil {
+		const prefix string = ",\"read\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Price))
+		(*in.Read).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DBInsertionAdvert) MarshalJSON() ([]byte, error) {
+func (v ChatEvent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels61(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels120(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DBInsertionAdvert) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels61(w, v)
+func (v ChatEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels120(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DBInsertionAdvert) UnmarshalJSON(data []byte) error {
+func (v *ChatEvent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels120(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DBInsertionAdvert) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels61(l, v)
+func (v *ChatEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels120(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(in *jlexer.Lexer, out *Confirmation) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels121(in *jlexer.Lexer, out *CategoryTree) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6062,12 +11607,39 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(in *jl
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "return_url":
-			out.ReturnURL = string(in.String())
-		case "confirmation_url":
-			out.ConfirmationURL = string(in.String())
+		case "city":
+			out.City = string(in.String())
+		case "categories":
+			if in.IsNull() {
+				in.Skip()
+				out.Categories = nil
+			} else {
+				in.Delim('[')
+				if out.Categories == nil {
+					if !in.IsDelim(']') {
+						out.Categories = make([]*CategoryNode, 0, 8)
+					} else {
+						out.Categories = []*CategoryNode{}
+					}
+				} else {
+					out.Categories = (out.Categories)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v103 *CategoryNode
+					if in.IsNull() {
+						in.Skip()
+						v103 = nil
+					} else {
+						if v103 == nil {
+							v103 = new(CategoryNode)
+						}
+						(*v103).UnmarshalEasyJSON(in)
+					}
+					out.Categories = append(out.Categories, v103)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -6078,52 +11650,62 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels62(out *jwriter.Writer, in Confirmation) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels121(out *jwriter.Writer, in CategoryTree) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"city\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"return_url\":"
-		out.RawString(prefix)
-		out.String(string(in.ReturnURL))
+		out.String(string(in.City))
 	}
 	{
-		const prefix string = ",\"confirmation_url\":"
+		const prefix string = ",\"categories\":"
 		out.RawString(prefix)
-		out.String(string(in.ConfirmationURL))
+		if in.Categories == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v104, v105 := range in.Categories {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				if v105 == nil {
+					out.RawString("null")
+				} else {
+					(*v105).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Confirmation) MarshalJSON() ([]byte, error) {
+func (v CategoryTree) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels62(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels121(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Confirmation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels62(w, v)
+func (v CategoryTree) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels121(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Confirmation) UnmarshalJSON(data []byte) error {
+func (v *CategoryTree) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels121(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Confirmation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels62(l, v)
+func (v *CategoryTree) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels121(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(in *jlexer.Lexer, out *CityList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels122(in *jlexer.Lexer, out *CategoryNode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6142,39 +11724,55 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(in *jl
 			continue
 		}
 		switch key {
-		case "CityItems":
+		case "id":
+			out.ID = uint(in.Uint())
+		case "parentId":
 			if in.IsNull() {
 				in.Skip()
-				out.CityItems = nil
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uint)
+				}
+				*out.ParentID = uint(in.Uint())
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "translation":
+			out.Translation = string(in.String())
+		case "advertsNum":
+			out.AdvertsNum = uint(in.Uint())
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
 			} else {
 				in.Delim('[')
-				if out.CityItems == nil {
+				if out.Children == nil {
 					if !in.IsDelim(']') {
-						out.CityItems = make([]*City, 0, 8)
+						out.Children = make([]*CategoryNode, 0, 8)
 					} else {
-						out.CityItems = []*City{}
+						out.Children = []*CategoryNode{}
 					}
 				} else {
-					out.CityItems = (out.CityItems)[:0]
+					out.Children = (out.Children)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v55 *City
+					var v106 *CategoryNode
 					if in.IsNull() {
 						in.Skip()
-						v55 = nil
+						v106 = nil
 					} else {
-						if v55 == nil {
-							v55 = new(City)
+						if v106 == nil {
+							v106 = new(CategoryNode)
 						}
-						(*v55).UnmarshalEasyJSON(in)
+						(*v106).UnmarshalEasyJSON(in)
 					}
-					out.CityItems = append(out.CityItems, v55)
+					out.Children = append(out.Children, v106)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Mux":
-			easyjsonD2b7633eDecodeSync(in, &out.Mux)
 		default:
 			in.SkipRecursive()
 		}
@@ -6185,62 +11783,86 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels63(out *jwriter.Writer, in CityList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels122(out *jwriter.Writer, in CategoryNode) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"CityItems\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		if in.CityItems == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		if in.ParentID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint(uint(*in.ParentID))
+		}
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"translation\":"
+		out.RawString(prefix)
+		out.String(string(in.Translation))
+	}
+	{
+		const prefix string = ",\"advertsNum\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.AdvertsNum))
+	}
+	{
+		const prefix string = ",\"children\":"
+		out.RawString(prefix)
+		if in.Children == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v56, v57 := range in.CityItems {
-				if v56 > 0 {
+			for v107, v108 := range in.Children {
+				if v107 > 0 {
 					out.RawByte(',')
 				}
-				if v57 == nil {
+				if v108 == nil {
 					out.RawString("null")
 				} else {
-					(*v57).MarshalEasyJSON(out)
+					(*v108).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"Mux\":"
-		out.RawString(prefix)
-		easyjsonD2b7633eEncodeSync(out, in.Mux)
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CityList) MarshalJSON() ([]byte, error) {
+func (v CategoryNode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels63(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels122(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CityList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels63(w, v)
+func (v CategoryNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels122(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CityList) UnmarshalJSON(data []byte) error {
+func (v *CategoryNode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels122(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CityList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels63(l, v)
+func (v *CategoryNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels122(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(in *jlexer.Lexer, out *City) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels123(in *jlexer.Lexer, out *Category) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6262,7 +11884,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(in *jl
 		case "id":
 			out.ID = uint(in.Uint())
 		case "name":
-			out.CityName = string(in.String())
+			out.Name = string(in.String())
 		case "translation":
 			out.Translation = string(in.String())
 		default:
@@ -6275,7 +11897,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(out *jwriter.Writer, in City) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels123(out *jwriter.Writer, in Category) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6287,7 +11909,7 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(out *j
 	{
 		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.CityName))
+		out.String(string(in.Name))
 	}
 	{
 		const prefix string = ",\"translation\":"
@@ -6298,29 +11920,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(out *j
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v City) MarshalJSON() ([]byte, error) {
+func (v Category) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels123(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v City) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels64(w, v)
+func (v Category) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels123(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *City) UnmarshalJSON(data []byte) error {
+func (v *Category) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels123(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *City) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels64(l, v)
+func (v *Category) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels123(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(in *jlexer.Lexer, out *Category) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels124(in *jlexer.Lexer, out *CartSellerGroup) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6339,12 +11961,78 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(in *jl
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint(in.Uint())
-		case "name":
-			out.Name = string(in.String())
-		case "translation":
-			out.Translation = string(in.String())
+		case "sellerId":
+			out.SellerID = uint(in.Uint())
+		case "adverts":
+			if in.IsNull() {
+				in.Skip()
+				out.Adverts = nil
+			} else {
+				in.Delim('[')
+				if out.Adverts == nil {
+					if !in.IsDelim(']') {
+						out.Adverts = make([]*ReturningAdvert, 0, 8)
+					} else {
+						out.Adverts = []*ReturningAdvert{}
+					}
+				} else {
+					out.Adverts = (out.Adverts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v109 *ReturningAdvert
+					if in.IsNull() {
+						in.Skip()
+						v109 = nil
+					} else {
+						if v109 == nil {
+							v109 = new(ReturningAdvert)
+						}
+						(*v109).UnmarshalEasyJSON(in)
+					}
+					out.Adverts = append(out.Adverts, v109)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "subtotal":
+			out.Subtotal = uint(in.Uint())
+		case "deliveryOptions":
+			if in.IsNull() {
+				in.Skip()
+				out.DeliveryOptions = nil
+			} else {
+				in.Delim('[')
+				if out.DeliveryOptions == nil {
+					if !in.IsDelim(']') {
+						out.DeliveryOptions = make([]*DeliveryOption, 0, 8)
+					} else {
+						out.DeliveryOptions = []*DeliveryOption{}
+					}
+				} else {
+					out.DeliveryOptions = (out.DeliveryOptions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v110 *DeliveryOption
+					if in.IsNull() {
+						in.Skip()
+						v110 = nil
+					} else {
+						if v110 == nil {
+							v110 = new(DeliveryOption)
+						}
+						(*v110).UnmarshalEasyJSON(in)
+					}
+					out.DeliveryOptions = append(out.DeliveryOptions, v110)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "deliveryMethod":
+			out.DeliveryMethod = string(in.String())
+		case "deliveryPrice":
+			out.DeliveryPrice = uint(in.Uint())
+		case "total":
+			out.Total = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -6355,52 +12043,102 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels65(out *jwriter.Writer, in Category) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels124(out *jwriter.Writer, in CartSellerGroup) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"sellerId\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.ID))
+		out.Uint(uint(in.SellerID))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"adverts\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		if in.Adverts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v111, v112 := range in.Adverts {
+				if v111 > 0 {
+					out.RawByte(',')
+				}
+				if v112 == nil {
+					out.RawString("null")
+				} else {
+					(*v112).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"translation\":"
+		const prefix string = ",\"subtotal\":"
 		out.RawString(prefix)
-		out.String(string(in.Translation))
+		out.Uint(uint(in.Subtotal))
+	}
+	{
+		const prefix string = ",\"deliveryOptions\":"
+		out.RawString(prefix)
+		if in.DeliveryOptions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v113, v114 := range in.DeliveryOptions {
+				if v113 > 0 {
+					out.RawByte(',')
+				}
+				if v114 == nil {
+					out.RawString("null")
+				} else {
+					(*v114).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"deliveryMethod\":"
+		out.RawString(prefix)
+		out.String(string(in.DeliveryMethod))
+	}
+	{
+		const prefix string = ",\"deliveryPrice\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.DeliveryPrice))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Total))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Category) MarshalJSON() ([]byte, error) {
+func (v CartSellerGroup) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels65(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels124(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Category) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels65(w, v)
+func (v CartSellerGroup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels124(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Category) UnmarshalJSON(data []byte) error {
+func (v *CartSellerGroup) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels124(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Category) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels65(l, v)
+func (v *CartSellerGroup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels124(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(in *jlexer.Lexer, out *CartList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels125(in *jlexer.Lexer, out *CartList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6435,17 +12173,17 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(in *jl
 					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 *CartItem
+					var v115 *CartItem
 					if in.IsNull() {
 						in.Skip()
-						v58 = nil
+						v115 = nil
 					} else {
-						if v58 == nil {
-							v58 = new(CartItem)
+						if v115 == nil {
+							v115 = new(CartItem)
 						}
-						(*v58).UnmarshalEasyJSON(in)
+						(*v115).UnmarshalEasyJSON(in)
 					}
-					out.Items = append(out.Items, v58)
+					out.Items = append(out.Items, v115)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6462,7 +12200,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(out *jwriter.Writer, in CartList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels125(out *jwriter.Writer, in CartList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6473,14 +12211,14 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(out *j
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.Items {
-				if v59 > 0 {
+			for v116, v117 := range in.Items {
+				if v116 > 0 {
 					out.RawByte(',')
 				}
-				if v60 == nil {
+				if v117 == nil {
 					out.RawString("null")
 				} else {
-					(*v60).MarshalEasyJSON(out)
+					(*v117).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -6497,27 +12235,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v CartList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels125(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CartList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels66(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels125(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CartList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels125(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CartList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels66(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels125(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(in *jlexer.Lexer, out *CartItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels126(in *jlexer.Lexer, out *CartItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6550,7 +12288,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(out *jwriter.Writer, in CartItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels126(out *jwriter.Writer, in CartItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6570,27 +12308,209 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v CartItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels126(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CartItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels67(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels126(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CartItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels126(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CartItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels67(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels126(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels127(in *jlexer.Lexer, out *CartContent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sellers":
+			if in.IsNull() {
+				in.Skip()
+				out.Sellers = nil
+			} else {
+				in.Delim('[')
+				if out.Sellers == nil {
+					if !in.IsDelim(']') {
+						out.Sellers = make([]*CartSellerGroup, 0, 8)
+					} else {
+						out.Sellers = []*CartSellerGroup{}
+					}
+				} else {
+					out.Sellers = (out.Sellers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v118 *CartSellerGroup
+					if in.IsNull() {
+						in.Skip()
+						v118 = nil
+					} else {
+						if v118 == nil {
+							v118 = new(CartSellerGroup)
+						}
+						(*v118).UnmarshalEasyJSON(in)
+					}
+					out.Sellers = append(out.Sellers, v118)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "unavailable":
+			if in.IsNull() {
+				in.Skip()
+				out.Unavailable = nil
+			} else {
+				in.Delim('[')
+				if out.Unavailable == nil {
+					if !in.IsDelim(']') {
+						out.Unavailable = make([]*UnavailableCartItem, 0, 8)
+					} else {
+						out.Unavailable = []*UnavailableCartItem{}
+					}
+				} else {
+					out.Unavailable = (out.Unavailable)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v119 *UnavailableCartItem
+					if in.IsNull() {
+						in.Skip()
+						v119 = nil
+					} else {
+						if v119 == nil {
+							v119 = new(UnavailableCartItem)
+						}
+						(*v119).UnmarshalEasyJSON(in)
+					}
+					out.Unavailable = append(out.Unavailable, v119)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "subtotal":
+			out.Subtotal = uint(in.Uint())
+		case "deliveryPrice":
+			out.DeliveryPrice = uint(in.Uint())
+		case "total":
+			out.Total = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels127(out *jwriter.Writer, in CartContent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sellers\":"
+		out.RawString(prefix[1:])
+		if in.Sellers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v120, v121 := range in.Sellers {
+				if v120 > 0 {
+					out.RawByte(',')
+				}
+				if v121 == nil {
+					out.RawString("null")
+				} else {
+					(*v121).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"unavailable\":"
+		out.RawString(prefix)
+		if in.Unavailable == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v122, v123 := range in.Unavailable {
+				if v122 > 0 {
+					out.RawByte(',')
+				}
+				if v123 == nil {
+					out.RawString("null")
+				} else {
+					(*v123).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"subtotal\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Subtotal))
+	}
+	{
+		const prefix string = ",\"deliveryPrice\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.DeliveryPrice))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CartContent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels127(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CartContent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels127(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CartContent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels127(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CartContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels127(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(in *jlexer.Lexer, out *CardProduct) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels128(in *jlexer.Lexer, out *CardProduct) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6621,7 +12541,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(out *jwriter.Writer, in CardProduct) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels128(out *jwriter.Writer, in CardProduct) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6636,27 +12556,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v CardProduct) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels128(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CardProduct) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels68(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels128(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CardProduct) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels128(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CardProduct) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels68(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels128(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(in *jlexer.Lexer, out *Card) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels129(in *jlexer.Lexer, out *Card) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6699,7 +12619,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(out *jwriter.Writer, in Card) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels129(out *jwriter.Writer, in Card) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6744,27 +12664,93 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v Card) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels129(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Card) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels69(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels129(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Card) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels129(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Card) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels129(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels130(in *jlexer.Lexer, out *CSRFToken) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "TokenBody":
+			out.TokenBody = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels130(out *jwriter.Writer, in CSRFToken) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"TokenBody\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TokenBody))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSRFToken) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels130(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSRFToken) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels130(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSRFToken) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels130(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Card) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels69(l, v)
+func (v *CSRFToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels130(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(in *jlexer.Lexer, out *CSRFToken) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels131(in *jlexer.Lexer, out *BumpStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6783,8 +12769,32 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(in *jl
 			continue
 		}
 		switch key {
-		case "TokenBody":
-			out.TokenBody = string(in.String())
+		case "lastBump":
+			if in.IsNull() {
+				in.Skip()
+				out.LastBump = nil
+			} else {
+				if out.LastBump == nil {
+					out.LastBump = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LastBump).UnmarshalJSON(data))
+				}
+			}
+		case "bumpsInWindow":
+			out.BumpsInWindow = int(in.Int())
+		case "pendingSince":
+			if in.IsNull() {
+				in.Skip()
+				out.PendingSince = nil
+			} else {
+				if out.PendingSince == nil {
+					out.PendingSince = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PendingSince).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -6795,42 +12805,60 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels70(out *jwriter.Writer, in CSRFToken) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels131(out *jwriter.Writer, in BumpStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"TokenBody\":"
+		const prefix string = ",\"lastBump\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.TokenBody))
+		if in.LastBump == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.LastBump).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"bumpsInWindow\":"
+		out.RawString(prefix)
+		out.Int(int(in.BumpsInWindow))
+	}
+	{
+		const prefix string = ",\"pendingSince\":"
+		out.RawString(prefix)
+		if in.PendingSince == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.PendingSince).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSRFToken) MarshalJSON() ([]byte, error) {
+func (v BumpStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels70(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels131(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSRFToken) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels70(w, v)
+func (v BumpStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels131(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSRFToken) UnmarshalJSON(data []byte) error {
+func (v *BumpStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels131(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSRFToken) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels70(l, v)
+func (v *BumpStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels131(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(in *jlexer.Lexer, out *AuthorizationDetails) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels132(in *jlexer.Lexer, out *AuthorizationDetails) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6865,7 +12893,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(out *jwriter.Writer, in AuthorizationDetails) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels132(out *jwriter.Writer, in AuthorizationDetails) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6890,27 +12918,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v AuthorizationDetails) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels132(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthorizationDetails) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels71(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels132(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthorizationDetails) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels132(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthorizationDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels71(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels132(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(in *jlexer.Lexer, out *AuthResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels133(in *jlexer.Lexer, out *AuthResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6945,7 +12973,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(out *jwriter.Writer, in AuthResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels133(out *jwriter.Writer, in AuthResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6970,27 +12998,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v AuthResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels133(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels72(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels133(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels133(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels72(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels133(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(in *jlexer.Lexer, out *Appended) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels134(in *jlexer.Lexer, out *Appended) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7021,7 +13049,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(out *jwriter.Writer, in Appended) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels134(out *jwriter.Writer, in Appended) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7036,27 +13064,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v Appended) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels134(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Appended) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels73(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels134(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Appended) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels134(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Appended) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels73(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels134(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(in *jlexer.Lexer, out *Amount) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels135(in *jlexer.Lexer, out *Amount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7089,7 +13117,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(out *jwriter.Writer, in Amount) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels135(out *jwriter.Writer, in Amount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7109,27 +13137,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v Amount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels135(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Amount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels74(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels135(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Amount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels135(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Amount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels74(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels135(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(in *jlexer.Lexer, out *AdvertsList) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels136(in *jlexer.Lexer, out *AdvertsList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7164,17 +13192,17 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(in *jl
 					out.Adverts = (out.Adverts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v61 *Advert
+					var v124 *Advert
 					if in.IsNull() {
 						in.Skip()
-						v61 = nil
+						v124 = nil
 					} else {
-						if v61 == nil {
-							v61 = new(Advert)
+						if v124 == nil {
+							v124 = new(Advert)
 						}
-						(*v61).UnmarshalEasyJSON(in)
+						(*v124).UnmarshalEasyJSON(in)
 					}
-					out.Adverts = append(out.Adverts, v61)
+					out.Adverts = append(out.Adverts, v124)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7195,17 +13223,17 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(in *jl
 					out.Categories = (out.Categories)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v62 *Category
+					var v125 *Category
 					if in.IsNull() {
 						in.Skip()
-						v62 = nil
+						v125 = nil
 					} else {
-						if v62 == nil {
-							v62 = new(Category)
+						if v125 == nil {
+							v125 = new(Category)
 						}
-						(*v62).UnmarshalEasyJSON(in)
+						(*v125).UnmarshalEasyJSON(in)
 					}
-					out.Categories = append(out.Categories, v62)
+					out.Categories = append(out.Categories, v125)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7226,17 +13254,17 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(in *jl
 					out.Cities = (out.Cities)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v63 *City
+					var v126 *City
 					if in.IsNull() {
 						in.Skip()
-						v63 = nil
+						v126 = nil
 					} else {
-						if v63 == nil {
-							v63 = new(City)
+						if v126 == nil {
+							v126 = new(City)
 						}
-						(*v63).UnmarshalEasyJSON(in)
+						(*v126).UnmarshalEasyJSON(in)
 					}
-					out.Cities = append(out.Cities, v63)
+					out.Cities = append(out.Cities, v126)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7259,7 +13287,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(out *jwriter.Writer, in AdvertsList) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels136(out *jwriter.Writer, in AdvertsList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7270,14 +13298,14 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(out *j
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v64, v65 := range in.Adverts {
-				if v64 > 0 {
+			for v127, v128 := range in.Adverts {
+				if v127 > 0 {
 					out.RawByte(',')
 				}
-				if v65 == nil {
+				if v128 == nil {
 					out.RawString("null")
 				} else {
-					(*v65).MarshalEasyJSON(out)
+					(*v128).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -7290,14 +13318,14 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(out *j
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v66, v67 := range in.Categories {
-				if v66 > 0 {
+			for v129, v130 := range in.Categories {
+				if v129 > 0 {
 					out.RawByte(',')
 				}
-				if v67 == nil {
+				if v130 == nil {
 					out.RawString("null")
 				} else {
-					(*v67).MarshalEasyJSON(out)
+					(*v130).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -7310,14 +13338,14 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(out *j
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v68, v69 := range in.Cities {
-				if v68 > 0 {
+			for v131, v132 := range in.Cities {
+				if v131 > 0 {
 					out.RawByte(',')
 				}
-				if v69 == nil {
+				if v132 == nil {
 					out.RawString("null")
 				} else {
-					(*v69).MarshalEasyJSON(out)
+					(*v132).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -7349,27 +13377,158 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v AdvertsList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels136(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdvertsList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels75(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels136(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdvertsList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels136(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdvertsList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels75(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels136(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels137(in *jlexer.Lexer, out *AdvertImage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint(in.Uint())
+		case "advertId":
+			out.AdvertID = uint(in.Uint())
+		case "url":
+			out.URL = string(in.String())
+		case "urlResized":
+			out.URLResized = string(in.String())
+		case "position":
+			out.Position = uint(in.Uint())
+		case "renditions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Renditions = make(map[string]string)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v133 string
+					v133 = string(in.String())
+					(out.Renditions)[key] = v133
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels137(out *jwriter.Writer, in AdvertImage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.ID))
+	}
+	{
+		const prefix string = ",\"advertId\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.AdvertID))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"urlResized\":"
+		out.RawString(prefix)
+		out.String(string(in.URLResized))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Position))
+	}
+	{
+		const prefix string = ",\"renditions\":"
+		out.RawString(prefix)
+		if in.Renditions == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v134First := true
+			for v134Name, v134Value := range in.Renditions {
+				if v134First {
+					v134First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v134Name))
+				out.RawByte(':')
+				out.String(string(v134Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AdvertImage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels137(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AdvertImage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels137(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AdvertImage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels137(&r, v)
+	return r.Error()
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(in *jlexer.Lexer, out *Advert) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AdvertImage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels137(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels138(in *jlexer.Lexer, out *Advert) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7424,6 +13583,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(in *jl
 			out.InCart = bool(in.Bool())
 		case "favouritesNum":
 			out.FavouritesNum = uint(in.Uint())
+		case "reserved":
+			out.Reserved = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -7434,7 +13595,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(out *jwriter.Writer, in Advert) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels138(out *jwriter.Writer, in Advert) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7518,33 +13679,38 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(out *j
 		out.RawString(prefix)
 		out.Uint(uint(in.FavouritesNum))
 	}
+	{
+		const prefix string = ",\"reserved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Reserved))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Advert) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels138(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Advert) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels76(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels138(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Advert) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels138(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Advert) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels76(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels138(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(in *jlexer.Lexer, out *AdditionalUserData) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels139(in *jlexer.Lexer, out *AdditionalUserData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -7573,6 +13739,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(in *jl
 			out.CartNum = uint(in.Uint())
 		case "favNum":
 			out.FavNum = uint(in.Uint())
+		case "unreadMessagesNum":
+			out.UnreadNum = uint(in.Uint())
 		case "phoneNumber":
 			out.PhoneNumber = string(in.String())
 		default:
@@ -7585,7 +13753,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(in *jl
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(out *jwriter.Writer, in AdditionalUserData) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels139(out *jwriter.Writer, in AdditionalUserData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -7614,6 +13782,11 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(out *j
 		out.RawString(prefix)
 		out.Uint(uint(in.FavNum))
 	}
+	{
+		const prefix string = ",\"unreadMessagesNum\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.UnreadNum))
+	}
 	{
 		const prefix string = ",\"phoneNumber\":"
 		out.RawString(prefix)
@@ -7625,23 +13798,144 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v AdditionalUserData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels139(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdditionalUserData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels77(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels139(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdditionalUserData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels139(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdditionalUserData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels77(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels139(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels140(in *jlexer.Lexer, out *ActiveSession) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "device":
+			out.Device = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "userAgent":
+			out.UserAgent = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "lastSeen":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastSeen).UnmarshalJSON(data))
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "current":
+			out.Current = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels140(out *jwriter.Writer, in ActiveSession) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"device\":"
+		out.RawString(prefix)
+		out.String(string(in.Device))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"userAgent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"lastSeen\":"
+		out.RawString(prefix)
+		out.Raw((in.LastSeen).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"current\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Current))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActiveSession) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels140(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActiveSession) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20241IMAOInternalModels140(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActiveSession) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels140(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActiveSession) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20241IMAOInternalModels140(l, v)
 }
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+const (
+	ModerationPending  = "pending"
+	ModerationApproved = "approved"
+	ModerationRejected = "rejected"
+)
+
+type ModerationVerdict struct {
+	Approved bool     `json:"approved"`
+	Reasons  []string `json:"reasons"`
+}
+
+type ModerationDecision struct {
+	AdvertID    uint      `json:"advertId"`
+	ModeratorID uint      `json:"moderatorId"`
+	Decision    string    `json:"decision"`
+	Reasons     []string  `json:"reasons"`
+	CreatedTime time.Time `json:"created"`
+}
+
+type ModerationItem struct {
+	Advert   ReturningAdvert    `json:"advert"`
+	Decision ModerationDecision `json:"decision"`
+}
+
+type ReceivedModerationDecision struct {
+	AdvertID uint     `json:"advertId"`
+	Approved bool     `json:"approved"`
+	Reasons  []string `json:"reasons"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+const (
+	NotificationAdvertSold       = "advert_sold"
+	NotificationAdvertClosed     = "advert_closed"
+	NotificationOrderCreated     = "order_created"
+	NotificationPaymentSucceeded = "payment_succeeded"
+)
+
+type Notification struct {
+	ID          uint      `json:"id"`
+	UserID      uint      `json:"-"`
+	Kind        string    `json:"kind"`
+	AdvertID    *uint     `json:"advertId,omitempty"`
+	AdvertTitle string    `json:"advertTitle,omitempty"`
+	ActorID     *uint     `json:"actorId,omitempty"`
+	IsRead      bool      `json:"isRead"`
+	CreatedTime time.Time `json:"created"`
+}
+
+// NewNotification - то, что другие пакеты передают в Notifier.Notify.
+type NewNotification struct {
+	UserID   uint
+	Kind     string
+	AdvertID *uint
+	ActorID  *uint
+}
+
+type NotificationsUnread struct {
+	Unread uint `json:"unread"`
+}
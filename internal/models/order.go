@@ -12,7 +12,11 @@ type ReceivedOrderItem struct {
 	Name            string `json:"name"`
 	Email           string `json:"email"`
 	Adress          string `json:"adress"`
-	DeliveryPrice   uint   `json:"deliveryPrice"`
+	DeliveryMethod  string `json:"deliveryMethod"`
+	DeliveryPrice   uint   `json:"-"`
+	SellerID        uint   `json:"-"`
+	Title           string `json:"-"`
+	Price           uint   `json:"-"`
 	DeliveryAddress string `json:"address"`
 }
 
@@ -20,6 +24,22 @@ type ReceivedOrderItems struct {
 	Adverts []*ReceivedOrderItem `json:"adverts"`
 }
 
+type ReceivedReservation struct {
+	AdvertIDs []uint `json:"advertIDs"`
+}
+
+// Reservation - бронь объявления покупателем до ReservedUntil.
+type Reservation struct {
+	AdvertID      uint      `json:"advertId"`
+	ReservedUntil time.Time `json:"reservedUntil"`
+}
+
+// ReceivedOrderStatus - продавец переводит заказ в новый статус.
+type ReceivedOrderStatus struct {
+	OrderID uint   `json:"orderId"`
+	Status  string `json:"status"`
+}
+
 type OrderCreated struct {
 	IsCreated bool `json:"isCreated"`
 }
@@ -30,18 +50,19 @@ type ReturningOrder struct {
 }
 
 type OrderItem struct {
-	ID            uint      `json:"id"`
-	UserID        uint      `json:"userId"`
-	AdvertID      uint      `json:"advertId"`
-	Status        string    `json:"status"`
-	Created       time.Time `json:"created"`
-	Updated       time.Time `json:"updated"`
-	Closed        time.Time `json:"closed"`
-	Phone         string    `json:"phone"`
-	Name          string    `json:"name"`
-	Email         string    `json:"email"`
-	Address       string    `json:"address"`
-	DeliveryPrice uint      `json:"deliveryPrice"`
+	ID             uint      `json:"id"`
+	UserID         uint      `json:"userId"`
+	AdvertID       uint      `json:"advertId"`
+	Status         string    `json:"status"`
+	Created        time.Time `json:"created"`
+	Updated        time.Time `json:"updated"`
+	Closed         time.Time `json:"closed"`
+	Phone          string    `json:"phone"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	Address        string    `json:"address"`
+	DeliveryPrice  uint      `json:"deliveryPrice"`
+	DeliveryMethod string    `json:"deliveryMethod"`
 }
 
 type OrderList struct {
@@ -75,6 +75,7 @@ type PriceAndDescription struct {
 	Description string `json:"description"`
 	URLEnding   string `json:"urlEnding"`
 	Duration    string `json:"duration"`
+	ServiceType string `json:"serviceType"`
 }
 
 type ReceivedPaymentFormItem struct {
@@ -159,3 +160,16 @@ type ThreeDSecure struct {
 type PaymentFormResponse struct {
 	PaymentFormURL string `json:"paymentFormUrl"`
 }
+
+const (
+	ServicePromotion = "promotion"
+	ServiceBump      = "bump"
+)
+
+// BumpStats - сколько раз объявление поднимали за окно лимита и когда это было в последний раз.
+// Считаются только оплаченные поднятия; PendingSince - время последней ещё не оплаченной формы.
+type BumpStats struct {
+	LastBump      *time.Time `json:"lastBump"`
+	BumpsInWindow int        `json:"bumpsInWindow"`
+	PendingSince  *time.Time `json:"pendingSince"`
+}
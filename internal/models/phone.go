@@ -0,0 +1,28 @@
+package models
+
+type ReceivedPhoneNumber struct {
+	Phone string `json:"phone"`
+}
+
+type ReceivedPhoneCode struct {
+	Code string `json:"code"`
+}
+
+// PhoneCodeSent - код отправлен: сколько секунд он действует и через сколько можно запросить новый.
+type PhoneCodeSent struct {
+	Phone     string `json:"phone"`
+	ExpiresIn int    `json:"expiresIn"`
+	ResendIn  int    `json:"resendIn"`
+}
+
+type PhoneStatus struct {
+	Phone    string `json:"phone"`
+	Verified bool   `json:"verified"`
+}
+
+// PhoneCode - выданный пользователю код: хранится только хэш, Attempts считает неудачные вводы.
+type PhoneCode struct {
+	Phone    string
+	CodeHash string
+	Attempts int
+}
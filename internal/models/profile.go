@@ -19,6 +19,7 @@ type Profile struct {
 	Rating          float32   `json:"rating"`
 	ReactionsCount  float32   `json:"reactionsCount"`
 	Approved        bool      `json:"approved"`
+	PhoneVerified   bool      `json:"phoneVerified"`
 	MerchantsName   string    `json:"merchantsName"`
 	SubersCount     int       `json:"subersCount"`
 	SubonsCount     int       `json:"subonsCount"`
@@ -27,6 +28,7 @@ type Profile struct {
 	SoldAddsCount   int       `json:"soldAddsCount"`
 	CartNum         uint      `json:"cartNum"`
 	FavNum          uint      `json:"favNum"`
+	UnreadMessages  uint      `json:"unreadMessagesNum"`
 }
 
 type ProfilePad struct {
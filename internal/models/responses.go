@@ -1,8 +1,17 @@
 package models
 
 type ErrResponse struct {
-	Code   int    `json:"code"`
-	Status string `json:"status"`
+	Code   int            `json:"code"`
+	Status string         `json:"status"`
+	Errors []*UploadError `json:"errors,omitempty"`
+}
+
+// UploadError описывает, какой загруженный файл не прошёл проверку и почему.
+type UploadError struct {
+	Field   string `json:"field"`
+	File    string `json:"file,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 type OkResponse struct {
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// SubscriptionsFeed - страница ленты объявлений от продавцов, на которых подписан пользователь.
+type SubscriptionsFeed struct {
+	Adverts []*ReturningAdInList `json:"adverts"`
+	// NextBefore передаётся в следующий запрос как before, nil - лента кончилась.
+	NextBefore *uint `json:"nextBefore,omitempty"`
+}
+
+// SubscriptionProfile - продавец в списке подписок или подписчик в списке подписчиков.
+type SubscriptionProfile struct {
+	SubscriptionID uint      `json:"-"`
+	UserID         uint      `json:"id"`
+	Name           string    `json:"name"`
+	Surname        string    `json:"surname"`
+	Avatar         string    `json:"avatar"`
+	AvatarIMG      string    `json:"avatarImg"`
+	Approved       bool      `json:"approved"`
+	Since          time.Time `json:"since"`
+}
+
+type SubscriptionsPage struct {
+	Profiles   []*SubscriptionProfile `json:"profiles"`
+	NextBefore *uint                  `json:"nextBefore,omitempty"`
+}
@@ -0,0 +1,20 @@
+package models
+
+// SearchQueryStats - как часто искали запрос за окно и сколько активных объявлений он находит.
+type SearchQueryStats struct {
+	Query     string `json:"query"`
+	Frequency uint   `json:"frequency"`
+	Results   uint   `json:"results"`
+}
+
+// TrendingQuery - частый запрос в городе; пустой City - по всем городам.
+type TrendingQuery struct {
+	City      string `json:"city"`
+	Query     string `json:"query"`
+	Frequency uint   `json:"frequency"`
+}
+
+type TrendingSearches struct {
+	City    string   `json:"city"`
+	Queries []string `json:"queries"`
+}
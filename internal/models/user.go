@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 const (
 	SessionIDLen = 32
 )
@@ -11,9 +13,37 @@ type UnauthorizedUser struct {
 }
 
 type User struct {
-	ID           uint   `json:"id"`
-	Email        string `json:"email"`
-	PasswordHash string `json:"-"`
+	ID             uint   `json:"id"`
+	Email          string `json:"email"`
+	PasswordHash   string `json:"-"`
+	EmailConfirmed bool   `json:"emailConfirmed"`
+	// PendingEmail - новый адрес, который ещё не подтвердили; входить по нему пока нельзя
+	PendingEmail string `json:"pendingEmail,omitempty"`
+}
+
+type ReceivedEmailToken struct {
+	Token string `json:"token"`
+}
+
+type EmailConfirmationSent struct {
+	Email    string `json:"email"`
+	ResendIn int    `json:"resendIn"`
+}
+
+type ReceivedPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+type ReceivedPasswordReset struct {
+	Token          string `json:"token"`
+	Password       string `json:"password"`
+	PasswordRepeat string `json:"passwordRepeat"`
+}
+
+type ReceivedPasswordChange struct {
+	OldPassword    string `json:"oldPassword"`
+	Password       string `json:"password"`
+	PasswordRepeat string `json:"passwordRepeat"`
 }
 
 type CSRFToken struct {
@@ -25,6 +55,29 @@ type Session struct {
 	Value  string
 }
 
+// SessionClient - откуда пришёл запрос с сессией: по нему в списке сессий видно устройство и адрес.
+type SessionClient struct {
+	IP        string
+	UserAgent string
+}
+
+// ActiveSession - сессия в списке активных. ID - не сам идентификатор сессии, а его отпечаток:
+// по нему сессию можно завершить, но нельзя ею воспользоваться.
+type ActiveSession struct {
+	ID        string    `json:"id"`
+	Device    string    `json:"device"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"userAgent"`
+	CreatedAt time.Time `json:"createdAt"`
+	LastSeen  time.Time `json:"lastSeen"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Current   bool      `json:"current"`
+}
+
+type ReceivedSessionRevoke struct {
+	ID string `json:"id"`
+}
+
 type DBInsertionUser struct {
 	Email        string `json:"email"`
 	PasswordHash string `json:"-"`
@@ -36,6 +89,7 @@ type AdditionalUserData struct {
 	IsAuth      bool   `json:"isAuth"`
 	CartNum     uint   `json:"cartNum"`
 	FavNum      uint   `json:"favNum"`
+	UnreadNum   uint   `json:"unreadMessagesNum"`
 	PhoneNumber string `json:"phoneNumber"`
 }
 
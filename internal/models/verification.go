@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+const (
+	VerificationPending  = "pending"
+	VerificationApproved = "approved"
+	VerificationRejected = "rejected"
+)
+
+// VerificationRequest - заявка продавца на проверку. Documents - адреса, по которым заявитель и модераторы
+// скачивают сканы; сами ключи в хранилище наружу не отдаются.
+type VerificationRequest struct {
+	ID              uint       `json:"id"`
+	UserID          uint       `json:"userId"`
+	BusinessName    string     `json:"businessName"`
+	TaxID           string     `json:"taxId"`
+	BusinessAddress string     `json:"businessAddress"`
+	Documents       []string   `json:"documents"`
+	DocumentKeys    []string   `json:"-"`
+	Status          string     `json:"status"`
+	Reason          string     `json:"reason"`
+	CreatedTime     time.Time  `json:"created"`
+	DecidedTime     *time.Time `json:"decided,omitempty"`
+}
+
+// ReceivedVerificationRequest - реквизиты из формы заявки, документы приходят файлами в поле documents.
+type ReceivedVerificationRequest struct {
+	BusinessName    string `json:"businessName"`
+	TaxID           string `json:"taxId"`
+	BusinessAddress string `json:"businessAddress"`
+}
+
+type ReceivedVerificationDecision struct {
+	RequestID uint   `json:"requestId"`
+	Approved  bool   `json:"approved"`
+	Reason    string `json:"reason"`
+}
+
+func (req *ReceivedVerificationRequest) Sanitize() {
+	sanitizer := bluemonday.StrictPolicy()
+
+	req.BusinessName = sanitizer.Sanitize(req.BusinessName)
+	req.BusinessAddress = sanitizer.Sanitize(req.BusinessAddress)
+}
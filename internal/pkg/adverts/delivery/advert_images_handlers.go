@@ -0,0 +1,209 @@
+package delivery
+
+import (
+	"errors"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+)
+
+var (
+	errNotAdvertOwner = errors.New("user is not the owner of the advert")
+	errNoImage        = errors.New("no image in request")
+)
+
+// advertIDForOwner достаёт id объявления из пути и проверяет, что текущий пользователь его автор.
+func (advertsHandler *AdvertsHandler) advertIDForOwner(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger) (uint, bool) {
+	ctx := request.Context()
+
+	vars := mux.Vars(request)
+	id, _ := strconv.Atoi(vars["id"])
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := advertsHandler.authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	if !advertsHandler.storage.IsAdvertOwner(ctx, uint(id), uint(user.ID)) {
+		logging.LogHandlerError(logger, errNotAdvertOwner, responses.StatusForbidden)
+		log.Println(errNotAdvertOwner, responses.StatusForbidden)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusForbidden,
+			responses.ErrForbidden))
+
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func sendImagesResponse(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger,
+	images []*models.AdvertImage, err error) {
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	// в базе лежат пути на диске, клиенту отдаём адреса /media/
+	for _, image := range images {
+		image.URL = utils.MediaURL(image.URL)
+		image.URLResized = utils.MediaURL(image.URLResized)
+
+		for name, path := range image.Renditions {
+			image.Renditions[name] = utils.MediaURL(path)
+		}
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(images))
+}
+
+func (advertsHandler *AdvertsHandler) GetAdvertImages(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	vars := mux.Vars(request)
+	id, _ := strconv.Atoi(vars["id"])
+
+	images, err := advertsHandler.storage.GetAdvertImages(ctx, uint(id))
+
+	sendImagesResponse(writer, request, logger, images, err)
+}
+
+// parsePhotos разбирает multipart-форму и проверяет файлы поля field. При ошибке ответ уже отправлен.
+func (advertsHandler *AdvertsHandler) parsePhotos(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger, field string) ([]*multipart.FileHeader, bool) {
+	err := advertsHandler.uploads.ParseMultipartForm(writer, request)
+	if err == nil {
+		err = advertsHandler.uploads.ValidateFiles(field, request.MultipartForm.File[field])
+	}
+
+	if err != nil {
+		response := utils.UploadErrResponse(err)
+
+		logging.LogHandlerError(logger, err, response.Code)
+		log.Println(err, response.Code)
+		responses.SendErrResponse(request, writer, response)
+
+		return nil, false
+	}
+
+	return request.MultipartForm.File[field], true
+}
+
+func (advertsHandler *AdvertsHandler) AddAdvertImage(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	advertID, ok := advertsHandler.advertIDForOwner(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	photos, ok := advertsHandler.parsePhotos(writer, request, logger, "photo")
+	if !ok {
+		return
+	}
+
+	if len(photos) != 1 {
+		logging.LogHandlerError(logger, errNoImage, responses.StatusBadRequest)
+		log.Println(errNoImage, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	images, err := advertsHandler.storage.AddAdvertImage(ctx, photos[0], advertID)
+
+	sendImagesResponse(writer, request, logger, images, err)
+}
+
+func (advertsHandler *AdvertsHandler) DeleteAdvertImage(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	advertID, ok := advertsHandler.advertIDForOwner(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	var data models.ReceivedAdvertImage
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err != nil {
+		sendImagesResponse(writer, request, logger, nil, err)
+
+		return
+	}
+
+	images, err := advertsHandler.storage.DeleteAdvertImage(ctx, advertID, data.ImageID)
+
+	sendImagesResponse(writer, request, logger, images, err)
+}
+
+func (advertsHandler *AdvertsHandler) ReorderAdvertImages(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	advertID, ok := advertsHandler.advertIDForOwner(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	var data models.ReceivedAdvertImagesOrder
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err != nil {
+		sendImagesResponse(writer, request, logger, nil, err)
+
+		return
+	}
+
+	images, err := advertsHandler.storage.ReorderAdvertImages(ctx, advertID, data.ImageIDs)
+
+	sendImagesResponse(writer, request, logger, images, err)
+}
+
+func (advertsHandler *AdvertsHandler) SetAdvertCover(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	advertID, ok := advertsHandler.advertIDForOwner(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	var data models.ReceivedAdvertImage
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err != nil {
+		sendImagesResponse(writer, request, logger, nil, err)
+
+		return
+	}
+
+	images, err := advertsHandler.storage.SetAdvertCover(ctx, advertID, data.ImageID)
+
+	sendImagesResponse(writer, request, logger, images, err)
+}
@@ -2,6 +2,9 @@
 package delivery
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
@@ -16,24 +19,39 @@ import (
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
 
 	advertusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	phoneusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	suggestusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/suggestions/usecases"
 )
 
 const (
 	defaultCity       = "Moscow"
 	defaultAdverCount = 20
-	maxMemory         = 2 << 28
 )
 
 type AdvertsHandler struct {
 	storage    advertusecases.AdvertsStorageInterface
+	moderator  *moderationusecases.AdvertModerator
 	authClient authproto.AuthClient
+	uploads    *utils.UploadValidator
+	suggester  *suggestusecases.Suggester
+	emailer    *emailsusecases.Emailer
+	phones     *phoneusecases.PhoneVerifier
 }
 
 func NewAdvertsHandler(storage advertusecases.AdvertsStorageInterface,
-	authClient authproto.AuthClient) *AdvertsHandler {
+	moderator *moderationusecases.AdvertModerator, authClient authproto.AuthClient,
+	uploads *utils.UploadValidator, suggester *suggestusecases.Suggester,
+	emailer *emailsusecases.Emailer, phones *phoneusecases.PhoneVerifier) *AdvertsHandler {
 	return &AdvertsHandler{
 		storage:    storage,
+		moderator:  moderator,
 		authClient: authClient,
+		uploads:    uploads,
+		suggester:  suggester,
+		emailer:    emailer,
+		phones:     phones,
 	}
 }
 
@@ -63,6 +81,7 @@ func (advertsHandler *AdvertsHandler) GetAdsList(writer http.ResponseWriter, req
 	startID, errstartID := strconv.Atoi(request.URL.Query().Get("startId"))
 	userID, errUser := strconv.Atoi(request.URL.Query().Get("userId"))
 	deleted, errdeleted := strconv.Atoi(request.URL.Query().Get("deleted"))
+	verified := request.URL.Query().Get("verified") == "1"
 
 	if count == 0 {
 		count = defaultAdverCount
@@ -95,9 +114,10 @@ func (advertsHandler *AdvertsHandler) GetAdsList(writer http.ResponseWriter, req
 	}
 
 	if category != "" {
-		adsList, err = storage.GetAdvertsByCategory(ctx, category, city, userIDCookie, uint(startID), uint(count))
+		adsList, err = storage.GetAdvertsByCategory(ctx, category, city, verified, userIDCookie, uint(startID),
+			uint(count))
 	} else if errCount == nil && errstartID == nil {
-		adsList, err = storage.GetAdvertsByCity(ctx, city, userIDCookie, uint(startID), uint(count))
+		adsList, err = storage.GetAdvertsByCity(ctx, city, verified, userIDCookie, uint(startID), uint(count))
 	} else if errUser == nil && errdeleted == nil {
 		adsList, err = storage.GetAdvertsForUserWhereStatusIs(ctx, userIDCookie, uint(userID),
 			uint(deleted), uint(count))
@@ -125,6 +145,7 @@ func (advertsHandler *AdvertsHandler) GetAdsListWithSearch(writer http.ResponseW
 	count, errCount := strconv.Atoi(request.URL.Query().Get("count"))
 	startID, errStartID := strconv.Atoi(request.URL.Query().Get("startId"))
 	title := request.URL.Query().Get("title")
+	verified := request.URL.Query().Get("verified") == "1"
 
 	var (
 		adsList      []*models.ReturningAdInList
@@ -147,7 +168,8 @@ func (advertsHandler *AdvertsHandler) GetAdsListWithSearch(writer http.ResponseW
 	}
 
 	if errCount == nil && errStartID == nil && title != "" {
-		adsList, err = storage.SearchAdvertByTitle(ctx, title, userIDCookie, uint(startID), uint(count))
+		adsList, err = storage.SearchAdvertByTitle(ctx, title, verified, userIDCookie, uint(startID),
+			uint(count))
 	} else {
 		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
 		log.Println(err, responses.StatusBadRequest)
@@ -166,6 +188,11 @@ func (advertsHandler *AdvertsHandler) GetAdsListWithSearch(writer http.ResponseW
 		return
 	}
 
+	// в журнал идёт только первая страница, иначе листание выдачи накручивало бы частоту запроса
+	if startID <= 1 {
+		go advertsHandler.suggester.LogSearch(context.WithoutCancel(ctx), title, request.URL.Query().Get("city"))
+	}
+
 	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
 	responses.SendOkResponse(writer, responses.NewOkResponse(adsList))
 }
@@ -184,7 +211,13 @@ func (advertsHandler *AdvertsHandler) GetSuggestions(writer http.ResponseWriter,
 	)
 
 	if errCount == nil && title != "" {
-		suggestions, err = storage.GetSuggestions(ctx, title, uint(num))
+		// пока индекс не построен или по префиксу в журнале ничего нет, подсказываем по заголовкам объявлений
+		var ready bool
+
+		suggestions, ready = advertsHandler.suggester.Suggest(title, num)
+		if !ready || len(suggestions) == 0 {
+			suggestions, err = storage.GetSuggestions(ctx, title, uint(num))
+		}
 	} else {
 		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
 		log.Println(err, responses.StatusBadRequest)
@@ -238,7 +271,8 @@ func (advertsHandler *AdvertsHandler) GetAdvert(writer http.ResponseWriter, requ
 			paymentList, err := utils.YuKassaUpdates()
 
 			if err == nil {
-				_ = storage.YuKassaUpdateDB(ctx, paymentList, uint(id)) // ПЕРЕПИСАТЬ ЧЕРЕЗ ПЕРЕСЕЧЕНИЕ МНОЖЕСТВ И BULK UPDATE
+				paid, _ := storage.YuKassaUpdateDB(ctx, paymentList, uint(id)) // ПЕРЕПИСАТЬ ЧЕРЕЗ ПЕРЕСЕЧЕНИЕ МНОЖЕСТВ И BULK UPDATE
+				advertsHandler.sendPaymentEmail(ctx, logger, paid, userIDCookie, uint(id))
 			}
 		}
 	}
@@ -319,12 +353,9 @@ func (advertsHandler *AdvertsHandler) CreateAdvert(writer http.ResponseWriter, r
 	ctx := request.Context()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	err := request.ParseMultipartForm(maxMemory)
-	if err != nil {
-		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
-		log.Println(err, responses.StatusInternalServerError)
-		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
-			responses.ErrInternalServer))
+	photos, ok := advertsHandler.parsePhotos(writer, request, logger, "photos")
+	if !ok {
+		return
 	}
 
 	storage := advertsHandler.storage
@@ -337,7 +368,6 @@ func (advertsHandler *AdvertsHandler) CreateAdvert(writer http.ResponseWriter, r
 	price, _ := strconv.Atoi(request.PostFormValue("price"))
 	userID, _ := strconv.Atoi(request.PostFormValue("userId"))
 
-	photos := request.MultipartForm.File["photos"]
 	data := models.ReceivedAdData{
 		UserID:      uint(userID),
 		City:        request.PostFormValue("city"),
@@ -349,8 +379,13 @@ func (advertsHandler *AdvertsHandler) CreateAdvert(writer http.ResponseWriter, r
 		Phone:       request.PostFormValue("phone"),
 	}
 
-	var advert *models.ReturningAdvert
-	advert, err = storage.CreateAdvert(ctx, photos, data)
+	if !advertsHandler.useVerifiedPhone(writer, request, logger, &data) {
+		return
+	}
+
+	verdict := advertsHandler.moderator.Check(data)
+
+	advert, err := storage.CreateAdvert(ctx, photos, data, verdict)
 
 	if err != nil {
 		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
@@ -361,20 +396,57 @@ func (advertsHandler *AdvertsHandler) CreateAdvert(writer http.ResponseWriter, r
 		return
 	}
 
+	advert.Moderation = verdict
+
 	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
 	responses.SendOkResponse(writer, responses.NewOkResponse(advert))
 }
 
+// useVerifiedPhone, если так настроено, пускает к публикации и правке только продавцов с подтверждённым
+// телефоном и печатает в объявлении именно этот номер, а не введённый в форме. Продавцом объявления
+// становится владелец сессии: иначе его номер и отметку можно было бы приписать чужому объявлению.
+func (advertsHandler *AdvertsHandler) useVerifiedPhone(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger, data *models.ReceivedAdData) bool {
+	if advertsHandler.phones == nil || !advertsHandler.phones.RequiredForAdverts() {
+		return true
+	}
+
+	ctx := request.Context()
+	session, _ := request.Cookie("session_id")
+
+	user, err := advertsHandler.authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	phone := ""
+	if err == nil {
+		phone, err = advertsHandler.phones.VerifiedPhone(ctx, uint(user.ID))
+	}
+
+	if err != nil {
+		code, message := responses.StatusInternalServerError, responses.ErrInternalServer
+		if errors.Is(err, phoneusecases.ErrPhoneNotVerified) {
+			code, message = responses.StatusForbidden, responses.ErrPhoneNotVerified
+		}
+
+		logging.LogHandlerError(logger, err, code)
+		log.Println(err, code)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+
+		return false
+	}
+
+	data.UserID = uint(user.ID)
+	data.Phone = phone
+
+	return true
+}
+
 func (advertsHandler *AdvertsHandler) EditAdvert(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	err := request.ParseMultipartForm(maxMemory)
-	if err != nil {
-		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
-		log.Println(err, responses.StatusInternalServerError)
-		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
-			responses.ErrInternalServer))
+	photos, ok := advertsHandler.parsePhotos(writer, request, logger, "photos")
+	if !ok {
+		return
 	}
 
 	storage := advertsHandler.storage
@@ -388,7 +460,6 @@ func (advertsHandler *AdvertsHandler) EditAdvert(writer http.ResponseWriter, req
 	id, _ := strconv.Atoi(request.PostFormValue("id"))
 	userID, _ := strconv.Atoi(request.PostFormValue("userId"))
 
-	photos := request.MultipartForm.File["photos"]
 	data := models.ReceivedAdData{
 		ID:          uint(id),
 		UserID:      uint(userID),
@@ -401,8 +472,13 @@ func (advertsHandler *AdvertsHandler) EditAdvert(writer http.ResponseWriter, req
 		Phone:       request.PostFormValue("phone"),
 	}
 
-	var advert *models.ReturningAdvert
-	advert, err = storage.EditAdvert(ctx, photos, data)
+	if !advertsHandler.useVerifiedPhone(writer, request, logger, &data) {
+		return
+	}
+
+	verdict := advertsHandler.moderator.Check(data)
+
+	advert, err := storage.EditAdvert(ctx, photos, data, verdict)
 
 	if err != nil {
 		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
@@ -413,6 +489,8 @@ func (advertsHandler *AdvertsHandler) EditAdvert(writer http.ResponseWriter, req
 		return
 	}
 
+	advert.Moderation = verdict
+
 	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
 	responses.SendOkResponse(writer, responses.NewOkResponse(advert))
 }
@@ -465,7 +543,8 @@ func (advertsHandler *AdvertsHandler) GetPromotionData(writer http.ResponseWrite
 			paymentList, err := utils.YuKassaUpdates()
 
 			if err == nil {
-				_ = storage.YuKassaUpdateDB(ctx, paymentList, uint(id))
+				paid, _ := storage.YuKassaUpdateDB(ctx, paymentList, uint(id))
+				advertsHandler.sendPaymentEmail(ctx, logger, paid, userIDCookie, uint(id))
 			}
 		}
 	}
@@ -483,3 +562,30 @@ func (advertsHandler *AdvertsHandler) GetPromotionData(writer http.ResponseWrite
 	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
 	responses.SendOkResponse(writer, responses.NewOkResponse(promotionData))
 }
+
+// GetTrendingSearches возвращает частые запросы за последние сутки в городе ?city= или по всем городам.
+func (advertsHandler *AdvertsHandler) GetTrendingSearches(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	city := request.URL.Query().Get("city")
+	num, _ := strconv.Atoi(request.URL.Query().Get("num"))
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.TrendingSearches{
+		City:    city,
+		Queries: advertsHandler.suggester.Trending(city, num),
+	}))
+}
+
+// sendPaymentEmail пишет владельцу об оплате продвижения, если она подтвердилась только что.
+func (advertsHandler *AdvertsHandler) sendPaymentEmail(ctx context.Context, logger *zap.SugaredLogger, paid bool,
+	sellerID, advertID uint) {
+	if !paid {
+		return
+	}
+
+	if err := advertsHandler.emailer.PaymentSucceeded(ctx, sellerID, advertID); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sending payment email, err=%w", err))
+	}
+}
@@ -0,0 +1,140 @@
+//nolint:all
+package delivery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	delivery "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/delivery"
+	mock_adverts "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases/mocks"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	phoneusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	mock_user_client "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf/mocks"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+type phonesStub struct {
+	status *models.PhoneStatus
+}
+
+func (s *phonesStub) GetPhoneStatus(_ context.Context, _ uint) (*models.PhoneStatus, error) {
+	return s.status, nil
+}
+
+func (s *phonesStub) SetVerifiedPhone(_ context.Context, _ uint, _ string) error {
+	return nil
+}
+
+func newEditRequest(t *testing.T, phone string) *http.Request {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	fields := map[string]string{
+		"id":          "7",
+		"userId":      "99",
+		"city":        "Moscow",
+		"category":    "Электроника",
+		"title":       "Ноутбук",
+		"description": "Почти новый",
+		"price":       "1000",
+		"phone":       phone,
+	}
+
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := form.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	request := httptest.NewRequest(http.MethodPost, "/api/adverts/edit", body)
+	request.Header.Set("Content-Type", form.FormDataContentType())
+	request.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+
+	return request.WithContext(context.WithValue(request.Context(), "code", new(int)))
+}
+
+func TestEditAdvertPhone(t *testing.T) {
+	t.Parallel()
+
+	const verifiedPhone = "+79990001122"
+
+	tests := []struct {
+		name           string
+		status         *models.PhoneStatus
+		expectEdit     bool
+		expectedStatus int
+	}{
+		{
+			name:           "Verified phone and session user replace form values",
+			status:         &models.PhoneStatus{Phone: verifiedPhone, Verified: true},
+			expectEdit:     true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unverified phone is rejected",
+			status:         &models.PhoneStatus{Phone: verifiedPhone},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mock_adverts.NewMockAdvertsStorageInterface(ctrl)
+			authClient := mock_user_client.NewMockAuthClient(ctrl)
+
+			authClient.EXPECT().GetCurrentUser(gomock.Any(), &authproto.SessionData{SessionID: "session"}).
+				Return(&authproto.AuthUser{ID: 1, IsAuth: true}, nil)
+
+			if tt.expectEdit {
+				storage.EXPECT().EditAdvert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ []*multipart.FileHeader, data models.ReceivedAdData,
+						_ *models.ModerationVerdict) (*models.ReturningAdvert, error) {
+						assert.Equal(t, verifiedPhone, data.Phone)
+						assert.Equal(t, uint(1), data.UserID)
+
+						return &models.ReturningAdvert{}, nil
+					})
+			}
+
+			moderator, err := moderationusecases.NewAdvertModerator(config.ModerationConfig{AutoApprove: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			phones := phoneusecases.NewPhoneVerifier(nil, &phonesStub{status: tt.status}, nil,
+				config.PhoneVerificationConfig{RequireForAdverts: true})
+
+			handler := delivery.NewAdvertsHandler(storage, moderator, authClient,
+				utils.NewUploadValidator(config.UploadsConfig{}), nil, nil, phones)
+
+			writer := httptest.NewRecorder()
+			handler.EditAdvert(writer, newEditRequest(t, "+71112223344"))
+
+			var response models.ErrResponse
+			_ = json.NewDecoder(writer.Body).Decode(&response)
+
+			assert.Equal(t, tt.expectedStatus, response.Code)
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	advertusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+func (ads *AdvertStorage) isAdvertOwner(ctx context.Context, tx pgx.Tx, advertID, userID uint) (bool, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLIsAdvertOwner := `SELECT EXISTS(SELECT 1 FROM public.advert WHERE id=$1 AND user_id=$2);`
+
+	logging.LogInfo(logger, "SELECT FROM advert")
+
+	start := time.Now()
+
+	userLine := tx.QueryRow(ctx, SQLIsAdvertOwner, advertID, userID)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	var owner bool
+
+	if err := userLine.Scan(&owner); err != nil {
+		logging.LogError(logger, fmt.Errorf("error while scanning advert owner, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return false, err
+	}
+
+	return owner, nil
+}
+
+// IsAdvertOwner в отличие от CheckAdvertOwnership не учитывает продвижение объявления.
+func (ads *AdvertStorage) IsAdvertOwner(ctx context.Context, advertID, userID uint) bool {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var owner bool
+
+	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		ownerInner, err := ads.isAdvertOwner(ctx, tx, advertID, userID)
+		owner = ownerInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("error while executing advert owner query, err=%w", err))
+
+		return false
+	}
+
+	return owner
+}
+
+func (ads *AdvertStorage) getAdvertImages(ctx context.Context, tx pgx.Tx,
+	advertID uint) ([]*models.AdvertImage, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLAdvertImages := `
+	SELECT id, advert_id, url, COALESCE(url_resized, ''), position, renditions
+	FROM public.advert_image
+	WHERE advert_id = $1
+	ORDER BY position, id;`
+
+	logging.LogInfo(logger, "SELECT FROM advert_image")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLAdvertImages, advertID)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while executing select advert images, err=%w",
+			err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	images := []*models.AdvertImage{}
+
+	for rows.Next() {
+		image := models.AdvertImage{}
+
+		if err := rows.Scan(&image.ID, &image.AdvertID, &image.URL, &image.URLResized,
+			&image.Position, &image.Renditions); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning advert images, err=%w", err))
+
+			return nil, err
+		}
+
+		images = append(images, &image)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning advert images rows, err=%w", err))
+
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (ads *AdvertStorage) GetAdvertImages(ctx context.Context, advertID uint) ([]*models.AdvertImage, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var images []*models.AdvertImage
+
+	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		imagesInner, err := ads.getAdvertImages(ctx, tx, advertID)
+		images = imagesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting advert images, err=%w", err))
+
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// countAdvertImagesForUpdate блокирует строку объявления до конца транзакции, чтобы одновременные загрузки
+// считали фотографии по очереди и не превысили ограничение.
+func (ads *AdvertStorage) countAdvertImagesForUpdate(ctx context.Context, tx pgx.Tx, advertID uint) (int, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLCountAdvertImages := `
+	WITH locked AS (
+		SELECT id FROM public.advert WHERE id = $1 FOR UPDATE
+	)
+	SELECT COUNT(ai.id)
+	FROM locked
+	LEFT JOIN public.advert_image ai ON ai.advert_id = locked.id;`
+
+	logging.LogInfo(logger, "SELECT FROM advert_image")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCountAdvertImages, advertID)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	var count int
+
+	if err := line.Scan(&count); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while counting advert images, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// AddAdvertImage проверяет ограничение на число фотографий в той же транзакции, что и вставку.
+// Файлы записываются до транзакции и удаляются, если она не прошла.
+func (ads *AdvertStorage) AddAdvertImage(ctx context.Context, file *multipart.FileHeader,
+	advertID uint) ([]*models.AdvertImage, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	renditions, err := ads.images.Process(ctx, file, "advert_images")
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while processing advert image , err=%w", err))
+
+		return nil, err
+	}
+
+	var images []*models.AdvertImage
+
+	err = pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		count, err := ads.countAdvertImagesForUpdate(ctx, tx, advertID)
+		if err != nil {
+			return err
+		}
+
+		if count >= advertusecases.MaxAdvertImages {
+			return fmt.Errorf("advert %d already has %d images: %w", advertID, count,
+				advertusecases.ErrTooManyImages)
+		}
+
+		_, err = ads.setAdvertImage(ctx, tx, advertID, renditions)
+		if err != nil {
+			return err
+		}
+
+		images, err = ads.getAdvertImages(ctx, tx, advertID)
+
+		return err
+	})
+
+	if err != nil {
+		ads.images.Remove(ctx, renditions)
+		logging.LogError(logger, fmt.Errorf("something went wrong while adding advert image, err=%w", err))
+
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (ads *AdvertStorage) deleteAdvertImage(ctx context.Context, tx pgx.Tx,
+	advertID, imageID uint) (*models.AdvertImage, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLDeleteAdvertImage := `
+	WITH deleted AS (
+		DELETE FROM public.advert_image
+		WHERE id = $2 AND advert_id = $1
+		RETURNING id, advert_id, url, COALESCE(url_resized, '') AS url_resized, position, renditions
+	), shifted AS (
+		UPDATE public.advert_image
+		SET position = position - 1
+		WHERE advert_id = $1 AND position > (SELECT position FROM deleted)
+	)
+	SELECT id, advert_id, url, url_resized, position, renditions FROM deleted;`
+
+	logging.LogInfo(logger, "DELETE FROM advert_image")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLDeleteAdvertImage, advertID, imageID)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	image := models.AdvertImage{}
+
+	if err := line.Scan(&image.ID, &image.AdvertID, &image.URL, &image.URLResized, &image.Position,
+		&image.Renditions); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, advertusecases.ErrImageNotFound
+		}
+
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting advert image, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+func (ads *AdvertStorage) DeleteAdvertImage(ctx context.Context, advertID,
+	imageID uint) ([]*models.AdvertImage, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var (
+		deleted *models.AdvertImage
+		images  []*models.AdvertImage
+	)
+
+	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		deletedInner, err := ads.deleteAdvertImage(ctx, tx, advertID, imageID)
+		if err != nil {
+			return err
+		}
+
+		deleted = deletedInner
+		images, err = ads.getAdvertImages(ctx, tx, advertID)
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting advert image, err=%w", err))
+
+		return nil, err
+	}
+
+	for _, key := range advertusecases.ImageKeys(deleted) {
+		if err := ads.blobs.Delete(ctx, key); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while deleting image %s, err=%w", key, err))
+		}
+	}
+
+	return images, nil
+}
+
+func (ads *AdvertStorage) setAdvertImagesOrder(ctx context.Context, tx pgx.Tx, advertID uint,
+	imageIDs []uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetAdvertImagesOrder := `
+	UPDATE public.advert_image ai
+	SET position = ordered.num - 1
+	FROM unnest($2::BIGINT[]) WITH ORDINALITY AS ordered(id, num)
+	WHERE ai.id = ordered.id AND ai.advert_id = $1;`
+
+	logging.LogInfo(logger, "UPDATE advert_image")
+
+	ids := make([]int64, 0, len(imageIDs))
+	for _, id := range imageIDs {
+		ids = append(ids, int64(id))
+	}
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLSetAdvertImagesOrder, advertID, ids)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while updating advert images order, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+// ReorderAdvertImages принимает полный список фотографий объявления в новом порядке.
+func (ads *AdvertStorage) ReorderAdvertImages(ctx context.Context, advertID uint,
+	imageIDs []uint) ([]*models.AdvertImage, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var images []*models.AdvertImage
+
+	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		current, err := ads.getAdvertImages(ctx, tx, advertID)
+		if err != nil {
+			return err
+		}
+
+		if err = advertusecases.CheckImagesOrder(current, imageIDs); err != nil {
+			return err
+		}
+
+		if err = ads.setAdvertImagesOrder(ctx, tx, advertID, imageIDs); err != nil {
+			return err
+		}
+
+		images, err = ads.getAdvertImages(ctx, tx, advertID)
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while reordering advert images, err=%w", err))
+
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// SetAdvertCover делает фотографию первой, остальные сдвигаются без изменения относительного порядка.
+func (ads *AdvertStorage) SetAdvertCover(ctx context.Context, advertID,
+	imageID uint) ([]*models.AdvertImage, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var images []*models.AdvertImage
+
+	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		current, err := ads.getAdvertImages(ctx, tx, advertID)
+		if err != nil {
+			return err
+		}
+
+		imageIDs, err := advertusecases.CoverOrder(current, imageID)
+		if err != nil {
+			return err
+		}
+
+		if err = ads.setAdvertImagesOrder(ctx, tx, advertID, imageIDs); err != nil {
+			return err
+		}
+
+		images, err = ads.getAdvertImages(ctx, tx, advertID)
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting advert cover, err=%w", err))
+
+		return nil, err
+	}
+
+	return images, nil
+}
@@ -0,0 +1,291 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/ranking"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// listingFilter описывает выдачу: условие отбора с параметрами $1..$len(args) и порядок внутри
+// продвигаемой и обычной частей.
+type listingFilter struct {
+	listing       string
+	where         string
+	args          []any
+	promotedOrder string
+	organicOrder  string
+}
+
+const (
+	searchQuery = `to_tsquery(replace($1 || ':*', ' ', ' | '))`
+	// поднятие возвращает объявление наверх как только что созданное, дальше оно опускается вместе с остальными;
+	// в поиске - после релевантности
+	bumpedOrder = `COALESCE(a.bumped_at, a.created_time) DESC`
+
+	// категория $2 вместе со всеми потомками: выдача родителя включает объявления подкатегорий
+	categorySubtreeSQL = `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM public.category WHERE translation = $2
+			UNION ALL
+			SELECT ch.id FROM public.category ch INNER JOIN subtree ON ch.parent_id = subtree.id
+		)
+		SELECT id FROM subtree`
+
+	listingFromSQL = `
+	FROM public.advert a
+	INNER JOIN city c ON a.city_id = c.id
+	INNER JOIN category ON a.category_id = category.id
+	WHERE a.is_promoted = %t AND a.advert_status = 'Активно' AND %s`
+
+	// %[1]d - номер параметра с id пользователя: для анонима передаётся 0, и флаги получаются false
+	listingColumnsSQL = `
+	SELECT a.id, c.translation AS city_translation, category.translation AS category_translation, a.title,
+		a.price, a.is_promoted,
+		(SELECT array_agg(url_resized) FROM
+	                                   (SELECT url_resized
+	                                    FROM advert_image
+	                                    WHERE advert_id = a.id
+	                                    ORDER BY position, id) AS ordered_images) AS image_urls,
+		EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $%[1]d AND f.advert_id = a.id) AS in_favourites,
+		EXISTS (SELECT 1 FROM cart ct WHERE ct.user_id = $%[1]d AND ct.advert_id = a.id) AS in_cart,
+		EXISTS (SELECT 1 FROM profile p WHERE p.user_id = a.user_id AND p.verified) AS seller_verified,
+		EXISTS (SELECT 1 FROM advert_reservation r
+			WHERE r.advert_id = a.id AND r.user_id <> $%[1]d AND r.reserved_until > NOW()) AS reserved`
+
+	sellerVerifiedSQL = ` AND EXISTS (SELECT 1 FROM profile p WHERE p.user_id = a.user_id AND p.verified)`
+)
+
+// onlyVerified оставляет в выдаче объявления только проверенных продавцов.
+func (filter listingFilter) onlyVerified(verified bool) listingFilter {
+	if verified {
+		filter.where = `(` + filter.where + `)` + sellerVerifiedSQL
+	}
+
+	return filter
+}
+
+func cityListing(city string) listingFilter {
+	return listingFilter{
+		listing:       ranking.ListingCity,
+		where:         `c.translation = $1`,
+		args:          []any{city},
+		promotedOrder: `a.promotion_start DESC, a.id`,
+		organicOrder:  bumpedOrder + `, a.id`,
+	}
+}
+
+func categoryListing(category, city string) listingFilter {
+	return listingFilter{
+		listing:       ranking.ListingCategory,
+		where:         `c.translation = $1 AND a.category_id IN (` + categorySubtreeSQL + `)`,
+		args:          []any{city, category},
+		promotedOrder: `a.promotion_start DESC, a.id`,
+		organicOrder:  bumpedOrder + `, a.id`,
+	}
+}
+
+func searchListing(title string) listingFilter {
+	return listingFilter{
+		listing:       ranking.ListingSearch,
+		where:         `to_tsvector(a.title) @@ ` + searchQuery,
+		args:          []any{title},
+		promotedOrder: `ts_rank(to_tsvector(a.title), ` + searchQuery + `) DESC, a.promotion_start DESC, a.id`,
+		organicOrder:  `ts_rank(to_tsvector(a.title), ` + searchQuery + `) DESC, ` + bumpedOrder + `, a.id`,
+	}
+}
+
+func (ads *AdvertStorage) countPromoted(ctx context.Context, tx pgx.Tx, filter listingFilter) (int, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLCountPromoted := `SELECT COUNT(*)` + fmt.Sprintf(listingFromSQL, true, filter.where) + `;`
+
+	logging.LogInfo(logger, "SELECT COUNT FROM advert, city, category")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCountPromoted, filter.args...)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	var total int
+
+	if err := line.Scan(&total); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while counting promoted adverts, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// selectListingPart выбирает срез продвигаемой или обычной части выдачи. Продвигаемые объявления
+// сначала нумеруются в порядке promotedOrder, затем циклически сдвигаются на window.Rotation.
+func (ads *AdvertStorage) selectListingPart(ctx context.Context, tx pgx.Tx, filter listingFilter, promoted bool,
+	userID uint, offset, limit, rotation, total int) ([]*models.ReturningAdInList, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	next := len(filter.args) + 1
+	args := append(append([]any{}, filter.args...), userID, offset, limit)
+	columns := fmt.Sprintf(listingColumnsSQL, next)
+	from := fmt.Sprintf(listingFromSQL, promoted, filter.where)
+
+	var SQLListing string
+
+	if promoted {
+		args = append(args, rotation, total)
+		SQLListing = fmt.Sprintf(`
+	SELECT id, city_translation, category_translation, title, price, is_promoted, image_urls, in_favourites, in_cart,
+		seller_verified, reserved
+	FROM (%s, row_number() OVER (ORDER BY %s) AS promoted_rank %s) AS ranked
+	ORDER BY (promoted_rank - 1 + $%d) %% $%d
+	OFFSET $%d
+	LIMIT $%d;`, columns, filter.promotedOrder, from, next+3, next+4, next+1, next+2)
+	} else {
+		SQLListing = fmt.Sprintf(`%s %s
+	ORDER BY %s
+	OFFSET $%d
+	LIMIT $%d;`, columns, from, filter.organicOrder, next+1, next+2)
+	}
+
+	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image, favourite, cart")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLListing, args...)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
+			err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var adsList []*models.ReturningAdInList
+
+	for rows.Next() {
+		var (
+			returningAdInList models.ReturningAdInList
+			photoPad          models.PhotoPad
+		)
+
+		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
+			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted,
+			&photoPad.Photo, &returningAdInList.InFavourites, &returningAdInList.InCart,
+			&returningAdInList.SellerVerified, &returningAdInList.Reserved); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
+			ads.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		returningAdInList.IsActive = true
+
+		for _, ptr := range photoPad.Photo {
+			if ptr != nil {
+				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
+			}
+		}
+
+		returningAdInList.Photos, returningAdInList.PhotosIMG =
+			utils.MediaImages(ctx, ads.blobs, returningAdInList.Photos)
+
+		adsList = append(adsList, &returningAdInList)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return adsList, nil
+}
+
+func (ads *AdvertStorage) getRankedAdverts(ctx context.Context, tx pgx.Tx, filter listingFilter, userID, startID,
+	num uint) ([]*models.ReturningAdInList, error) {
+	if num == 0 || (startID-1)%num != 0 {
+		return nil, nil
+	}
+
+	page := int((startID - 1) / num)
+	policy := ads.ranker.Policy(filter.listing)
+
+	total, err := ads.countPromoted(ctx, tx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	window := policy.Window(page, int(num), total, time.Now())
+
+	promoted, err := ads.selectListingPart(ctx, tx, filter, true, userID, window.PromotedOffset,
+		window.PromotedLimit, window.Rotation, total)
+	if err != nil {
+		return nil, err
+	}
+
+	organic, err := ads.selectListingPart(ctx, tx, filter, false, userID, window.OrganicOffset,
+		window.OrganicLimit, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return ranking.Merge(policy, int(num), promoted, organic), nil
+}
+
+// rankedAdverts возвращает страницу выдачи, в которой продвигаемые объявления расставлены по политике ранжирования.
+func (ads *AdvertStorage) rankedAdverts(ctx context.Context, filter listingFilter, userID, startID,
+	num uint) ([]*models.ReturningAdInList, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var advertsList []*models.ReturningAdInList
+
+	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
+		advertsListInner, err := ads.getRankedAdverts(ctx, tx, filter, userID, startID, num)
+		advertsList = advertsListInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
+
+		return nil, err
+	}
+
+	return advertsList, nil
+}
+
+func (ads *AdvertStorage) GetAdvertsByCity(ctx context.Context, city string, verified bool, userID, startID,
+	num uint) ([]*models.ReturningAdInList, error) {
+	return ads.rankedAdverts(ctx, cityListing(city).onlyVerified(verified), userID, startID, num)
+}
+
+func (ads *AdvertStorage) GetAdvertsByCategory(ctx context.Context, category, city string, verified bool, userID,
+	startID, num uint) ([]*models.ReturningAdInList, error) {
+	return ads.rankedAdverts(ctx, categoryListing(category, city).onlyVerified(verified), userID, startID, num)
+}
+
+func (ads *AdvertStorage) SearchAdvertByTitle(ctx context.Context, title string, verified bool, userID, startID,
+	num uint) ([]*models.ReturningAdInList, error) {
+	return ads.rankedAdverts(ctx, searchListing(title).onlyVerified(verified), userID, startID, num)
+}
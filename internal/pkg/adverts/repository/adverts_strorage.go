@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"mime/multipart"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +12,9 @@ import (
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	advertusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/ranking"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -29,12 +31,19 @@ const (
 type AdvertStorage struct {
 	pool    *pgxpool.Pool
 	metrics *mymetrics.DatabaseMetrics
+	images  *utils.ImagePipeline
+	blobs   blobstore.BlobStore
+	ranker  *ranking.Ranker
 }
 
-func NewAdvertStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *AdvertStorage {
+func NewAdvertStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics,
+	images *utils.ImagePipeline, blobs blobstore.BlobStore, ranker *ranking.Ranker) *AdvertStorage {
 	return &AdvertStorage{
 		pool:    pool,
 		metrics: metrics,
+		images:  images,
+		blobs:   blobs,
+		ranker:  ranker,
 	}
 }
 
@@ -138,15 +147,7 @@ func (ads *AdvertStorage) GetAdvertOnlyByID(ctx context.Context, advertID uint)
 		return nil, err
 	}
 
-	for i := 0; i < len(advertsList.Photos); i++ {
-		image, err := utils.DecodeImage(advertsList.Photos[i])
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-				advertsList.Photos[i], err))
-		}
-
-		advertsList.PhotosIMG = append(advertsList.PhotosIMG, image)
-	}
+	advertsList.Photos, advertsList.PhotosIMG = utils.MediaImages(ctx, ads.blobs, advertsList.Photos)
 
 	// advertsList.Advert.Sanitize()
 
@@ -160,7 +161,8 @@ func (ads *AdvertStorage) getAdvertImagesURLs(ctx context.Context, tx pgx.Tx, ad
 	SQLAdvertImagesURLs := `
 	SELECT url
 	FROM public.advert_image
-	WHERE advert_id = $1;`
+	WHERE advert_id = $1
+	ORDER BY position, id;`
 
 	logging.LogInfo(logger, "SELECT FROM advert_image")
 
@@ -292,7 +294,8 @@ func (ads *AdvertStorage) getAdvert(ctx context.Context, tx pgx.Tx, advertID uin
 		a.is_used,
 		a.views,
 		a.advert_status,
-		a.favourites_number
+		a.favourites_number,
+		EXISTS (SELECT 1 FROM advert_reservation r WHERE r.advert_id = a.id AND r.reserved_until > NOW()) AS reserved
 		FROM 
 		public.advert a
 		LEFT JOIN 
@@ -319,7 +322,8 @@ func (ads *AdvertStorage) getAdvert(ctx context.Context, tx pgx.Tx, advertID uin
 	if err := advertLine.Scan(&advertModel.ID, &advertModel.UserID, &cityModel.ID, &cityModel.CityName,
 		&cityModel.Translation, &categoryModel.ID, &categoryModel.Name, &categoryModel.Translation, &advertModel.Title,
 		&advertModel.Description, &advertModel.Price, &advertModel.CreatedTime, &advertModel.ClosedTime,
-		&advertModel.IsUsed, &advertModel.Views, &advertStatus, &advertModel.FavouritesNum); err != nil {
+		&advertModel.IsUsed, &advertModel.Views, &advertStatus, &advertModel.FavouritesNum,
+		&advertModel.Reserved); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning advert, err=%w", err))
 
 		return nil, err
@@ -367,12 +371,15 @@ func (ads *AdvertStorage) getAdvertAuth(ctx context.Context, tx pgx.Tx, userID,
 		a.is_promoted,
 		a.promotion_start,
 		a.promotion_duration,
+		a.bumped_at,
 		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $1 AND f.advert_id = a.id)
          	THEN 1 ELSE 0 END AS bool) AS in_favourites,
 		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $1 AND c.advert_id = a.id)
 			THEN 1 ELSE 0 END AS bool) AS in_cart,
 		a.favourites_number,
-		ARRAY_AGG(pay.created_time ORDER BY pay.created_time DESC) FILTER (WHERE pay.payment_status = 'pending')
+		ARRAY_AGG(pay.created_time ORDER BY pay.created_time DESC) FILTER (WHERE pay.payment_status = 'pending'),
+		EXISTS (SELECT 1 FROM advert_reservation r
+			WHERE r.advert_id = a.id AND r.user_id <> $1 AND r.reserved_until > NOW()) AS reserved
 		FROM 
 		public.advert a
 		LEFT JOIN 
@@ -385,7 +392,7 @@ func (ads *AdvertStorage) getAdvertAuth(ctx context.Context, tx pgx.Tx, userID,
 		GROUP BY 
 		     a.id, a.user_id, a.city_id, c.name, c.translation, a.category_id, cat.name, cat.translation, a.title, 
 		     a.description, a.price, a.created_time, a.closed_time, a.is_used, a.views, a.advert_status, a.is_promoted, 
-		     a.promotion_start, a.promotion_duration, a.favourites_number;`
+		     a.promotion_start, a.promotion_duration, a.bumped_at, a.favourites_number;`
 
 	logging.LogInfo(logger, "SELECT FROM advert, city, category")
 
@@ -407,8 +414,9 @@ func (ads *AdvertStorage) getAdvertAuth(ctx context.Context, tx pgx.Tx, userID,
 		&cityModel.Translation, &categoryModel.ID, &categoryModel.Name, &categoryModel.Translation, &advertModel.Title,
 		&advertModel.Description, &advertModel.Price, &advertModel.CreatedTime, &advertModel.ClosedTime,
 		&advertModel.IsUsed, &advertModel.Views, &advertStatus, &promotionModel.IsPromoted,
-		&promotionModel.PromotionStart, &promotionModel.PromotionDuration, &advertModel.InFavourites,
-		&advertModel.InCart, &advertModel.FavouritesNum, &paymentsDates.List); err != nil {
+		&promotionModel.PromotionStart, &promotionModel.PromotionDuration, &promotionModel.BumpedAt,
+		&advertModel.InFavourites,
+		&advertModel.InCart, &advertModel.FavouritesNum, &paymentsDates.List, &advertModel.Reserved); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning advert, err=%w", err))
 
 		return nil, err
@@ -483,643 +491,13 @@ func (ads *AdvertStorage) GetAdvert(ctx context.Context, userID, advertID uint)
 		return nil, err
 	}
 
-	for i := 0; i < len(advertsList.Photos); i++ {
-		image, err := utils.DecodeImage(advertsList.Photos[i])
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %v, err = %w",
-				advertsList.Photos[i], err))
-		}
-
-		advertsList.PhotosIMG = append(advertsList.PhotosIMG, image)
-	}
+	advertsList.Photos, advertsList.PhotosIMG = utils.MediaImages(ctx, ads.blobs, advertsList.Photos)
 
 	// advertsList.Advert.Sanitize()
 
 	return advertsList, nil
 }
 
-func (ads *AdvertStorage) getAdvertsByCity(ctx context.Context, tx pgx.Tx, city string,
-	startID, num uint) ([]*models.ReturningAdInList, error) {
-	funcName := logging.GetOnlyFunctionName()
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var param uint
-
-	if (startID-1)%num != 0 {
-		return nil, nil
-	}
-
-	param = (startID - 1) / num
-
-	SQLAdvertsByCityPromoted := `
-	WITH promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = TRUE AND a.advert_status = 'Активно' AND c.translation = $2
-		ORDER BY promotion_start DESC, id
-		OFFSET 5 * $1
-		LIMIT 5
-	), non_promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = FALSE AND a.advert_status = 'Активно' AND c.translation = $2
-		ORDER BY id
-		OFFSET 15 * $1 + 5 * div((SELECT 
-		CASE 
-			WHEN ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) < 0 THEN 0
-			ELSE ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE)
-		END), 5) + (SELECT
-			CASE
-				WHEN (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) - $1 * 5 < 0 
-						THEN 5 - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) % 5
-				ELSE 0
-			END)
-	)
-	SELECT * FROM promoted_adverts
-	UNION ALL
-	SELECT * FROM non_promoted_adverts
-	ORDER BY is_promoted DESC, promotion_start DESC, id ASC
-	LIMIT 20;
-	`
-
-	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLAdvertsByCityPromoted, param, city)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var adsList []*models.ReturningAdInList
-
-	for rows.Next() {
-		returningAdInList := models.ReturningAdInList{}
-
-		var (
-			photoPad       models.PhotoPad
-			isPromotedPlug interface{}
-		)
-
-		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
-			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted, &isPromotedPlug,
-			&photoPad.Photo); err != nil {
-			ads.metrics.IncreaseErrors(funcName)
-
-			return nil, err
-		}
-
-		returningAdInList.InFavourites = false
-		returningAdInList.InCart = false
-		returningAdInList.IsActive = true
-
-		if photoPad.Photo != nil {
-			for _, ptr := range photoPad.Photo {
-				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
-			}
-		}
-
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %v, err = %w",
-					returningAdInList.Photos[i], err))
-
-				return nil, err
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		// returningAdInList.Sanitize()
-
-		adsList = append(adsList, &returningAdInList)
-	}
-
-	if err := rows.Err(); err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	return adsList, nil
-}
-
-func (ads *AdvertStorage) getAdvertsByCityAuth(ctx context.Context, tx pgx.Tx, city string, userID, startID,
-	num uint) ([]*models.ReturningAdInList, error) {
-	funcName := logging.GetOnlyFunctionName()
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var param uint
-
-	if (startID-1)%num != 0 {
-		return nil, nil
-	}
-
-	param = (startID - 1) / num
-
-	SQLAdvertsByCityAuth := `
-	WITH promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $3 AND f.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_favourites,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $3 AND c.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_cart								
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = TRUE AND a.advert_status = 'Активно' AND c.translation = $2
-		ORDER BY promotion_start DESC, id
-		OFFSET 5 * $1
-		LIMIT 5
-	), non_promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $3 AND f.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_favourites,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $3 AND c.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_cart								
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = FALSE AND a.advert_status = 'Активно' AND c.translation = $2
-		ORDER BY id
-		OFFSET 15 * $1 + 5 * div((SELECT 
-		CASE 
-			WHEN ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) < 0 THEN 0
-			ELSE ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE)
-		END), 5) + (SELECT
-			CASE
-				WHEN (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) - $1 * 5 < 0 
-						THEN 5 - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) % 5
-				ELSE 0
-			END)
-	)
-	SELECT * FROM promoted_adverts
-	UNION ALL
-	SELECT * FROM non_promoted_adverts
-	ORDER BY is_promoted DESC, promotion_start DESC, id ASC
-	LIMIT 20;
-	`
-
-	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image, favourite, cart")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLAdvertsByCityAuth, param, city, userID)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var adsList []*models.ReturningAdInList
-
-	for rows.Next() {
-		returningAdInList := models.ReturningAdInList{}
-
-		var (
-			photoPad       models.PhotoPad
-			isPromotedPlug interface{}
-		)
-
-		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
-			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted, &isPromotedPlug,
-			&photoPad.Photo, &returningAdInList.InFavourites, &returningAdInList.InCart); err != nil {
-			return nil, err
-		}
-
-		returningAdInList.IsActive = true
-
-		if photoPad.Photo != nil {
-			for _, ptr := range photoPad.Photo {
-				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
-			}
-		}
-
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %v, err = %w",
-					returningAdInList.Photos[i], err))
-
-				return nil, err
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		// returningAdInList.Sanitize()
-
-		adsList = append(adsList, &returningAdInList)
-	}
-
-	if err := rows.Err(); err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
-
-		return nil, err
-	}
-
-	return adsList, nil
-}
-
-func (ads *AdvertStorage) GetAdvertsByCity(ctx context.Context, city string, userID, startID,
-	num uint) ([]*models.ReturningAdInList, error) {
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var advertsList []*models.ReturningAdInList
-
-	if userID == 0 {
-		err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			advertsListInner, err := ads.getAdvertsByCity(ctx, tx, city, startID, num)
-			advertsList = advertsListInner
-
-			return err
-		})
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
-
-			return nil, err
-		}
-	} else {
-		err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			advertsListInner, err := ads.getAdvertsByCityAuth(ctx, tx, city, userID, startID, num)
-			advertsList = advertsListInner
-
-			return err
-		})
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
-
-			return nil, err
-		}
-	}
-
-	return advertsList, nil
-}
-
-func (ads *AdvertStorage) getAdvertsByCategory(ctx context.Context, tx pgx.Tx, category, city string,
-	startID, num uint) ([]*models.ReturningAdInList, error) {
-	funcName := logging.GetOnlyFunctionName()
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var param uint
-
-	if (startID-1)%num != 0 {
-		return nil, nil
-	}
-
-	param = (startID - 1) / num
-
-	SQLAdvertsByCity := `
-	WITH promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = TRUE AND a.advert_status = 'Активно' AND c.translation = $2 AND category.translation = $3
-		ORDER BY promotion_start DESC, id
-		OFFSET 5 * $1
-		LIMIT 5
-	), non_promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = FALSE AND a.advert_status = 'Активно' AND c.translation = $2 AND category.translation = $3
-		ORDER BY id
-		OFFSET 15 * $1 + 5 * div((SELECT 
-		CASE 
-			WHEN ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) < 0 THEN 0
-			ELSE ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE)
-		END), 5) + (SELECT
-			CASE
-				WHEN (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) - $1 * 5 < 0 
-						THEN 5 - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) % 5
-				ELSE 0
-			END)
-	)
-	SELECT * FROM promoted_adverts
-	UNION ALL
-	SELECT * FROM non_promoted_adverts
-	ORDER BY is_promoted DESC, promotion_start DESC, id ASC
-	LIMIT 20;
-	`
-
-	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLAdvertsByCity, param, city, category)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var adsList []*models.ReturningAdInList
-
-	for rows.Next() {
-		returningAdInList := models.ReturningAdInList{}
-
-		var (
-			photoPad       models.PhotoPad
-			isPromotedPlug interface{}
-		)
-
-		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
-			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted, &isPromotedPlug,
-			&photoPad.Photo); err != nil {
-			return nil, err
-		}
-
-		returningAdInList.IsActive = true
-
-		if photoPad.Photo != nil {
-			for _, ptr := range photoPad.Photo {
-				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
-			}
-		}
-
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %v, err = %w",
-					returningAdInList.Photos[i], err))
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		returningAdInList.InFavourites = false
-		returningAdInList.InCart = false
-
-		// returningAdInList.Sanitize()
-
-		adsList = append(adsList, &returningAdInList)
-	}
-
-	if err := rows.Err(); err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
-
-		return nil, err
-	}
-
-	return adsList, nil
-}
-
-func (ads *AdvertStorage) getAdvertsByCategoryAuth(ctx context.Context, tx pgx.Tx, category, city string, userID,
-	startID, num uint) ([]*models.ReturningAdInList, error) {
-	funcName := logging.GetOnlyFunctionName()
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var param uint
-
-	if (startID-1)%num != 0 {
-		return nil, nil
-	}
-
-	param = (startID - 1) / num
-
-	SQLAdvertsByCityAuth := `
-	WITH promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $4 AND f.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_favourites,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $4 AND c.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_cart								
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = TRUE AND a.advert_status = 'Активно' AND c.translation = $2 AND category.translation = $3
-		ORDER BY promotion_start DESC, id
-		OFFSET 5 * $1
-		LIMIT 5
-	), non_promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $4 AND f.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_favourites,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $4 AND c.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_cart								
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = FALSE AND a.advert_status = 'Активно' AND c.translation = $2 AND category.translation = $3
-		ORDER BY id
-		OFFSET 15 * $1 + 5 * div((SELECT 
-		CASE 
-			WHEN ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) < 0 THEN 0
-			ELSE ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE)
-		END), 5) + (SELECT
-			CASE
-				WHEN (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) - $1 * 5 < 0 
-						THEN 5 - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) % 5
-				ELSE 0
-			END)
-	)
-	SELECT * FROM promoted_adverts
-	UNION ALL
-	SELECT * FROM non_promoted_adverts
-	ORDER BY is_promoted DESC, promotion_start DESC, id ASC
-	LIMIT 20;
-	`
-
-	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image, favourite, cart")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLAdvertsByCityAuth, param, city, category, userID)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var adsList []*models.ReturningAdInList
-
-	for rows.Next() {
-		returningAdInList := models.ReturningAdInList{}
-
-		var (
-			photoPad       models.PhotoPad
-			isPromotedPlug interface{}
-		)
-
-		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
-			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted, &isPromotedPlug,
-			&photoPad.Photo, &returningAdInList.InFavourites, &returningAdInList.InCart); err != nil {
-			return nil, err
-		}
-
-		returningAdInList.IsActive = true
-
-		if photoPad.Photo != nil {
-			for _, ptr := range photoPad.Photo {
-				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
-			}
-		}
-
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-					returningAdInList.Photos[i], err))
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		// returningAdInList.Sanitize()
-
-		adsList = append(adsList, &returningAdInList)
-	}
-
-	if err := rows.Err(); err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
-
-		return nil, err
-	}
-
-	return adsList, nil
-}
-
-func (ads *AdvertStorage) GetAdvertsByCategory(ctx context.Context, category, city string, userID, startID,
-	num uint) ([]*models.ReturningAdInList, error) {
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var advertsList []*models.ReturningAdInList
-
-	if userID == 0 {
-		err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			advertsListInner, err := ads.getAdvertsByCategory(ctx, tx, category, city, startID, num)
-			advertsList = advertsListInner
-
-			return err
-		})
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
-
-			return nil, err
-		}
-	} else {
-		err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			advertsListInner, err := ads.getAdvertsByCategoryAuth(ctx, tx, category, city, userID, startID, num)
-			advertsList = advertsListInner
-
-			return err
-		})
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
-
-			return nil, err
-		}
-	}
-
-	return advertsList, nil
-}
-
 func (ads *AdvertStorage) getAdvertsForUserWhereStatusIs(ctx context.Context, tx pgx.Tx, userID, deleted,
 	advertNum uint) ([]*models.ReturningAdInList, error) {
 	funcName := logging.GetOnlyFunctionName()
@@ -1135,7 +513,7 @@ func (ads *AdvertStorage) getAdvertsForUserWhereStatusIs(ctx context.Context, tx
 	                                   (SELECT url_resized 
 	                                    FROM advert_image 
 	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
+	                                    ORDER BY position, id) AS ordered_images) AS image_urls
 	FROM public.advert a
 	INNER JOIN city c ON a.city_id = c.id
 	INNER JOIN category ON a.category_id = category.id
@@ -1185,23 +563,8 @@ func (ads *AdvertStorage) getAdvertsForUserWhereStatusIs(ctx context.Context, tx
 			}
 		}
 
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-					returningAdInList.Photos[i], err))
-
-				return nil, err
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
+		returningAdInList.Photos, returningAdInList.PhotosIMG =
+			utils.MediaImages(ctx, ads.blobs, returningAdInList.Photos)
 
 		returningAdInList.InFavourites = false
 		returningAdInList.InCart = false
@@ -1235,7 +598,7 @@ func (ads *AdvertStorage) getAdvertsForUserWhereStatusIsAuth(ctx context.Context
 	                                   (SELECT url_resized 
 	                                    FROM advert_image 
 	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
+	                                    ORDER BY position, id) AS ordered_images) AS image_urls,
 	CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $1 AND f.advert_id = a.id)
          THEN 1 ELSE 0 END AS bool) AS in_favourites,
 	CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $1 AND c.advert_id = a.id)
@@ -1290,23 +653,8 @@ func (ads *AdvertStorage) getAdvertsForUserWhereStatusIsAuth(ctx context.Context
 			}
 		}
 
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-					returningAdInList.Photos[i], err))
-
-				return nil, err
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
+		returningAdInList.Photos, returningAdInList.PhotosIMG =
+			utils.MediaImages(ctx, ads.blobs, returningAdInList.Photos)
 
 		// returningAdInList.Sanitize()
 
@@ -1361,13 +709,14 @@ func (ads *AdvertStorage) GetAdvertsForUserWhereStatusIs(ctx context.Context, us
 }
 
 func (ads *AdvertStorage) createAdvert(ctx context.Context, tx pgx.Tx,
-	data models.ReceivedAdData) (*models.ReturningAdvert, error) {
+	data models.ReceivedAdData, approved bool) (*models.ReturningAdvert, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	SQLCreateAdvert :=
 		`WITH ins AS (
-		INSERT INTO advert (user_id, city_id, category_id, title, description, price, is_used, phone, price_history)
+		INSERT INTO advert (user_id, city_id, category_id, title, description, price, is_used, phone, price_history,
+			advert_status)
 		SELECT
 			$1,
 			city.id,
@@ -1377,7 +726,8 @@ func (ads *AdvertStorage) createAdvert(ctx context.Context, tx pgx.Tx,
 			$4,
 			$5,
 			$8,
-			ARRAY['{"updated_time":"' || $9 || '", "new_price":' || $10 || '}']::jsonb[]
+			ARRAY['{"updated_time":"' || $9 || '", "new_price":' || $10 || '}']::jsonb[],
+			(CASE WHEN $11 THEN 'Активно' ELSE 'На модерации' END)::advert_status
 		FROM
 			city
 		JOIN
@@ -1392,7 +742,8 @@ func (ads *AdvertStorage) createAdvert(ctx context.Context, tx pgx.Tx,
 			advert.created_time,
 			advert.closed_time, 
 			advert.price, 
-			advert.is_used
+			advert.is_used,
+			advert.advert_status
 	)
 	SELECT ins.*, c.name AS city_name, c.translation AS city_translation, cat.name AS category_name, 
 			cat.translation AS category_translation
@@ -1406,7 +757,7 @@ func (ads *AdvertStorage) createAdvert(ctx context.Context, tx pgx.Tx,
 
 	advertLine := tx.QueryRow(ctx, SQLCreateAdvert, data.UserID, data.Title, data.Description, data.Price, data.IsUsed,
 		data.City, data.Category, data.Phone, time.Now().Format("2006-01-02 15:04:05"),
-		strconv.Itoa(int(data.Price)))
+		strconv.Itoa(int(data.Price)), approved)
 
 	ads.metrics.AddDuration(funcName, time.Since(start))
 
@@ -1414,16 +765,19 @@ func (ads *AdvertStorage) createAdvert(ctx context.Context, tx pgx.Tx,
 	cityModel := models.City{}
 	advertModel := models.Advert{}
 
+	var advertStatus string
+
 	if err := advertLine.Scan(&advertModel.ID, &advertModel.UserID, &cityModel.ID, &categoryModel.ID,
 		&advertModel.Title, &advertModel.Description, &advertModel.CreatedTime, &advertModel.ClosedTime,
-		&advertModel.Price, &advertModel.IsUsed, &cityModel.CityName, &cityModel.Translation, &categoryModel.Name,
-		&categoryModel.Translation); err != nil {
+		&advertModel.Price, &advertModel.IsUsed, &advertStatus, &cityModel.CityName, &cityModel.Translation,
+		&categoryModel.Name, &categoryModel.Translation); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning advert, err=%w", err))
 		ads.metrics.IncreaseErrors(funcName)
 
 		return nil, err
 	}
 
+	advertModel.Active = advertStatus == activeStatus
 	advertModel.CityID = cityModel.ID
 	advertModel.CategoryID = categoryModel.ID
 
@@ -1434,17 +788,60 @@ func (ads *AdvertStorage) createAdvert(ctx context.Context, tx pgx.Tx,
 	}, nil
 }
 
+// insertModerationVerdict сохраняет результат автоматической проверки в той же транзакции, что и объявление,
+// чтобы объявление не оказалось опубликованным или на модерации без решения.
+func (ads *AdvertStorage) insertModerationVerdict(ctx context.Context, tx pgx.Tx, advertID uint,
+	verdict *models.ModerationVerdict) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLInsertVerdict := `
+	INSERT INTO public.advert_moderation (advert_id, decision, reasons)
+	VALUES ($1, $2, $3);`
+
+	logging.LogInfo(logger, "INSERT INTO advert_moderation")
+
+	decision := models.ModerationPending
+	if verdict.Approved {
+		decision = models.ModerationApproved
+	}
+
+	reasons := verdict.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLInsertVerdict, advertID, decision, reasons)
+
+	ads.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting moderation verdict, err=%w", err))
+		ads.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
 func (ads *AdvertStorage) CreateAdvert(ctx context.Context, files []*multipart.FileHeader,
-	data models.ReceivedAdData) (*models.ReturningAdvert, error) {
+	data models.ReceivedAdData, verdict *models.ModerationVerdict) (*models.ReturningAdvert, error) {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	var advertsList *models.ReturningAdvert
 
 	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-		advertsListInner, err := ads.createAdvert(ctx, tx, data)
+		advertsListInner, err := ads.createAdvert(ctx, tx, data, verdict.Approved)
+		if err != nil {
+			return err
+		}
+
 		advertsList = advertsListInner
 
-		return err
+		return ads.insertModerationVerdict(ctx, tx, advertsList.Advert.ID, verdict)
 	})
 
 	if err != nil {
@@ -1453,41 +850,30 @@ func (ads *AdvertStorage) CreateAdvert(ctx context.Context, files []*multipart.F
 		return nil, err
 	}
 
-	advertsList.Photos, err = ads.SetAdvertImages(ctx, files, "advert_images",
-		"advert_images_resized", advertsList.Advert.ID)
+	advertsList.Photos, err = ads.SetAdvertImages(ctx, files, "advert_images", advertsList.Advert.ID)
 	if err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while updating advert image url , err=%w",
 			err))
 
-		return nil, err
-	}
-
-	for i := 0; i < len(advertsList.Photos); i++ {
-		image, err := utils.DecodeImage(advertsList.Photos[i])
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %v, err = %w",
-				advertsList.Photos[i], err))
-
-			return nil, err
-		}
-
-		advertsList.PhotosIMG = append(advertsList.PhotosIMG, image)
+		return nil, err
 	}
 
+	advertsList.Photos, advertsList.PhotosIMG = utils.MediaImages(ctx, ads.blobs, advertsList.Photos)
+
 	// advertsList.Advert.Sanitize()
 
 	return advertsList, nil
 }
 
-func (ads *AdvertStorage) setAdvertImage(ctx context.Context, tx pgx.Tx, advertID uint, originalImageURL,
-	resizedImage string) (string, error) {
+func (ads *AdvertStorage) setAdvertImage(ctx context.Context, tx pgx.Tx, advertID uint,
+	renditions map[string]string) (string, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	SQLUpdateProfileAvatarURL := `
-	INSERT INTO advert_image (url, advert_id, url_resized)
+	INSERT INTO advert_image (url, advert_id, url_resized, renditions, position)
 	VALUES 
-    ($1, $2, $3)
+    ($1, $2, $3, $4, (SELECT COALESCE(MAX(position) + 1, 0) FROM advert_image WHERE advert_id = $2))
 	RETURNING url;`
 
 	logging.LogInfo(logger, "INSERT INTO advert_image")
@@ -1496,7 +882,8 @@ func (ads *AdvertStorage) setAdvertImage(ctx context.Context, tx pgx.Tx, advertI
 
 	start := time.Now()
 
-	urlLine := tx.QueryRow(ctx, SQLUpdateProfileAvatarURL, originalImageURL, advertID, resizedImage)
+	urlLine := tx.QueryRow(ctx, SQLUpdateProfileAvatarURL, renditions[utils.RenditionFull], advertID,
+		renditions[utils.RenditionCard], renditions)
 
 	ads.metrics.AddDuration(funcName, time.Since(start))
 
@@ -1511,47 +898,19 @@ func (ads *AdvertStorage) setAdvertImage(ctx context.Context, tx pgx.Tx, advertI
 
 func (ads *AdvertStorage) deleteAllImagesForAdvertFromLocalStorage(ctx context.Context, tx pgx.Tx,
 	advertID uint) error {
-	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	SQLAdvertImagesURLs := `
-	SELECT url
-	FROM public.advert_image
-	WHERE advert_id = $1;`
-
-	logging.LogInfo(logger, "SELECT FROM advert_image")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLAdvertImagesURLs, advertID)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
+	images, err := ads.getAdvertImages(ctx, tx, advertID)
 	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts urls, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
 		return err
 	}
 
-	defer rows.Close()
-
-	var oldURL interface{}
-
-	for rows.Next() {
-		if err := rows.Scan(&oldURL); err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while scanning rows "+
-				"for deleting advert images for advert %d, err=%w", advertID, err))
-
-			return err
-		}
-
-		if oldURL != nil {
-			err := os.Remove(oldURL.(string))
+	for _, image := range images {
+		for _, key := range advertusecases.ImageKeys(image) {
+			err := ads.blobs.Delete(ctx, key)
 			if err != nil {
 				logging.LogError(logger, fmt.Errorf("something went wrong while deleting image %s, err=%w",
-					oldURL.(string), err))
+					key, err))
 
 				return err
 			}
@@ -1588,8 +947,8 @@ func (ads *AdvertStorage) deleteAllImagesForAdvertFromDatabase(ctx context.Conte
 	return nil
 }
 
-func (ads *AdvertStorage) SetAdvertImages(ctx context.Context, files []*multipart.FileHeader, originalImageFolderName,
-	resizedImageFolderName string, advertID uint) ([]string, error) {
+func (ads *AdvertStorage) SetAdvertImages(ctx context.Context, files []*multipart.FileHeader, folderName string,
+	advertID uint) ([]string, error) {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
@@ -1619,32 +978,23 @@ func (ads *AdvertStorage) SetAdvertImages(ctx context.Context, files []*multipar
 	for i := 0; i < len(files); i++ {
 		var url string
 
-		originalImageFullPath, err := utils.WriteFile(files[i], originalImageFolderName)
-
-		if err != nil {
-			logging.LogError(logger,
-				fmt.Errorf("something went wrong while writing file of the original image , err=%w", err))
-
-			return nil, err
-		}
-
-		resizedImageFullPath, err := utils.WriteResizedFile(files[i], resizedImageFolderName)
-
+		renditions, err := ads.images.Process(ctx, files[i], folderName)
 		if err != nil {
 			logging.LogError(logger,
-				fmt.Errorf("something went wrong while writing file of the resized image , err=%w", err))
+				fmt.Errorf("something went wrong while processing advert image , err=%w", err))
 
 			return nil, err
 		}
 
 		err = pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			urlInner, err := ads.setAdvertImage(ctx, tx, advertID, originalImageFullPath, resizedImageFullPath)
+			urlInner, err := ads.setAdvertImage(ctx, tx, advertID, renditions)
 			url = urlInner
 
 			return err
 		})
 
 		if err != nil {
+			ads.images.Remove(ctx, renditions)
 			logging.LogError(logger, fmt.Errorf("something went wrong while updating profile url , err=%w", err))
 
 			return nil, err
@@ -1657,7 +1007,7 @@ func (ads *AdvertStorage) SetAdvertImages(ctx context.Context, files []*multipar
 }
 
 func (ads *AdvertStorage) editAdvert(ctx context.Context, tx pgx.Tx,
-	data models.ReceivedAdData) (*models.ReturningAdvert, error) {
+	data models.ReceivedAdData, approved bool) (*models.ReturningAdvert, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
@@ -1673,7 +1023,12 @@ func (ads *AdvertStorage) editAdvert(ctx context.Context, tx pgx.Tx,
 				is_used = $4,
 				phone = $8,
 				price_history = price_history || 
-					ARRAY['{"updated_time":"' || $9 || '", "new_price":' || $10 || '}']::jsonb[]
+					ARRAY['{"updated_time":"' || $9 || '", "new_price":' || $10 || '}']::jsonb[],
+				advert_status = (CASE
+					WHEN advert.advert_status NOT IN ('Активно', 'Отклонено', 'На модерации')
+						THEN advert.advert_status::text
+					WHEN $11 THEN 'Активно'
+					ELSE 'На модерации' END)::advert_status
 			 FROM
 				city
 			JOIN
@@ -1690,7 +1045,8 @@ func (ads *AdvertStorage) editAdvert(ctx context.Context, tx pgx.Tx,
 				advert.created_time,
 				advert.closed_time,
 				advert.price, 
-				advert.is_used
+				advert.is_used,
+				advert.advert_status
 		)
 		SELECT 
 			upd.*, 
@@ -1711,7 +1067,7 @@ func (ads *AdvertStorage) editAdvert(ctx context.Context, tx pgx.Tx,
 
 	advertLine := tx.QueryRow(ctx, SQLUpdateAdvert, data.Title, data.Description, data.Price, data.IsUsed,
 		data.City, data.Category, data.ID, data.Phone, time.Now().Format("2006-01-02 15:04:05"),
-		strconv.Itoa(int(data.Price)))
+		strconv.Itoa(int(data.Price)), approved)
 
 	ads.metrics.AddDuration(funcName, time.Since(start))
 
@@ -1719,15 +1075,19 @@ func (ads *AdvertStorage) editAdvert(ctx context.Context, tx pgx.Tx,
 	cityModel := models.City{}
 	advertModel := models.Advert{}
 
+	var advertStatus string
+
 	if err := advertLine.Scan(&advertModel.ID, &advertModel.UserID, &cityModel.ID, &categoryModel.ID,
 		&advertModel.Title, &advertModel.Description, &advertModel.CreatedTime, &advertModel.ClosedTime,
-		&advertModel.Price, &advertModel.IsUsed, &cityModel.CityName, &cityModel.Translation,
+		&advertModel.Price, &advertModel.IsUsed, &advertStatus, &cityModel.CityName, &cityModel.Translation,
 		&categoryModel.Name, &categoryModel.Translation); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning advert, err=%w", err))
 
 		return nil, err
 	}
 
+	// скрытые и проданные объявления после редактирования не публикуются повторно
+	advertModel.Active = advertStatus == activeStatus
 	advertModel.CityID = cityModel.ID
 	advertModel.CategoryID = categoryModel.ID
 
@@ -1739,16 +1099,20 @@ func (ads *AdvertStorage) editAdvert(ctx context.Context, tx pgx.Tx,
 }
 
 func (ads *AdvertStorage) EditAdvert(ctx context.Context, files []*multipart.FileHeader,
-	data models.ReceivedAdData) (*models.ReturningAdvert, error) {
+	data models.ReceivedAdData, verdict *models.ModerationVerdict) (*models.ReturningAdvert, error) {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	var advertsList *models.ReturningAdvert
 
 	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-		advertsListInner, err := ads.editAdvert(ctx, tx, data)
+		advertsListInner, err := ads.editAdvert(ctx, tx, data, verdict.Approved)
+		if err != nil {
+			return err
+		}
+
 		advertsList = advertsListInner
 
-		return err
+		return ads.insertModerationVerdict(ctx, tx, advertsList.Advert.ID, verdict)
 	})
 
 	if err != nil {
@@ -1757,8 +1121,13 @@ func (ads *AdvertStorage) EditAdvert(ctx context.Context, files []*multipart.Fil
 		return nil, err
 	}
 
-	advertsList.Photos, err = ads.SetAdvertImages(ctx, files, "advert_images",
-		"advert_images_resized", data.ID)
+	// без новых файлов фотографии не трогаем, ими управляют отдельные ручки
+	if len(files) == 0 {
+		advertsList.Photos, err = ads.GetAdvertImagesURLs(ctx, data.ID)
+	} else {
+		advertsList.Photos, err = ads.SetAdvertImages(ctx, files, "advert_images", data.ID)
+	}
+
 	if err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while updating advert image url , err=%w",
 			err))
@@ -1766,17 +1135,7 @@ func (ads *AdvertStorage) EditAdvert(ctx context.Context, files []*multipart.Fil
 		return nil, err
 	}
 
-	for i := 0; i < len(advertsList.Photos); i++ {
-		image, err := utils.DecodeImage(advertsList.Photos[i])
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-				advertsList.Photos[i], err))
-
-			return nil, err
-		}
-
-		advertsList.PhotosIMG = append(advertsList.PhotosIMG, image)
-	}
+	advertsList.Photos, advertsList.PhotosIMG = utils.MediaImages(ctx, ads.blobs, advertsList.Photos)
 
 	// advertsList.Advert.Sanitize()
 
@@ -1833,318 +1192,6 @@ func (ads *AdvertStorage) CloseAdvert(ctx context.Context, advertID uint) error
 	return nil
 }
 
-func (ads *AdvertStorage) searchAdvertByTitle(ctx context.Context, tx pgx.Tx, title string, startID,
-	num uint) ([]*models.ReturningAdInList, error) {
-	funcName := logging.GetOnlyFunctionName()
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var param uint
-
-	if (startID-1)%num != 0 {
-		return nil, nil
-	}
-
-	param = (startID - 1) / num
-
-	SQLSearchAdvertByTitle := `
-	WITH promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = TRUE AND a.advert_status = 'Активно' 
-			AND (to_tsvector(a.title) @@ to_tsquery(replace($2 || ':*', ' ', ' | ')))
-		ORDER BY ts_rank(to_tsvector(a.title), to_tsquery(replace($2 || ':*', ' ', ' | '))) DESC, promotion_start DESC, id
-		OFFSET 5 * $1
-		LIMIT 5
-	), non_promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = FALSE AND a.advert_status = 'Активно' 
-			AND (to_tsvector(a.title) @@ to_tsquery(replace($2 || ':*', ' ', ' | ')))
-		ORDER BY ts_rank(to_tsvector(a.title), to_tsquery(replace($2 || ':*', ' ', ' | '))) DESC, id
-		OFFSET 15 * $1 + 5 * div((SELECT 
-		CASE 
-			WHEN ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) < 0 THEN 0
-			ELSE ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE)
-		END), 5) + (SELECT
-			CASE
-				WHEN (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) - $1 * 5 < 0 
-						THEN 5 - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) % 5
-				ELSE 0
-			END)
-	)
-	SELECT * FROM promoted_adverts
-	UNION ALL
-	SELECT * FROM non_promoted_adverts
-	ORDER BY is_promoted DESC, promotion_start DESC, id ASC
-	LIMIT 20;
-	`
-
-	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image using index")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLSearchAdvertByTitle, param, title)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var adsList []*models.ReturningAdInList
-
-	for rows.Next() {
-		returningAdInList := models.ReturningAdInList{}
-
-		var (
-			photoPad       models.PhotoPad
-			isPromotedPlug interface{}
-		)
-
-		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
-			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted, &isPromotedPlug,
-			&photoPad.Photo); err != nil {
-			return nil, err
-		}
-
-		returningAdInList.IsActive = true
-
-		if photoPad.Photo != nil {
-			for _, ptr := range photoPad.Photo {
-				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
-			}
-		}
-
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-					returningAdInList.Photos[i], err))
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		returningAdInList.InFavourites = false
-		returningAdInList.InCart = false
-
-		// returningAdInList.Sanitize()
-
-		adsList = append(adsList, &returningAdInList)
-	}
-
-	if err := rows.Err(); err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
-
-		return nil, err
-	}
-
-	return adsList, nil
-}
-
-func (ads *AdvertStorage) searchAdvertByTitleAuth(ctx context.Context, tx pgx.Tx, title string, userID, startID,
-	num uint) ([]*models.ReturningAdInList, error) {
-	funcName := logging.GetOnlyFunctionName()
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var param uint
-
-	if (startID-1)%num != 0 {
-		return nil, nil
-	}
-
-	SQLSearchAdvertByTitle := `
-	WITH promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $3 AND f.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_favourites,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $3 AND c.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_cart								
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = TRUE AND a.advert_status = 'Активно' 
-			AND (to_tsvector(a.title) @@ to_tsquery(replace($2 || ':*', ' ', ' | ')))
-		ORDER BY ts_rank(to_tsvector(a.title), to_tsquery(replace($2 || ':*', ' ', ' | '))) DESC, 
-				promotion_start DESC, id
-		OFFSET 5 * $1
-		LIMIT 5
-	), non_promoted_adverts AS (
-		SELECT a.id, c.translation, category.translation, a.title, a.price, a.is_promoted, a.promotion_start,
-		(SELECT array_agg(url_resized) FROM 
-	                                   (SELECT url_resized 
-	                                    FROM advert_image 
-	                                    WHERE advert_id = a.id 
-	                                    ORDER BY id) AS ordered_images) AS image_urls,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $3 AND f.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_favourites,
-		CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $3 AND c.advert_id = a.id)
-			THEN 1 ELSE 0 END AS bool) AS in_cart
-		FROM public.advert a
-		INNER JOIN city c ON a.city_id = c.id
-		INNER JOIN category ON a.category_id = category.id
-		WHERE is_promoted = FALSE AND a.advert_status = 'Активно' 
-			AND (to_tsvector(a.title) @@ to_tsquery(replace($2 || ':*', ' ', ' | ')))
-		ORDER BY ts_rank(to_tsvector(a.title), to_tsquery(replace($2 || ':*', ' ', ' | '))) DESC, id
-		OFFSET 15 * $1 + 5 * div((SELECT 
-		CASE 
-			WHEN ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) < 0 THEN 0
-			ELSE ($1 * 5) - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE)
-		END), 5) + (SELECT
-			CASE
-				WHEN (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) - $1 * 5 < 0 
-						THEN 5 - (SELECT COUNT(*) FROM public.advert WHERE is_promoted = TRUE) % 5
-				ELSE 0
-			END)
-	)
-	SELECT * FROM promoted_adverts
-	UNION ALL
-	SELECT * FROM non_promoted_adverts
-	ORDER BY is_promoted DESC, promotion_start DESC, id ASC
-	LIMIT 20;
-	`
-
-	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image using index")
-
-	start := time.Now()
-
-	rows, err := tx.Query(ctx, SQLSearchAdvertByTitle, param, title, userID)
-
-	ads.metrics.AddDuration(funcName, time.Since(start))
-
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while executing select adverts query, err=%w",
-			err))
-		ads.metrics.IncreaseErrors(funcName)
-
-		return nil, err
-	}
-
-	defer rows.Close()
-
-	var adsList []*models.ReturningAdInList
-
-	for rows.Next() {
-		var (
-			returningAdInList models.ReturningAdInList
-			photoPad          models.PhotoPad
-			isPromotedPlug    interface{}
-		)
-
-		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
-			&returningAdInList.Title, &returningAdInList.Price, &returningAdInList.IsPromoted, &isPromotedPlug,
-			&photoPad.Photo, &returningAdInList.InFavourites, &returningAdInList.InCart); err != nil {
-			return nil, err
-		}
-
-		returningAdInList.IsActive = true
-
-		if photoPad.Photo != nil {
-			for _, ptr := range photoPad.Photo {
-				returningAdInList.Photos = append(returningAdInList.Photos, *ptr)
-			}
-		}
-
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %s, err = %w",
-					returningAdInList.Photos[i], err))
-
-				return nil, err
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		// returningAdInList.Sanitize()
-
-		adsList = append(adsList, &returningAdInList)
-	}
-
-	if err := rows.Err(); err != nil {
-		logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts rows, err=%w", err))
-
-		return nil, err
-	}
-
-	return adsList, nil
-}
-
-func (ads *AdvertStorage) SearchAdvertByTitle(ctx context.Context, title string, userID, startID,
-	num uint) ([]*models.ReturningAdInList, error) {
-	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
-
-	var advertsList []*models.ReturningAdInList
-
-	if userID == 0 {
-		err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			advertsListInner, err := ads.searchAdvertByTitle(ctx, tx, title, startID, num)
-			advertsList = advertsListInner
-
-			return err
-		})
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
-
-			return nil, err
-		}
-	} else {
-		err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-			advertsListInner, err := ads.searchAdvertByTitleAuth(ctx, tx, title, userID, startID, num)
-			advertsList = advertsListInner
-
-			return err
-		})
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while getting adverts list, err=%w", err))
-
-			return nil, err
-		}
-	}
-
-	return advertsList, nil
-}
-
 func (ads *AdvertStorage) getSuggestions(ctx context.Context, tx pgx.Tx, title string, num uint) ([]string, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
@@ -2431,21 +1478,20 @@ func (ads *AdvertStorage) GetPaymnetUUIDList(ctx context.Context, advertID uint)
 }
 
 // ПЕРЕПИСАТЬ ЧЕРЕЗ ПЕРЕСЕЧЕНИЕ МНОЖЕСТВ И BULK UPDATE
-func (ads *AdvertStorage) yuKassaUpdateOneRecord(ctx context.Context, tx pgx.Tx, uuid string) error {
+func (ads *AdvertStorage) yuKassaUpdateOneRecord(ctx context.Context, tx pgx.Tx, uuid string) (bool, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
+	// уже подтверждённый платёж не трогаем, иначе каждый просмотр объявления считался бы новой оплатой
 	SQLCloseAdvert := `UPDATE public.payments
 	SET  payment_status='waiting_for_capture'
-	WHERE payment_uuid=$1;`
+	WHERE payment_uuid=$1 AND payment_status IS DISTINCT FROM 'waiting_for_capture';`
 
 	logging.LogInfo(logger, "UPDATE advert")
 
-	var err error
-
 	start := time.Now()
 
-	_, err = tx.Exec(ctx, SQLCloseAdvert, uuid)
+	tag, err := tx.Exec(ctx, SQLCloseAdvert, uuid)
 
 	ads.metrics.AddDuration(funcName, time.Since(start))
 
@@ -2454,38 +1500,43 @@ func (ads *AdvertStorage) yuKassaUpdateOneRecord(ctx context.Context, tx pgx.Tx,
 			fmt.Errorf("something went wrong while executing updating payment query, err=%w", err))
 		ads.metrics.IncreaseErrors(funcName)
 
-		return err
+		return false, err
 	}
 
-	return nil
+	return tag.RowsAffected() > 0, nil
 }
 
 // ПЕРЕПИСАТЬ ЧЕРЕЗ ПЕРЕСЕЧЕНИЕ МНОЖЕСТВ И BULK UPDATE
-func (ads *AdvertStorage) YuKassaUpdateOneRecord(ctx context.Context, uuid string) error {
+func (ads *AdvertStorage) YuKassaUpdateOneRecord(ctx context.Context, uuid string) (bool, error) {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
+	var updated bool
+
 	err := pgx.BeginFunc(ctx, ads.pool, func(tx pgx.Tx) error {
-		err := ads.yuKassaUpdateOneRecord(ctx, tx, uuid)
+		updatedInner, err := ads.yuKassaUpdateOneRecord(ctx, tx, uuid)
 		if err != nil {
 			logging.LogError(logger, fmt.Errorf("something went wrong while updating payment status, err=%w", err))
 
 			return err
 		}
 
+		updated = updatedInner
+
 		return nil
 	})
 
 	if err != nil {
 		logging.LogError(logger, fmt.Errorf("error while closing advert, err=%w", err))
 
-		return err
+		return false, err
 	}
 
-	return nil
+	return updated, nil
 }
 
 // ПЕРЕПИСАТЬ ЧЕРЕЗ ПЕРЕСЕЧЕНИЕ МНОЖЕСТВ И BULK UPDATE
-func (ads *AdvertStorage) YuKassaUpdateDB(ctx context.Context, paymentList *models.PaymentList, advertID uint) error {
+func (ads *AdvertStorage) YuKassaUpdateDB(ctx context.Context, paymentList *models.PaymentList,
+	advertID uint) (bool, error) {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	dbUUIDList, err := ads.GetPaymnetUUIDList(ctx, advertID)
@@ -2494,7 +1545,7 @@ func (ads *AdvertStorage) YuKassaUpdateDB(ctx context.Context, paymentList *mode
 		logging.LogError(logger, fmt.Errorf("no payments for advert with id=%d or error occurred, err=%w",
 			advertID, err))
 
-		return err
+		return false, err
 	}
 
 	yukassaUUIDArray := []string{}
@@ -2505,17 +1556,21 @@ func (ads *AdvertStorage) YuKassaUpdateDB(ctx context.Context, paymentList *mode
 
 	resultArray := utils.FindIntersection(dbUUIDList.UUIDList, yukassaUUIDArray)
 
+	paid := false
+
 	for i := 0; i < len(resultArray); i++ {
-		err = ads.YuKassaUpdateOneRecord(ctx, resultArray[i])
+		updated, err := ads.YuKassaUpdateOneRecord(ctx, resultArray[i])
 		if err != nil {
 			logging.LogError(logger, fmt.Errorf("something went wrong while updating payment status, err=%w",
 				err))
 
-			return err
+			return paid, err
 		}
+
+		paid = paid || updated
 	}
 
-	return nil
+	return paid, nil
 }
 
 func (ads *AdvertStorage) getPromotionData(ctx context.Context, tx pgx.Tx, advertID uint) (*models.Promotion, error) {
@@ -2526,7 +1581,8 @@ func (ads *AdvertStorage) getPromotionData(ctx context.Context, tx pgx.Tx, adver
 		SELECT 
 		a.is_promoted,
 		a.promotion_start,
-		a.promotion_duration
+		a.promotion_duration,
+		a.bumped_at
 		FROM 
 		public.advert a
 		WHERE a.id = $1;`
@@ -2542,7 +1598,7 @@ func (ads *AdvertStorage) getPromotionData(ctx context.Context, tx pgx.Tx, adver
 	var promotionData models.Promotion
 
 	if err := line.Scan(&promotionData.IsPromoted, &promotionData.PromotionStart,
-		&promotionData.PromotionDuration); err != nil {
+		&promotionData.PromotionDuration, &promotionData.BumpedAt); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning promotion data, err=%w", err))
 
 		return nil, err
@@ -12,26 +12,37 @@ import (
 
 type AdvertsStorageInterface interface {
 	GetAdvert(ctx context.Context, userID, advertID uint) (*models.ReturningAdvert, error)
-	GetAdvertsByCity(ctx context.Context, city string, userID, startID,
+	// verified оставляет в выдаче только объявления проверенных продавцов
+	GetAdvertsByCity(ctx context.Context, city string, verified bool, userID, startID,
 		num uint) ([]*models.ReturningAdInList, error)
-	GetAdvertsByCategory(ctx context.Context, category, city string, userID, startID,
+	GetAdvertsByCategory(ctx context.Context, category, city string, verified bool, userID, startID,
 		num uint) ([]*models.ReturningAdInList, error)
 	GetAdvertOnlyByID(ctx context.Context, advertID uint) (*models.ReturningAdvert, error)
-	SearchAdvertByTitle(ctx context.Context, title string, userID, startID,
+	SearchAdvertByTitle(ctx context.Context, title string, verified bool, userID, startID,
 		num uint) ([]*models.ReturningAdInList, error)
 	GetSuggestions(ctx context.Context, title string, num uint) ([]string, error)
 	GetPriceHistory(ctx context.Context, userID uint) ([]*models.PriceHistoryItem, error)
 	CheckAdvertOwnership(ctx context.Context, advertID, userID uint) bool
 	GetPaymnetUUIDList(ctx context.Context, advertID uint) (*models.PaymnetUUIDList, error)
-	YuKassaUpdateDB(ctx context.Context, paymentList *models.PaymentList, advertID uint) error
+	// YuKassaUpdateDB отмечает оплаченные платежи объявления и сообщает, подтвердился ли хотя бы один новый.
+	YuKassaUpdateDB(ctx context.Context, paymentList *models.PaymentList, advertID uint) (bool, error)
 	GetPromotionData(ctx context.Context, advertID uint) (*models.Promotion, error)
 
-	CreateAdvert(ctx context.Context, files []*multipart.FileHeader,
-		data models.ReceivedAdData) (*models.ReturningAdvert, error)
-	EditAdvert(ctx context.Context, files []*multipart.FileHeader,
-		data models.ReceivedAdData) (*models.ReturningAdvert, error)
+	// CreateAdvert и EditAdvert сохраняют объявление вместе с вердиктом автоматической модерации:
+	// одобренное публикуется сразу, остальное ждёт модератора.
+	CreateAdvert(ctx context.Context, files []*multipart.FileHeader, data models.ReceivedAdData,
+		verdict *models.ModerationVerdict) (*models.ReturningAdvert, error)
+	EditAdvert(ctx context.Context, files []*multipart.FileHeader, data models.ReceivedAdData,
+		verdict *models.ModerationVerdict) (*models.ReturningAdvert, error)
 	GetAdvertsForUserWhereStatusIs(ctx context.Context, userID, authorID, deleted,
 		advertNum uint) ([]*models.ReturningAdInList, error)
 	CloseAdvert(ctx context.Context, advertID uint) error
+
+	IsAdvertOwner(ctx context.Context, advertID, userID uint) bool
+	GetAdvertImages(ctx context.Context, advertID uint) ([]*models.AdvertImage, error)
+	AddAdvertImage(ctx context.Context, file *multipart.FileHeader, advertID uint) ([]*models.AdvertImage, error)
+	DeleteAdvertImage(ctx context.Context, advertID, imageID uint) ([]*models.AdvertImage, error)
+	ReorderAdvertImages(ctx context.Context, advertID uint, imageIDs []uint) ([]*models.AdvertImage, error)
+	SetAdvertCover(ctx context.Context, advertID, imageID uint) ([]*models.AdvertImage, error)
 	InsertView(ctx context.Context, userID, advertID uint) error
 }
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+const MaxAdvertImages = 10
+
+var (
+	ErrTooManyImages    = errors.New("too many images for one advert")
+	ErrImageNotFound    = errors.New("image does not belong to advert")
+	ErrWrongImagesOrder = errors.New("images order does not match advert images")
+	ErrDuplicateImage   = errors.New("duplicate image in order")
+)
+
+// CheckImagesOrder проверяет, что новый порядок - перестановка всех фотографий объявления.
+func CheckImagesOrder(images []*models.AdvertImage, imageIDs []uint) error {
+	if len(images) != len(imageIDs) {
+		return ErrWrongImagesOrder
+	}
+
+	known := make(map[uint]bool, len(images))
+	for _, image := range images {
+		known[image.ID] = false
+	}
+
+	for _, id := range imageIDs {
+		seen, ok := known[id]
+		if !ok {
+			return ErrWrongImagesOrder
+		}
+
+		if seen {
+			return ErrDuplicateImage
+		}
+
+		known[id] = true
+	}
+
+	return nil
+}
+
+// CoverOrder ставит фотографию первой, остальные сохраняют относительный порядок.
+func CoverOrder(images []*models.AdvertImage, imageID uint) ([]uint, error) {
+	imageIDs := []uint{imageID}
+
+	for _, image := range images {
+		if image.ID != imageID {
+			imageIDs = append(imageIDs, image.ID)
+		}
+	}
+
+	if len(imageIDs) != len(images) {
+		return nil, ErrImageNotFound
+	}
+
+	return imageIDs, nil
+}
+
+// ImageKeys возвращает все файлы изображения без повторов: старые записи хранят ключи
+// только в url и url_resized, новые дублируют их в наборе размеров.
+func ImageKeys(image *models.AdvertImage) []string {
+	seen := make(map[string]bool)
+	keys := []string{}
+
+	candidates := []string{image.URL, image.URLResized}
+	for _, key := range image.Renditions {
+		candidates = append(candidates, key)
+	}
+
+	for _, key := range candidates {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,126 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases"
+)
+
+func testImages(ids ...uint) []*models.AdvertImage {
+	images := make([]*models.AdvertImage, 0, len(ids))
+	for i, id := range ids {
+		images = append(images, &models.AdvertImage{ID: id, Position: uint(i)})
+	}
+
+	return images
+}
+
+func TestCheckImagesOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		images   []*models.AdvertImage
+		imageIDs []uint
+		wantErr  error
+	}{
+		{name: "same order", images: testImages(1, 2, 3), imageIDs: []uint{1, 2, 3}},
+		{name: "reversed", images: testImages(1, 2, 3), imageIDs: []uint{3, 2, 1}},
+		{name: "empty", images: testImages(), imageIDs: []uint{}},
+		{name: "missing image", images: testImages(1, 2, 3), imageIDs: []uint{3, 1},
+			wantErr: usecases.ErrWrongImagesOrder},
+		{name: "extra image", images: testImages(1, 2), imageIDs: []uint{1, 2, 3},
+			wantErr: usecases.ErrWrongImagesOrder},
+		{name: "foreign image", images: testImages(1, 2, 3), imageIDs: []uint{1, 2, 4},
+			wantErr: usecases.ErrWrongImagesOrder},
+		{name: "duplicate", images: testImages(1, 2, 3), imageIDs: []uint{1, 1, 2},
+			wantErr: usecases.ErrDuplicateImage},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.CheckImagesOrder(tt.images, tt.imageIDs); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckImagesOrder() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCoverOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		images  []*models.AdvertImage
+		imageID uint
+		want    []uint
+		wantErr error
+	}{
+		{name: "already cover", images: testImages(1, 2, 3), imageID: 1, want: []uint{1, 2, 3}},
+		{name: "from the middle", images: testImages(1, 2, 3, 4), imageID: 3, want: []uint{3, 1, 2, 4}},
+		{name: "last", images: testImages(1, 2, 3), imageID: 3, want: []uint{3, 1, 2}},
+		{name: "foreign image", images: testImages(1, 2, 3), imageID: 7, wantErr: usecases.ErrImageNotFound},
+		{name: "no images", images: testImages(), imageID: 1, wantErr: usecases.ErrImageNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := usecases.CoverOrder(tt.images, tt.imageID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CoverOrder() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("CoverOrder() = %v, want %v", got, tt.want)
+				}
+
+				if err := usecases.CheckImagesOrder(tt.images, got); err != nil {
+					t.Errorf("CoverOrder() result is not a valid order: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestImageKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		image *models.AdvertImage
+		want  []string
+	}{
+		{name: "legacy", image: &models.AdvertImage{URL: "a/full.jpg", URLResized: "a/card.jpg"},
+			want: []string{"a/card.jpg", "a/full.jpg"}},
+		{name: "renditions duplicate urls", image: &models.AdvertImage{URL: "a/full.webp", URLResized: "a/card.webp",
+			Renditions: map[string]string{"full": "a/full.webp", "card": "a/card.webp", "thumb": "a/thumb.webp"}},
+			want: []string{"a/card.webp", "a/full.webp", "a/thumb.webp"}},
+		{name: "no resized", image: &models.AdvertImage{URL: "a/full.jpg"}, want: []string{"a/full.jpg"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := usecases.ImageKeys(tt.image)
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ImageKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	KindLocal = "local"
+	KindS3    = "s3"
+
+	// так начинались пути файлов, пока загрузки хранились только на локальном диске
+	legacyLocalPrefix = "./uploads/"
+)
+
+var (
+	ErrNotFound    = errors.New("blob not found")
+	ErrBadKey      = errors.New("bad blob key")
+	errUnknownKind = errors.New("unknown blob store kind")
+)
+
+// Blob - содержимое объекта вместе с метаданными. Reader нужно закрыть после чтения.
+type Blob struct {
+	Reader      io.ReadCloser
+	Size        int64
+	ModTime     time.Time
+	ContentType string
+	ETag        string
+}
+
+// BlobStore хранит загруженные файлы по ключам вида "avatars/full/2024-05-01/abcdefgh.jpg".
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (*Blob, error)
+	Delete(ctx context.Context, key string) error
+	SignedURL(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+// FileOpener реализуют хранилища с файлами на локальном диске: их можно отдавать с поддержкой Range.
+type FileOpener interface {
+	Open(key string) (*os.File, error)
+}
+
+// ObjectInfo описывает объект хранилища без его содержимого.
+type ObjectInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// Lister реализуют хранилища, умеющие перечислять объекты; нужен сборщику осиротевших загрузок.
+// Обход останавливается на первой ошибке, которую вернул fn.
+type Lister interface {
+	List(ctx context.Context, prefix string, fn func(ObjectInfo) error) error
+}
+
+// NormalizeKey приводит ключ к каноничному виду, убирает префикс старых локальных путей
+// и не даёт выйти за пределы хранилища.
+func NormalizeKey(key string) (string, error) {
+	key = strings.TrimPrefix(key, legacyLocalPrefix)
+
+	cleaned := strings.TrimPrefix(path.Clean("/"+key), "/")
+	if cleaned == "" || strings.Contains(cleaned, "\\") {
+		return "", ErrBadKey
+	}
+
+	return cleaned, nil
+}
+
+func New(cfg config.BlobStoreConfig) (BlobStore, error) {
+	switch cfg.Kind {
+	case "", KindLocal:
+		return NewLocalStore(cfg.Local), nil
+	case KindS3:
+		store, err := NewS3Store(cfg.S3, os.Getenv("S3_ACCESS_KEY"), os.Getenv("S3_SECRET_KEY"), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return store, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownKind, cfg.Kind)
+	}
+}
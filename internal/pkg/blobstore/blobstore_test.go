@@ -0,0 +1,398 @@
+//nolint:all
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	testAccessKey = "minioadmin"
+	testSecretKey = "minioadmin-secret"
+	testBucket    = "uploads"
+	testRegion    = "us-east-1"
+)
+
+// fakeS3 - минимальная замена MinIO: хранит объекты в памяти и проверяет подписи SigV4.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+}
+
+func hmacSum(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+func sign(date, amzDate, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + date + "/" + testRegion + "/s3/aws4_request\n" +
+		hex.EncodeToString(hash[:])
+
+	key := hmacSum([]byte("AWS4"+testSecretKey), date)
+	key = hmacSum(key, testRegion)
+	key = hmacSum(key, "s3")
+	key = hmacSum(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSum(key, stringToSign))
+}
+
+func canonicalQuery(query url.Values, skip string) string {
+	parts := []string{}
+
+	for key, values := range query {
+		if key == skip {
+			continue
+		}
+
+		for _, value := range values {
+			parts = append(parts, url.QueryEscape(key)+"="+strings.ReplaceAll(url.QueryEscape(value), "+", "%20"))
+		}
+	}
+
+	sort.Strings(parts)
+
+	return strings.Join(parts, "&")
+}
+
+func (s *fakeS3) authorized(r *http.Request) bool {
+	if signature := r.URL.Query().Get("X-Amz-Signature"); signature != "" {
+		amzDate := r.URL.Query().Get("X-Amz-Date")
+		canonical := strings.Join([]string{r.Method, r.URL.EscapedPath(), canonicalQuery(r.URL.Query(), "X-Amz-Signature"),
+			"host:" + r.Host + "\n", "host", "UNSIGNED-PAYLOAD"}, "\n")
+
+		return signature == sign(amzDate[:8], amzDate, canonical)
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential="+testAccessKey+"/") {
+		return false
+	}
+
+	fields := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(auth, "AWS4-HMAC-SHA256 "), ", ") {
+		kv := strings.SplitN(part, "=", 2)
+		fields[kv[0]] = kv[1]
+	}
+
+	var headers strings.Builder
+	for _, name := range strings.Split(fields["SignedHeaders"], ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+
+		headers.WriteString(name + ":" + value + "\n")
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	canonical := strings.Join([]string{r.Method, r.URL.EscapedPath(), canonicalQuery(r.URL.Query(), ""),
+		headers.String(), fields["SignedHeaders"], r.Header.Get("X-Amz-Content-Sha256")}, "\n")
+
+	return fields["Signature"] == sign(amzDate[:8], amzDate, canonical)
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/"+testBucket+"/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		hash := sha256.Sum256(body)
+
+		if hex.EncodeToString(hash[:]) != r.Header.Get("X-Amz-Content-Sha256") {
+			http.Error(w, "XAmzContentSHA256Mismatch", http.StatusBadRequest)
+
+			return
+		}
+
+		s.objects[key] = body
+		s.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		if key == "" && r.URL.Query().Get("list-type") == "2" {
+			w.Write([]byte("<ListBucketResult>"))
+
+			for name, body := range s.objects {
+				if strings.HasPrefix(name, r.URL.Query().Get("prefix")) {
+					fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>%d</Size><LastModified>2024-05-01T10:00:00.000Z</LastModified></Contents>",
+						name, len(body))
+				}
+			}
+
+			w.Write([]byte("<IsTruncated>false</IsTruncated></ListBucketResult>"))
+
+			return
+		}
+
+		body, ok := s.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", s.types[key])
+		w.Header().Set("ETag", `"etag"`)
+		w.Write(body)
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(newFakeS3())
+	defer server.Close()
+
+	store, err := blobstore.NewS3Store(config.S3BlobStoreConfig{
+		Endpoint:  server.URL,
+		Region:    testRegion,
+		Bucket:    testBucket,
+		PathStyle: true,
+	}, testAccessKey, testSecretKey, server.Client())
+	if err != nil {
+		t.Fatalf("NewS3Store() error = %v", err)
+	}
+
+	ctx := context.Background()
+	key := "avatars/full/2024-05-01/abcdefgh.jpg"
+	content := []byte("jpeg bytes")
+
+	if err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "image/jpeg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	blob, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	got, _ := io.ReadAll(blob.Reader)
+	blob.Reader.Close()
+
+	if !bytes.Equal(got, content) || blob.ContentType != "image/jpeg" {
+		t.Errorf("Get() = %q (%s), want %q (image/jpeg)", got, blob.ContentType, content)
+	}
+
+	var listed []blobstore.ObjectInfo
+
+	err = store.List(ctx, "avatars/", func(object blobstore.ObjectInfo) error {
+		listed = append(listed, object)
+
+		return nil
+	})
+	if err != nil || len(listed) != 1 || listed[0].Key != key || listed[0].Size != int64(len(content)) {
+		t.Errorf("List() = %v, %v", listed, err)
+	}
+
+	signedURL, err := store.SignedURL(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatalf("SignedURL() error = %v", err)
+	}
+
+	response, err := server.Client().Get(signedURL)
+	if err != nil {
+		t.Fatalf("GET signed url error = %v", err)
+	}
+
+	got, _ = io.ReadAll(response.Body)
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK || !bytes.Equal(got, content) {
+		t.Errorf("GET signed url = %d %q, want 200 %q", response.StatusCode, got, content)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if _, err := store.Get(ctx, key); !errors.Is(err, blobstore.ErrNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestS3StoreWrongSecret(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(newFakeS3())
+	defer server.Close()
+
+	store, _ := blobstore.NewS3Store(config.S3BlobStoreConfig{
+		Endpoint:  server.URL,
+		Bucket:    testBucket,
+		PathStyle: true,
+	}, testAccessKey, "wrong", server.Client())
+
+	err := store.Put(context.Background(), "a/b.jpg", strings.NewReader("x"), 1, "image/jpeg")
+	if err == nil {
+		t.Error("Put() with wrong secret succeeded")
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	t.Parallel()
+
+	store := blobstore.NewLocalStore(config.LocalBlobStoreConfig{Root: t.TempDir()})
+	ctx := context.Background()
+	key := "advert_images/card/2024-05-01/abcdefgh.jpg"
+
+	if err := store.Put(ctx, key, strings.NewReader("image"), 5, "image/jpeg"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	blob, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	got, _ := io.ReadAll(blob.Reader)
+	blob.Reader.Close()
+
+	if string(got) != "image" || blob.Size != 5 || blob.ContentType != "image/jpeg" {
+		t.Errorf("Get() = %q, size %d, type %q", got, blob.Size, blob.ContentType)
+	}
+
+	if signedURL, _ := store.SignedURL(ctx, key, time.Minute); signedURL != "/media/"+key {
+		t.Errorf("SignedURL() = %q", signedURL)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("second Delete() error = %v, want nil", err)
+	}
+
+	if _, err := store.Get(ctx, key); !errors.Is(err, blobstore.ErrNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLocalStoreConcurrentPutDelete(t *testing.T) {
+	t.Parallel()
+
+	store := blobstore.NewLocalStore(config.LocalBlobStoreConfig{Root: t.TempDir()})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 200)
+
+	// удаление последнего файла в каталоге убирает каталог, пока в него же пишут другие
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+
+			key := fmt.Sprintf("advert_images/card/2024-05-01/put-%d.jpg", i)
+			if err := store.Put(ctx, key, strings.NewReader("image"), 5, "image/jpeg"); err != nil {
+				errs <- err
+			}
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+
+			key := fmt.Sprintf("advert_images/card/2024-05-01/del-%d.jpg", i)
+			if err := store.Put(ctx, key, strings.NewReader("image"), 5, "image/jpeg"); err != nil {
+				errs <- err
+
+				return
+			}
+
+			if err := store.Delete(ctx, key); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("concurrent Put()/Delete() error = %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("advert_images/card/2024-05-01/put-%d.jpg", i)
+
+		blob, err := store.Get(ctx, key)
+		if err != nil {
+			t.Errorf("Get(%s) error = %v", key, err)
+
+			continue
+		}
+
+		blob.Reader.Close()
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{name: "Plain key", key: "avatars/a.jpg", want: "avatars/a.jpg"},
+		{name: "Legacy local path", key: "./uploads/avatars/a.jpg", want: "avatars/a.jpg"},
+		{name: "Traversal stays inside", key: "../../etc/passwd", want: "etc/passwd"},
+		{name: "Empty key", key: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := blobstore.NormalizeKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("NormalizeKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	defaultLocalRoot    = "./uploads"
+	defaultLocalBaseURL = "/media/"
+	createTempAttempts  = 5
+)
+
+// LocalStore хранит файлы в каталоге на диске. Подходит только для одной реплики приложения.
+type LocalStore struct {
+	root    string
+	baseURL string
+}
+
+func NewLocalStore(cfg config.LocalBlobStoreConfig) *LocalStore {
+	store := &LocalStore{
+		root:    cfg.Root,
+		baseURL: cfg.BaseURL,
+	}
+
+	if store.root == "" {
+		store.root = defaultLocalRoot
+	}
+
+	if store.baseURL == "" {
+		store.baseURL = defaultLocalBaseURL
+	}
+
+	return store
+}
+
+func (store *LocalStore) filePath(key string) (string, error) {
+	key, err := NormalizeKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(store.root, filepath.FromSlash(key)), nil
+}
+
+func (store *LocalStore) Put(_ context.Context, key string, body io.Reader, _ int64, _ string) error {
+	fullpath, err := store.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	// пишем во временный файл, чтобы читатели не увидели недописанный объект
+	tmp, err := createTemp(filepath.Dir(fullpath))
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), fullpath)
+}
+
+// createTemp создаёт временный файл в каталоге. Параллельный Delete может убрать опустевший каталог или его
+// родителя прямо во время MkdirAll или до создания файла, тогда каталог создаётся заново.
+// Каталог с файлом pruneEmptyDirs уже не удалит.
+func createTemp(dir string) (*os.File, error) {
+	for attempt := 1; ; attempt++ {
+		tmp, err := tryCreateTemp(dir)
+		if err == nil || !errors.Is(err, os.ErrNotExist) || attempt == createTempAttempts {
+			return tmp, err
+		}
+	}
+}
+
+func tryCreateTemp(dir string) (*os.File, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	return os.CreateTemp(dir, ".upload-*")
+}
+
+func (store *LocalStore) Open(key string) (*os.File, error) {
+	fullpath, err := store.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(fullpath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return file, err
+}
+
+func (store *LocalStore) Get(_ context.Context, key string) (*Blob, error) {
+	file, err := store.Open(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	if info.IsDir() {
+		file.Close()
+
+		return nil, ErrNotFound
+	}
+
+	return &Blob{
+		Reader:      file,
+		Size:        info.Size(),
+		ModTime:     info.ModTime(),
+		ContentType: mime.TypeByExtension(filepath.Ext(info.Name())),
+		ETag:        fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()),
+	}, nil
+}
+
+func (store *LocalStore) Delete(_ context.Context, key string) error {
+	fullpath, err := store.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(fullpath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	store.pruneEmptyDirs(filepath.Dir(fullpath))
+
+	return nil
+}
+
+// pruneEmptyDirs удаляет опустевшие каталоги по датам, поднимаясь вверх до корня хранилища.
+func (store *LocalStore) pruneEmptyDirs(dir string) {
+	root := filepath.Clean(store.root)
+
+	for dir != root && strings.HasPrefix(dir, root+string(filepath.Separator)) {
+		if os.Remove(dir) != nil {
+			return
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
+
+func (store *LocalStore) List(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	root := filepath.Clean(store.root)
+	start := root
+
+	if prefix != "" {
+		dir, err := store.filePath(prefix)
+		if err != nil {
+			return err
+		}
+
+		start = dir
+	}
+
+	err := filepath.WalkDir(start, func(fullpath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, fullpath)
+		if err != nil {
+			return err
+		}
+
+		return fn(ObjectInfo{Key: filepath.ToSlash(rel), Size: info.Size(), ModTime: info.ModTime()})
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// SignedURL для локального хранилища возвращает обычный адрес: файлы и так раздаются публично через /media/.
+func (store *LocalStore) SignedURL(_ context.Context, key string, _ time.Duration) (string, error) {
+	key, err := NormalizeKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return store.baseURL + key, nil
+}
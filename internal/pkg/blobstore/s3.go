@@ -0,0 +1,439 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3Service        = "s3"
+	s3UnsignedBody   = "UNSIGNED-PAYLOAD"
+	s3DateFormat     = "20060102"
+	s3DateTimeFormat = "20060102T150405Z"
+	s3DefaultRegion  = "us-east-1"
+	s3DefaultTTL     = time.Hour
+	s3MaxTTL         = 7 * 24 * time.Hour
+	s3ClientTimeout  = 30 * time.Second
+	s3ErrorBodyLimit = 512
+)
+
+var (
+	errS3Config  = errors.New("s3 blob store is not configured")
+	errS3Request = errors.New("s3 request failed")
+)
+
+// S3Store работает с любым S3-совместимым хранилищем (AWS S3, MinIO, Yandex Object Storage).
+// Запросы подписываются AWS Signature V4 без сторонних SDK.
+type S3Store struct {
+	endpoint     *url.URL
+	region       string
+	bucket       string
+	pathStyle    bool
+	signedURLTTL time.Duration
+	accessKey    string
+	secretKey    string
+	client       *http.Client
+	now          func() time.Time
+}
+
+func NewS3Store(cfg config.S3BlobStoreConfig, accessKey, secretKey string, client *http.Client) (*S3Store, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.Host == "" || cfg.Bucket == "" || accessKey == "" || secretKey == "" {
+		return nil, errS3Config
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: s3ClientTimeout}
+	}
+
+	store := &S3Store{
+		endpoint:     endpoint,
+		region:       cfg.Region,
+		bucket:       cfg.Bucket,
+		pathStyle:    cfg.PathStyle,
+		signedURLTTL: cfg.SignedURLTTL,
+		accessKey:    accessKey,
+		secretKey:    secretKey,
+		client:       client,
+		now:          time.Now,
+	}
+
+	if store.region == "" {
+		store.region = s3DefaultRegion
+	}
+
+	if store.signedURLTTL <= 0 {
+		store.signedURLTTL = s3DefaultTTL
+	}
+
+	return store, nil
+}
+
+func (store *S3Store) objectURL(key string) (*url.URL, error) {
+	key, err := NormalizeKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.bucketURL(key), nil
+}
+
+// bucketURL строит адрес внутри бакета; пустой key даёт адрес самого бакета.
+func (store *S3Store) bucketURL(key string) *url.URL {
+	objectURL := *store.endpoint
+	basePath := strings.TrimSuffix(objectURL.Path, "/")
+
+	if store.pathStyle {
+		objectURL.Path = basePath + "/" + store.bucket + "/" + key
+	} else {
+		objectURL.Host = store.bucket + "." + objectURL.Host
+		objectURL.Path = basePath + "/" + key
+	}
+
+	objectURL.RawPath = uriEncode(objectURL.Path, false)
+
+	return &objectURL
+}
+
+func (store *S3Store) do(ctx context.Context, method, key string, body []byte,
+	contentType string) (*http.Response, error) {
+	objectURL, err := store.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.doURL(ctx, method, objectURL, body, contentType)
+}
+
+func (store *S3Store) doURL(ctx context.Context, method string, objectURL *url.URL, body []byte,
+	contentType string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, objectURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	request.ContentLength = int64(len(body))
+	store.sign(request, sha256Hex(body))
+
+	return store.client.Do(request)
+}
+
+func responseError(response *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, s3ErrorBodyLimit))
+
+	return fmt.Errorf("%w: %s %s", errS3Request, response.Status, strings.TrimSpace(string(body)))
+}
+
+func (store *S3Store) Put(ctx context.Context, key string, body io.Reader, _ int64, contentType string) error {
+	// подпись покрывает хэш тела, поэтому объект читается целиком; загружаемые картинки небольшие
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	response, err := store.do(ctx, http.MethodPut, key, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return responseError(response)
+	}
+
+	return nil
+}
+
+func (store *S3Store) Get(ctx context.Context, key string) (*Blob, error) {
+	response, err := store.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		response.Body.Close()
+
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode/100 != 2 {
+		defer response.Body.Close()
+
+		return nil, responseError(response)
+	}
+
+	modTime, _ := http.ParseTime(response.Header.Get("Last-Modified"))
+
+	return &Blob{
+		Reader:      response.Body,
+		Size:        response.ContentLength,
+		ModTime:     modTime,
+		ContentType: response.Header.Get("Content-Type"),
+		ETag:        response.Header.Get("ETag"),
+	}, nil
+}
+
+func (store *S3Store) Delete(ctx context.Context, key string) error {
+	response, err := store.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 && response.StatusCode != http.StatusNotFound {
+		return responseError(response)
+	}
+
+	return nil
+}
+
+type s3ListResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List перечисляет объекты бакета с ключами, начинающимися с prefix, постранично через ListObjectsV2.
+func (store *S3Store) List(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	token := ""
+
+	for {
+		listURL := store.bucketURL("")
+
+		query := url.Values{}
+		query.Set("list-type", "2")
+
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		listURL.RawQuery = canonicalQuery(query)
+
+		result, err := store.listPage(ctx, listURL)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range result.Contents {
+			err := fn(ObjectInfo{Key: object.Key, Size: object.Size, ModTime: object.LastModified})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+
+		token = result.NextContinuationToken
+	}
+}
+
+func (store *S3Store) listPage(ctx context.Context, listURL *url.URL) (*s3ListResult, error) {
+	response, err := store.doURL(ctx, http.MethodGet, listURL, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return nil, responseError(response)
+	}
+
+	result := &s3ListResult{}
+	if err := xml.NewDecoder(response.Body).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SignedURL выдаёт ссылку на объект, по которой его можно скачать без ключей до истечения expires.
+func (store *S3Store) SignedURL(_ context.Context, key string, expires time.Duration) (string, error) {
+	objectURL, err := store.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	if expires <= 0 {
+		expires = store.signedURLTTL
+	}
+
+	if expires > s3MaxTTL {
+		expires = s3MaxTTL
+	}
+
+	now := store.now().UTC()
+	scope := store.scope(now)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", store.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", now.Format(s3DateTimeFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		objectURL.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + objectURL.Host + "\n",
+		"host",
+		s3UnsignedBody,
+	}, "\n")
+
+	query.Set("X-Amz-Signature", store.signature(now, canonicalRequest))
+	objectURL.RawQuery = canonicalQuery(query)
+
+	return objectURL.String(), nil
+}
+
+func (store *S3Store) scope(now time.Time) string {
+	return now.Format(s3DateFormat) + "/" + store.region + "/" + s3Service + "/aws4_request"
+}
+
+func (store *S3Store) signature(now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		now.Format(s3DateTimeFormat),
+		store.scope(now),
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+store.secretKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, store.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// sign добавляет к запросу заголовок Authorization по схеме AWS Signature V4.
+func (store *S3Store) sign(request *http.Request, payloadHash string) {
+	now := store.now().UTC()
+
+	request.Header.Set("X-Amz-Date", now.Format(s3DateTimeFormat))
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host":                 request.URL.Host,
+		"x-amz-date":           request.Header.Get("X-Amz-Date"),
+		"x-amz-content-sha256": payloadHash,
+	}
+
+	if contentType := request.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		canonicalQuery(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, store.accessKey, store.scope(now), signedHeaders, store.signature(now, canonicalRequest)))
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+
+		for _, value := range values {
+			parts = append(parts, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+// uriEncode кодирует строку так, как этого требует SigV4: всё, кроме unreserved символов RFC 3986.
+func uriEncode(value string, encodeSlash bool) string {
+	var encoded strings.Builder
+
+	for _, b := range []byte(value) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '_', b == '.', b == '~':
+			encoded.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+
+	return encoded.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
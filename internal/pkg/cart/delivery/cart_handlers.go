@@ -1,12 +1,15 @@
 package delivery
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 
 	cartproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/delivery/protobuf"
+	cartusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/usecases"
+	profileproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/profile/delivery/protobuf"
 	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
@@ -17,17 +20,41 @@ import (
 )
 
 type CartHandler struct {
-	cartClient cartproto.CartClient
-	authClient authproto.AuthClient
+	cartClient      cartproto.CartClient
+	authClient      authproto.AuthClient
+	profileClient   profileproto.ProfileClient
+	deliveryPricing *cartusecases.DeliveryPricing
 }
 
-func NewCartHandler(cartClient cartproto.CartClient, authClient authproto.AuthClient) *CartHandler {
+func NewCartHandler(cartClient cartproto.CartClient, authClient authproto.AuthClient,
+	profileClient profileproto.ProfileClient, deliveryPricing *cartusecases.DeliveryPricing) *CartHandler {
 	return &CartHandler{
-		cartClient: cartClient,
-		authClient: authClient,
+		cartClient:      cartClient,
+		authClient:      authClient,
+		profileClient:   profileClient,
+		deliveryPricing: deliveryPricing,
 	}
 }
 
+// buildCartContent группирует корзину по продавцам и считает доставку в город из профиля покупателя.
+func (cartHandler *CartHandler) buildCartContent(ctx context.Context, userID uint64,
+	adverts []*models.ReturningAdvert) (*models.CartContent, error) {
+	content := cartusecases.NewCartContent(adverts)
+
+	var buyerCityID uint
+
+	profile, err := cartHandler.profileClient.GetProfile(ctx, &profileproto.ProfileIDRequest{ID: userID})
+	if err == nil {
+		buyerCityID = uint(profile.CityID)
+	}
+
+	if err := cartHandler.deliveryPricing.Apply(ctx, content, buyerCityID, nil); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
 // GetAdsList godoc
 // @Summary Retrieve a list of adverts
 // @Description Get a paginated list of adverts
@@ -65,6 +92,107 @@ func (cartHandler *CartHandler) GetCartList(writer http.ResponseWriter, request
 	logging.LogHandlerInfo(logger, fmt.Sprintf("Get cart for user %s", fmt.Sprint(user.ID)), responses.StatusOk)
 }
 
+// GetCartSummary отдаёт корзину, разбитую по продавцам, с доставкой и итогами. Список /cart/list
+// остаётся плоским массивом для старых клиентов.
+func (cartHandler *CartHandler) GetCartSummary(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	cartClient := cartHandler.cartClient
+	authClient := cartHandler.authClient
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+	adsList, err := cartClient.GetCartByUserID(ctx, &cartproto.UserIdRequest{UserId: uint32(user.ID)})
+
+	if err != nil {
+		log.Println(err, responses.StatusBadRequest)
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	content, err := cartHandler.buildCartContent(ctx, user.ID, ReturningAdvertItem(adsList))
+	if err != nil {
+		log.Println(err, responses.StatusInternalServerError)
+		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
+			responses.ErrInternalServer))
+
+		return
+	}
+
+	responses.SendOkResponse(writer, responses.NewOkResponse(content))
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get cart summary for user %d", user.ID), responses.StatusOk)
+}
+
+// CleanCart убирает из корзины проданные и снятые объявления и возвращает то, что осталось.
+func (cartHandler *CartHandler) CleanCart(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	cartClient := cartHandler.cartClient
+	authClient := cartHandler.authClient
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	adsList, err := cartClient.GetCartByUserID(ctx, &cartproto.UserIdRequest{UserId: uint32(user.ID)})
+	if err != nil {
+		log.Println(err, responses.StatusBadRequest)
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	adverts := ReturningAdvertItem(adsList)
+	content := cartusecases.NewCartContent(adverts)
+	removed := make(map[uint]bool, len(content.Unavailable))
+
+	for _, item := range content.Unavailable {
+		_, err := cartClient.DeleteAdvByIDs(ctx,
+			&cartproto.UserIdAdvertIdRequest{UserId: uint32(user.ID), AdvertId: uint32(item.AdvertID)})
+		if err != nil {
+			log.Println(err, responses.StatusBadRequest)
+			logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+			responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+				responses.ErrBadRequest))
+
+			return
+		}
+
+		removed[item.AdvertID] = true
+	}
+
+	remaining := make([]*models.ReturningAdvert, 0, len(adverts))
+
+	for _, advert := range adverts {
+		if !removed[advert.Advert.ID] {
+			remaining = append(remaining, advert)
+		}
+	}
+
+	content, err = cartHandler.buildCartContent(ctx, user.ID, remaining)
+	if err != nil {
+		log.Println(err, responses.StatusInternalServerError)
+		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
+			responses.ErrInternalServer))
+
+		return
+	}
+
+	responses.SendOkResponse(writer, responses.NewOkResponse(content))
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Removed %d unavailable adverts from cart of user %d",
+		len(removed), user.ID), responses.StatusOk)
+}
+
 func (cartHandler *CartHandler) ChangeCart(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
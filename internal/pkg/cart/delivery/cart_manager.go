@@ -39,6 +39,8 @@ func ReturningAdvertItem(returningAdvertList *protobuf.ReturningAdvertList) []*m
 				Active:      returningAdvertList.Adverts[i].Advert.Active,
 				IsUsed:      returningAdvertList.Adverts[i].Advert.IsUsed,
 				Deleted:     returningAdvertList.Adverts[i].Advert.IsUsed,
+				Status:      returningAdvertList.Adverts[i].Advert.Status,
+				Reserved:    returningAdvertList.Adverts[i].Advert.Reserved,
 			},
 			City: models.City{
 				ID:          uint(returningAdvertList.Adverts[i].City.Id),
@@ -80,6 +82,8 @@ func newProtobufAdvertList(advertList []*models.ReturningAdvert) *protobuf.Retur
 			CloseTime:   timestamppb.New(advertList[i].Advert.ClosedTime),
 			Active:      advertList[i].Advert.Active,
 			IsUsed:      advertList[i].Advert.IsUsed,
+			Status:      advertList[i].Advert.Status,
+			Reserved:    advertList[i].Advert.Reserved,
 		}
 
 		protobufCity := protobuf.City{
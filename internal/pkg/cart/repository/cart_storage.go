@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
@@ -17,12 +18,14 @@ import (
 type CartStorage struct {
 	pool    *pgxpool.Pool
 	metrics *mymetrics.DatabaseMetrics
+	blobs   blobstore.BlobStore
 }
 
-func NewCartStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *CartStorage {
+func NewCartStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics, blobs blobstore.BlobStore) *CartStorage {
 	return &CartStorage{
 		pool:    pool,
 		metrics: metrics,
+		blobs:   blobs,
 	}
 }
 
@@ -47,9 +50,11 @@ func (cl *CartStorage) getCartByUserID(ctx context.Context, tx pgx.Tx, userID ui
 			a.closed_time, 
 			a.is_used,
 			a.advert_status,
-			(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY id LIMIT 1) AS first_image_url,
+			(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY position, id LIMIT 1) AS first_image_url,
 			CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $1 AND f.advert_id = a.id)
-         		THEN 1 ELSE 0 END AS bool) AS in_favourites
+         		THEN 1 ELSE 0 END AS bool) AS in_favourites,
+			EXISTS (SELECT 1 FROM advert_reservation r
+				WHERE r.advert_id = a.id AND r.user_id <> $1 AND r.reserved_until > NOW()) AS reserved
 		FROM 
 			public.advert a
 		LEFT JOIN 
@@ -93,13 +98,14 @@ func (cl *CartStorage) getCartByUserID(ctx context.Context, tx pgx.Tx, userID ui
 			&cityModel.Translation, &categoryModel.ID, &categoryModel.Name, &categoryModel.Translation,
 			&advertModel.Title, &advertModel.Description, &advertModel.Price, &advertModel.CreatedTime,
 			&advertModel.ClosedTime, &advertModel.IsUsed, &isActivePad, &photoPad.Photo,
-			&advertModel.InFavourites); err != nil {
+			&advertModel.InFavourites, &advertModel.Reserved); err != nil {
 			logging.LogError(logger, fmt.Errorf("something went wrong while scanning adverts from the cart, err=%w", err))
 
 			return nil, err
 		}
 
 		advertModel.Active = false
+		advertModel.Status = isActivePad
 
 		if isActivePad == "Активно" {
 			advertModel.Active = true
@@ -119,16 +125,8 @@ func (cl *CartStorage) getCartByUserID(ctx context.Context, tx pgx.Tx, userID ui
 			Category: categoryModel,
 		}
 
-		decodedImage, err := utils.DecodeImage(photoURLToInsert)
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		returningAdvertList.Photos = append(returningAdvertList.Photos, photoURLToInsert)
-		returningAdvertList.PhotosIMG = append(returningAdvertList.PhotosIMG, decodedImage)
+		returningAdvertList.Photos, returningAdvertList.PhotosIMG =
+			utils.MediaImages(ctx, cl.blobs, []string{photoURLToInsert})
 
 		adsList = append(adsList, &returningAdvertList)
 	}
@@ -11,3 +11,56 @@ type CartStorageInterface interface {
 	DeleteAdvByIDs(ctx context.Context, userID uint, advertID uint) error
 	AppendAdvByIDs(ctx context.Context, userID uint, advertID uint) bool
 }
+
+const soldStatus = "Продано"
+
+// NewCartContent раскладывает корзину по продавцам в порядке появления и отмечает объявления,
+// которые уже нельзя купить. Доставку потом считает DeliveryPricing.Apply.
+func NewCartContent(adverts []*models.ReturningAdvert) *models.CartContent {
+	content := &models.CartContent{
+		Sellers:     []*models.CartSellerGroup{},
+		Unavailable: []*models.UnavailableCartItem{},
+	}
+
+	groups := make(map[uint]*models.CartSellerGroup)
+
+	for _, advert := range adverts {
+		group, ok := groups[advert.Advert.UserID]
+		if !ok {
+			group = &models.CartSellerGroup{
+				SellerID:        advert.Advert.UserID,
+				DeliveryOptions: []*models.DeliveryOption{},
+			}
+			groups[advert.Advert.UserID] = group
+			content.Sellers = append(content.Sellers, group)
+		}
+
+		group.Adverts = append(group.Adverts, advert)
+
+		if advert.Advert.Active {
+			group.Subtotal += advert.Advert.Price
+
+			continue
+		}
+
+		reason := models.CartItemClosed
+		if advert.Advert.Status == soldStatus {
+			reason = models.CartItemSold
+		}
+
+		content.Unavailable = append(content.Unavailable, &models.UnavailableCartItem{
+			AdvertID: advert.Advert.ID,
+			Title:    advert.Advert.Title,
+			Reason:   reason,
+		})
+	}
+
+	for _, group := range content.Sellers {
+		group.Total = group.Subtotal
+		content.Subtotal += group.Subtotal
+	}
+
+	content.Total = content.Subtotal
+
+	return content
+}
@@ -0,0 +1,125 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	moscow  = 1
+	podolsk = 2
+	kazan   = 3
+)
+
+var regions = map[uint]uint{moscow: 10, podolsk: 10, kazan: 20}
+
+func advert(id, sellerID, cityID, price uint, status string) *models.ReturningAdvert {
+	return &models.ReturningAdvert{Advert: models.Advert{ID: id, UserID: sellerID, CityID: cityID, Price: price,
+		Title: "Объявление", Active: status == "Активно", Status: status}}
+}
+
+func newPricing() *usecases.DeliveryPricing {
+	return usecases.NewDeliveryPricing(config.DeliveryConfig{
+		CourierSameCity: 300,
+		PostSameCity:    250,
+		PostSameRegion:  350,
+		PostOtherRegion: 600,
+	}, nil)
+}
+
+func TestNewCartContent(t *testing.T) {
+	t.Parallel()
+
+	content := usecases.NewCartContent([]*models.ReturningAdvert{
+		advert(1, 7, moscow, 1000, "Активно"),
+		advert(2, 8, kazan, 500, "Продано"),
+		advert(3, 7, moscow, 200, "Активно"),
+		advert(4, 8, kazan, 700, "Скрыто"),
+	})
+
+	if len(content.Sellers) != 2 || content.Sellers[0].SellerID != 7 || content.Sellers[1].SellerID != 8 {
+		t.Fatalf("NewCartContent() sellers = %+v, want sellers 7 and 8 in cart order", content.Sellers)
+	}
+
+	if content.Sellers[0].Subtotal != 1200 || content.Sellers[1].Subtotal != 0 || content.Subtotal != 1200 {
+		t.Errorf("NewCartContent() subtotals = %d, %d, %d; want 1200, 0, 1200",
+			content.Sellers[0].Subtotal, content.Sellers[1].Subtotal, content.Subtotal)
+	}
+
+	want := []models.UnavailableCartItem{
+		{AdvertID: 2, Title: "Объявление", Reason: models.CartItemSold},
+		{AdvertID: 4, Title: "Объявление", Reason: models.CartItemClosed},
+	}
+
+	if len(content.Unavailable) != len(want) {
+		t.Fatalf("NewCartContent() unavailable = %d, want %d", len(content.Unavailable), len(want))
+	}
+
+	for i, item := range content.Unavailable {
+		if *item != want[i] {
+			t.Errorf("NewCartContent() unavailable[%d] = %+v, want %+v", i, *item, want[i])
+		}
+	}
+}
+
+func TestApplyDelivery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		adverts    []*models.ReturningAdvert
+		buyerCity  uint
+		methods    map[uint]string
+		wantMethod string
+		wantPrice  uint
+		wantErr    error
+	}{
+		{name: "same city defaults to courier", adverts: []*models.ReturningAdvert{advert(1, 7, moscow, 100, "Активно")},
+			buyerCity: moscow, wantMethod: models.DeliveryCourier, wantPrice: 300},
+		{name: "same region", adverts: []*models.ReturningAdvert{advert(1, 7, podolsk, 100, "Активно")},
+			buyerCity: moscow, wantMethod: models.DeliveryPost, wantPrice: 350},
+		{name: "farthest advert wins", adverts: []*models.ReturningAdvert{advert(1, 7, moscow, 100, "Активно"),
+			advert(2, 7, kazan, 100, "Активно")}, buyerCity: moscow, wantMethod: models.DeliveryPost, wantPrice: 600},
+		{name: "unknown buyer city", adverts: []*models.ReturningAdvert{advert(1, 7, moscow, 100, "Активно")},
+			wantMethod: models.DeliveryPost, wantPrice: 600},
+		{name: "pickup chosen", adverts: []*models.ReturningAdvert{advert(1, 7, kazan, 100, "Активно")},
+			buyerCity: moscow, methods: map[uint]string{7: models.DeliveryPickup}, wantMethod: models.DeliveryPickup},
+		{name: "courier to other city", adverts: []*models.ReturningAdvert{advert(1, 7, kazan, 100, "Активно")},
+			buyerCity: moscow, methods: map[uint]string{7: models.DeliveryCourier}, wantErr: usecases.ErrDeliveryMethod},
+		{name: "nothing to deliver", adverts: []*models.ReturningAdvert{advert(1, 7, kazan, 100, "Продано")},
+			buyerCity: moscow},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			content := usecases.NewCartContent(tt.adverts)
+
+			err := newPricing().ApplyWithRegions(content, tt.buyerCity, regions, tt.methods)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ApplyWithRegions() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			group := content.Sellers[0]
+			if group.DeliveryMethod != tt.wantMethod || group.DeliveryPrice != tt.wantPrice {
+				t.Errorf("ApplyWithRegions() delivery = %s/%d, want %s/%d", group.DeliveryMethod,
+					group.DeliveryPrice, tt.wantMethod, tt.wantPrice)
+			}
+
+			if content.Total != content.Subtotal+tt.wantPrice {
+				t.Errorf("ApplyWithRegions() total = %d, want %d", content.Total, content.Subtotal+tt.wantPrice)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+var ErrDeliveryMethod = errors.New("delivery method is not available for this seller")
+
+type deliveryZone int
+
+const (
+	zoneSameCity deliveryZone = iota
+	zoneSameRegion
+	zoneOtherRegion
+)
+
+// CityDirectory - справочник городов с регионами, его отдаёт кэш городов.
+type CityDirectory interface {
+	GetCityList(ctx context.Context) (*models.CityList, error)
+}
+
+// DeliveryPricing считает доставку на сервере, чтобы клиент не мог сам назначить её цену.
+type DeliveryPricing struct {
+	cfg    config.DeliveryConfig
+	cities CityDirectory
+}
+
+func NewDeliveryPricing(cfg config.DeliveryConfig, cities CityDirectory) *DeliveryPricing {
+	return &DeliveryPricing{
+		cfg:    cfg,
+		cities: cities,
+	}
+}
+
+// RegionsByCity строит из справочника городов соответствие город -> регион.
+func RegionsByCity(cityList *models.CityList) map[uint]uint {
+	regions := make(map[uint]uint, len(cityList.CityItems))
+
+	for _, city := range cityList.CityItems {
+		if city.RegionID != nil {
+			regions[city.ID] = *city.RegionID
+		}
+	}
+
+	return regions
+}
+
+func zoneBetween(buyerCityID, sellerCityID uint, regions map[uint]uint) deliveryZone {
+	if buyerCityID != 0 && buyerCityID == sellerCityID {
+		return zoneSameCity
+	}
+
+	buyerRegion, buyerOk := regions[buyerCityID]
+	sellerRegion, sellerOk := regions[sellerCityID]
+
+	if buyerOk && sellerOk && buyerRegion == sellerRegion {
+		return zoneSameRegion
+	}
+
+	return zoneOtherRegion
+}
+
+// options перечисляет способы доставки для отправки в зону; первым идёт способ по умолчанию.
+func (pricing *DeliveryPricing) options(zone deliveryZone) []*models.DeliveryOption {
+	options := make([]*models.DeliveryOption, 0, 3)
+
+	post := pricing.cfg.PostOtherRegion
+
+	switch zone {
+	case zoneSameCity:
+		options = append(options, &models.DeliveryOption{Method: models.DeliveryCourier,
+			Price: pricing.cfg.CourierSameCity})
+		post = pricing.cfg.PostSameCity
+	case zoneSameRegion:
+		post = pricing.cfg.PostSameRegion
+	case zoneOtherRegion:
+	}
+
+	options = append(options,
+		&models.DeliveryOption{Method: models.DeliveryPost, Price: post},
+		&models.DeliveryOption{Method: models.DeliveryPickup, Price: 0})
+
+	return options
+}
+
+// Apply заполняет варианты и цену доставки для каждого продавца по справочнику городов.
+func (pricing *DeliveryPricing) Apply(ctx context.Context, content *models.CartContent, buyerCityID uint,
+	methods map[uint]string) error {
+	cityList, err := pricing.cities.GetCityList(ctx)
+	if err != nil {
+		return err
+	}
+
+	return pricing.ApplyWithRegions(content, buyerCityID, RegionsByCity(cityList), methods)
+}
+
+// ApplyWithRegions считает доставку по готовому соответствию город -> регион. Зону отправки определяет
+// самый дальний от покупателя город среди доступных объявлений продавца. methods задаёт выбранный способ
+// по продавцу, для остальных берётся способ по умолчанию.
+func (pricing *DeliveryPricing) ApplyWithRegions(content *models.CartContent, buyerCityID uint,
+	regions map[uint]uint, methods map[uint]string) error {
+	content.DeliveryPrice = 0
+
+	for _, group := range content.Sellers {
+		group.DeliveryOptions = []*models.DeliveryOption{}
+		group.DeliveryMethod = ""
+		group.DeliveryPrice = 0
+
+		zone, hasAvailable := zoneSameCity, false
+
+		for _, advert := range group.Adverts {
+			if !advert.Advert.Active {
+				continue
+			}
+
+			hasAvailable = true
+			zone = max(zone, zoneBetween(buyerCityID, advert.Advert.CityID, regions))
+		}
+
+		if hasAvailable {
+			group.DeliveryOptions = pricing.options(zone)
+
+			chosen := group.DeliveryOptions[0]
+
+			if method, ok := methods[group.SellerID]; ok && method != "" {
+				chosen = nil
+
+				for _, option := range group.DeliveryOptions {
+					if option.Method == method {
+						chosen = option
+					}
+				}
+
+				if chosen == nil {
+					return ErrDeliveryMethod
+				}
+			}
+
+			group.DeliveryMethod = chosen.Method
+			group.DeliveryPrice = chosen.Price
+		}
+
+		group.Total = group.Subtotal + group.DeliveryPrice
+		content.DeliveryPrice += group.DeliveryPrice
+	}
+
+	content.Total = content.Subtotal + content.DeliveryPrice
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package delivery
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	categoryusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/usecases"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+var errNotModerator = errors.New("user is not a moderator")
+
+type CategoryHandler struct {
+	storage           categoryusecases.CategoryStorageInterface
+	moderationStorage moderationusecases.ModerationStorageInterface
+	authClient        authproto.AuthClient
+}
+
+func NewCategoryHandler(storage categoryusecases.CategoryStorageInterface,
+	moderationStorage moderationusecases.ModerationStorageInterface,
+	authClient authproto.AuthClient) *CategoryHandler {
+	return &CategoryHandler{
+		storage:           storage,
+		moderationStorage: moderationStorage,
+		authClient:        authClient,
+	}
+}
+
+// GetCategoryTree возвращает дерево категорий; с ?city= объявления считаются только в этом городе.
+func (h *CategoryHandler) GetCategoryTree(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	city := request.URL.Query().Get("city")
+
+	categories, err := h.storage.GetCategories(ctx, city)
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.CategoryTree{
+		City:       city,
+		Categories: categoryusecases.BuildTree(categories),
+	}))
+}
+
+func (h *CategoryHandler) CreateCategory(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if !h.checkModerator(writer, request, logger) {
+		return
+	}
+
+	data, ok := h.readCategory(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	category, err := h.storage.CreateCategory(ctx, data)
+	if err != nil {
+		sendCategoryError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(category))
+}
+
+// EditCategory переименовывает категорию и переносит её к другому родителю; перенос в своё поддерево запрещён.
+func (h *CategoryHandler) EditCategory(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if !h.checkModerator(writer, request, logger) {
+		return
+	}
+
+	data, ok := h.readCategory(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	id, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	category, err := h.storage.EditCategory(ctx, uint(id), data)
+	if err != nil {
+		sendCategoryError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(category))
+}
+
+// DeleteCategory удаляет только пустую категорию: без подкатегорий и объявлений.
+func (h *CategoryHandler) DeleteCategory(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if !h.checkModerator(writer, request, logger) {
+		return
+	}
+
+	id, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	if err := h.storage.DeleteCategory(ctx, uint(id)); err != nil {
+		sendCategoryError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.CategoryNode{ID: uint(id)}))
+}
+
+// checkModerator пускает к изменению дерева только модераторов. При отказе ответ уже отправлен.
+func (h *CategoryHandler) checkModerator(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger) bool {
+	ctx := request.Context()
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := h.authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	if !h.moderationStorage.IsModerator(ctx, uint(user.ID)) {
+		logging.LogHandlerError(logger, errNotModerator, responses.StatusForbidden)
+		log.Println(errNotModerator, responses.StatusForbidden)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusForbidden,
+			responses.ErrForbidden))
+
+		return false
+	}
+
+	return true
+}
+
+func (h *CategoryHandler) readCategory(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger) (*models.ReceivedCategory, bool) {
+	var data models.ReceivedCategory
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err == nil {
+		err = categoryusecases.ValidateCategory(&data)
+	}
+
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrNotValidData))
+
+		return nil, false
+	}
+
+	return &data, true
+}
+
+func sendCategoryError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger, err error) {
+	code, message := responses.StatusBadRequest, responses.ErrBadRequest
+
+	switch {
+	case errors.Is(err, categoryusecases.ErrCategoryNotFound):
+		code, message = responses.StatusNotFound, responses.ErrCategoryNotExist
+	case errors.Is(err, categoryusecases.ErrCategoryCycle):
+		code, message = responses.StatusConflict, responses.ErrCategoryCycle
+	case errors.Is(err, categoryusecases.ErrCategoryNotEmpty):
+		code, message = responses.StatusConflict, responses.ErrCategoryNotEmpty
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
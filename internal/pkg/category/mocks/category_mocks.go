@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: category.go
+
+// Package mock_usecases is a generated GoMock package.
+package mock_usecases
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCategoryStorageInterface is a mock of CategoryStorageInterface interface.
+type MockCategoryStorageInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryStorageInterfaceMockRecorder
+}
+
+// MockCategoryStorageInterfaceMockRecorder is the mock recorder for MockCategoryStorageInterface.
+type MockCategoryStorageInterfaceMockRecorder struct {
+	mock *MockCategoryStorageInterface
+}
+
+// NewMockCategoryStorageInterface creates a new mock instance.
+func NewMockCategoryStorageInterface(ctrl *gomock.Controller) *MockCategoryStorageInterface {
+	mock := &MockCategoryStorageInterface{ctrl: ctrl}
+	mock.recorder = &MockCategoryStorageInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryStorageInterface) EXPECT() *MockCategoryStorageInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryStorageInterface) CreateCategory(ctx context.Context, data *models.ReceivedCategory) (*models.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, data)
+	ret0, _ := ret[0].(*models.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryStorageInterfaceMockRecorder) CreateCategory(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryStorageInterface)(nil).CreateCategory), ctx, data)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryStorageInterface) DeleteCategory(ctx context.Context, categoryID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryStorageInterfaceMockRecorder) DeleteCategory(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryStorageInterface)(nil).DeleteCategory), ctx, categoryID)
+}
+
+// EditCategory mocks base method.
+func (m *MockCategoryStorageInterface) EditCategory(ctx context.Context, categoryID uint, data *models.ReceivedCategory) (*models.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditCategory", ctx, categoryID, data)
+	ret0, _ := ret[0].(*models.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditCategory indicates an expected call of EditCategory.
+func (mr *MockCategoryStorageInterfaceMockRecorder) EditCategory(ctx, categoryID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditCategory", reflect.TypeOf((*MockCategoryStorageInterface)(nil).EditCategory), ctx, categoryID, data)
+}
+
+// GetCategories mocks base method.
+func (m *MockCategoryStorageInterface) GetCategories(ctx context.Context, city string) ([]*models.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", ctx, city)
+	ret0, _ := ret[0].([]*models.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockCategoryStorageInterfaceMockRecorder) GetCategories(ctx, city interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockCategoryStorageInterface)(nil).GetCategories), ctx, city)
+}
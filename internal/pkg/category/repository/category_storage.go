@@ -0,0 +1,371 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	categoryusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/usecases"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type CategoryStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewCategoryStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *CategoryStorage {
+	return &CategoryStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (cs *CategoryStorage) getCategories(ctx context.Context, tx pgx.Tx, city string) ([]*models.CategoryNode,
+	error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLCategories := `
+	SELECT cat.id, cat.parent_id, cat.name, cat.translation, COUNT(a.id)
+	FROM public.category cat
+	LEFT JOIN public.advert a ON a.category_id = cat.id AND a.advert_status = 'Активно'
+		AND ($1::TEXT = '' OR a.city_id = (SELECT c.id FROM public.city c WHERE c.translation = $1))
+	GROUP BY cat.id, cat.parent_id, cat.name, cat.translation
+	ORDER BY cat.id;`
+
+	logging.LogInfo(logger, "SELECT FROM category, advert")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLCategories, city)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while executing select categories query, err=%w",
+			err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var categories []*models.CategoryNode
+
+	for rows.Next() {
+		category := models.CategoryNode{}
+
+		if err := rows.Scan(&category.ID, &category.ParentID, &category.Name, &category.Translation,
+			&category.AdvertsNum); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning category rows, err=%w", err))
+			cs.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		categories = append(categories, &category)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning category rows, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (cs *CategoryStorage) GetCategories(ctx context.Context, city string) ([]*models.CategoryNode, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var categories []*models.CategoryNode
+
+	err := pgx.BeginFunc(ctx, cs.pool, func(tx pgx.Tx) error {
+		categoriesInner, err := cs.getCategories(ctx, tx, city)
+		categories = categoriesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting categories, err=%w", err))
+
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// lockCategories сериализует изменения дерева: две параллельные переброски иначе могут вместе замкнуть цикл.
+func (cs *CategoryStorage) lockCategories(ctx context.Context, tx pgx.Tx) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLLockCategories := `LOCK TABLE public.category IN SHARE ROW EXCLUSIVE MODE;`
+
+	logging.LogInfo(logger, "LOCK TABLE category")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLLockCategories)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while locking category table, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+// checkParent проверяет, что родитель существует и не лежит в поддереве categoryID (0 - новая категория).
+func (cs *CategoryStorage) checkParent(ctx context.Context, tx pgx.Tx, categoryID uint, parentID *uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if parentID == nil {
+		return nil
+	}
+
+	SQLCheckParent := `
+	WITH RECURSIVE subtree AS (
+		SELECT id FROM public.category WHERE id = $1
+		UNION ALL
+		SELECT ch.id FROM public.category ch INNER JOIN subtree ON ch.parent_id = subtree.id
+	)
+	SELECT EXISTS(SELECT 1 FROM public.category WHERE id = $2),
+		EXISTS(SELECT 1 FROM subtree WHERE id = $2);`
+
+	logging.LogInfo(logger, "SELECT FROM category")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCheckParent, categoryID, *parentID)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	var parentExists, inSubtree bool
+
+	if err := line.Scan(&parentExists, &inSubtree); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while checking category parent, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	switch {
+	case !parentExists:
+		return categoryusecases.ErrCategoryNotFound
+	case inSubtree:
+		return categoryusecases.ErrCategoryCycle
+	}
+
+	return nil
+}
+
+func (cs *CategoryStorage) createCategory(ctx context.Context, tx pgx.Tx,
+	data *models.ReceivedCategory) (*models.CategoryNode, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if err := cs.checkParent(ctx, tx, 0, data.ParentID); err != nil {
+		return nil, err
+	}
+
+	SQLCreateCategory := `
+	INSERT INTO public.category (name, translation, parent_id)
+	VALUES ($1, $2, $3)
+	RETURNING id;`
+
+	logging.LogInfo(logger, "INSERT INTO category")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCreateCategory, data.Name, data.Translation, data.ParentID)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	category := models.CategoryNode{ParentID: data.ParentID, Name: data.Name, Translation: data.Translation}
+
+	if err := line.Scan(&category.ID); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting category, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (cs *CategoryStorage) CreateCategory(ctx context.Context,
+	data *models.ReceivedCategory) (*models.CategoryNode, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var category *models.CategoryNode
+
+	err := pgx.BeginFunc(ctx, cs.pool, func(tx pgx.Tx) error {
+		if err := cs.lockCategories(ctx, tx); err != nil {
+			return err
+		}
+
+		categoryInner, err := cs.createCategory(ctx, tx, data)
+		category = categoryInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while creating category, err=%w", err))
+
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (cs *CategoryStorage) editCategory(ctx context.Context, tx pgx.Tx, categoryID uint,
+	data *models.ReceivedCategory) (*models.CategoryNode, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if err := cs.checkParent(ctx, tx, categoryID, data.ParentID); err != nil {
+		return nil, err
+	}
+
+	SQLEditCategory := `
+	UPDATE public.category
+	SET name = $2, translation = $3, parent_id = $4
+	WHERE id = $1;`
+
+	logging.LogInfo(logger, "UPDATE category")
+
+	start := time.Now()
+
+	tag, err := tx.Exec(ctx, SQLEditCategory, categoryID, data.Name, data.Translation, data.ParentID)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while updating category, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return nil, categoryusecases.ErrCategoryNotFound
+	}
+
+	return &models.CategoryNode{ID: categoryID, ParentID: data.ParentID, Name: data.Name,
+		Translation: data.Translation}, nil
+}
+
+func (cs *CategoryStorage) EditCategory(ctx context.Context, categoryID uint,
+	data *models.ReceivedCategory) (*models.CategoryNode, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var category *models.CategoryNode
+
+	err := pgx.BeginFunc(ctx, cs.pool, func(tx pgx.Tx) error {
+		if err := cs.lockCategories(ctx, tx); err != nil {
+			return err
+		}
+
+		categoryInner, err := cs.editCategory(ctx, tx, categoryID, data)
+		category = categoryInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while editing category, err=%w", err))
+
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (cs *CategoryStorage) deleteCategory(ctx context.Context, tx pgx.Tx, categoryID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLCategoryInUse := `
+	SELECT EXISTS(SELECT 1 FROM public.category WHERE parent_id = $1)
+		OR EXISTS(SELECT 1 FROM public.advert WHERE category_id = $1);`
+
+	logging.LogInfo(logger, "SELECT FROM category, advert")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCategoryInUse, categoryID)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	var inUse bool
+
+	if err := line.Scan(&inUse); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while checking category usage, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	if inUse {
+		return categoryusecases.ErrCategoryNotEmpty
+	}
+
+	SQLDeleteCategory := `DELETE FROM public.category WHERE id = $1;`
+
+	logging.LogInfo(logger, "DELETE FROM category")
+
+	start = time.Now()
+
+	tag, err := tx.Exec(ctx, SQLDeleteCategory, categoryID)
+
+	cs.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting category, err=%w", err))
+		cs.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return categoryusecases.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (cs *CategoryStorage) DeleteCategory(ctx context.Context, categoryID uint) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, cs.pool, func(tx pgx.Tx) error {
+		if err := cs.lockCategories(ctx, tx); err != nil {
+			return err
+		}
+
+		return cs.deleteCategory(ctx, tx, categoryID)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting category, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+//go:generate mockgen -source=category.go -destination=../mocks/category_mocks.go
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+	ErrCategoryCycle    = errors.New("category can not be moved into its own subtree")
+	ErrCategoryNotEmpty = errors.New("category has subcategories or adverts")
+	ErrCategoryNotValid = errors.New("category data is not valid")
+
+	// translation попадает в путь /api/adverts/{city}/{category}, поэтому ограничена тем же шаблоном
+	translationPattern = regexp.MustCompile(`^[a-zA-Z_]+$`)
+)
+
+const maxCategoryNameLen = 255
+
+type CategoryStorageInterface interface {
+	// GetCategories возвращает плоский список категорий с числом активных объявлений в самой категории;
+	// пустой city - по всем городам.
+	GetCategories(ctx context.Context, city string) ([]*models.CategoryNode, error)
+	CreateCategory(ctx context.Context, data *models.ReceivedCategory) (*models.CategoryNode, error)
+	EditCategory(ctx context.Context, categoryID uint, data *models.ReceivedCategory) (*models.CategoryNode, error)
+	DeleteCategory(ctx context.Context, categoryID uint) error
+}
+
+func ValidateCategory(data *models.ReceivedCategory) error {
+	if data.Name == "" || len(data.Name) > maxCategoryNameLen || !translationPattern.MatchString(data.Translation) {
+		return ErrCategoryNotValid
+	}
+
+	return nil
+}
+
+// BuildTree собирает дерево из плоского списка и суммирует счётчики объявлений снизу вверх.
+// Порядок детей сохраняется из списка; категории с несуществующим родителем становятся корнями.
+func BuildTree(categories []*models.CategoryNode) []*models.CategoryNode {
+	byID := make(map[uint]*models.CategoryNode, len(categories))
+
+	for _, category := range categories {
+		category.Children = nil
+		byID[category.ID] = category
+	}
+
+	var roots []*models.CategoryNode
+
+	for _, category := range categories {
+		parent, ok := byID[derefParent(category.ParentID)]
+		if category.ParentID == nil || !ok {
+			roots = append(roots, category)
+
+			continue
+		}
+
+		parent.Children = append(parent.Children, category)
+	}
+
+	for _, root := range roots {
+		sumAdverts(root)
+	}
+
+	return roots
+}
+
+func derefParent(parentID *uint) uint {
+	if parentID == nil {
+		return 0
+	}
+
+	return *parentID
+}
+
+func sumAdverts(category *models.CategoryNode) uint {
+	for _, child := range category.Children {
+		category.AdvertsNum += sumAdverts(child)
+	}
+
+	return category.AdvertsNum
+}
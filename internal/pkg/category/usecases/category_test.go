@@ -0,0 +1,66 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/usecases"
+)
+
+func parent(id uint) *uint {
+	return &id
+}
+
+func TestBuildTree(t *testing.T) {
+	t.Parallel()
+
+	tree := usecases.BuildTree([]*models.CategoryNode{
+		{ID: 1, Translation: "transport", AdvertsNum: 1},
+		{ID: 2, ParentID: parent(1), Translation: "cars", AdvertsNum: 3},
+		{ID: 3, ParentID: parent(2), Translation: "sedans", AdvertsNum: 5},
+		{ID: 4, Translation: "electronics", AdvertsNum: 2},
+		{ID: 5, ParentID: parent(42), Translation: "orphan"},
+	})
+
+	if len(tree) != 3 {
+		t.Fatalf("BuildTree() roots = %d, want 3", len(tree))
+	}
+
+	transport := tree[0]
+	if transport.AdvertsNum != 9 || len(transport.Children) != 1 || transport.Children[0].AdvertsNum != 8 {
+		t.Errorf("BuildTree() transport = %+v", transport)
+	}
+
+	if tree[1].AdvertsNum != 2 || tree[2].Translation != "orphan" {
+		t.Errorf("BuildTree() roots = %+v, %+v", tree[1], tree[2])
+	}
+}
+
+func TestValidateCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data models.ReceivedCategory
+		want error
+	}{
+		{name: "Valid", data: models.ReceivedCategory{Name: "Авто", Translation: "cars"}, want: nil},
+		{name: "Empty name", data: models.ReceivedCategory{Translation: "cars"}, want: usecases.ErrCategoryNotValid},
+		{name: "Translation not for url", data: models.ReceivedCategory{Name: "Авто", Translation: "cars/1"},
+			want: usecases.ErrCategoryNotValid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.ValidateCategory(&tt.data); !errors.Is(err, tt.want) {
+				t.Errorf("ValidateCategory() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
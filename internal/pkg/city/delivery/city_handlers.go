@@ -3,6 +3,9 @@ package delivery
 import (
 	"log"
 	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 
 	cityusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/city/usecases"
 	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
@@ -37,3 +40,25 @@ func (h *CityHandler) GetCityList(writer http.ResponseWriter, request *http.Requ
 	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
 	responses.SendOkResponse(writer, responses.NewOkResponse(city))
 }
+
+// SuggestCities подсказывает города по началу названия или транслитерации, допуская опечатки.
+func (h *CityHandler) SuggestCities(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	query := request.URL.Query().Get("q")
+	limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+
+	cities, err := h.storage.SuggestCities(ctx, query, limit)
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.CitySuggestions{Query: query, Cities: cities}))
+}
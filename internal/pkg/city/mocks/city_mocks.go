@@ -49,3 +49,56 @@ func (mr *MockCityStorageInterfaceMockRecorder) GetCityList(ctx interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCityList", reflect.TypeOf((*MockCityStorageInterface)(nil).GetCityList), ctx)
 }
+
+// SuggestCities mocks base method.
+func (m *MockCityStorageInterface) SuggestCities(ctx context.Context, query string, limit int) ([]*models.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestCities", ctx, query, limit)
+	ret0, _ := ret[0].([]*models.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestCities indicates an expected call of SuggestCities.
+func (mr *MockCityStorageInterfaceMockRecorder) SuggestCities(ctx, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestCities", reflect.TypeOf((*MockCityStorageInterface)(nil).SuggestCities), ctx, query, limit)
+}
+
+// MockCityDirectoryStorage is a mock of CityDirectoryStorage interface.
+type MockCityDirectoryStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityDirectoryStorageMockRecorder
+}
+
+// MockCityDirectoryStorageMockRecorder is the mock recorder for MockCityDirectoryStorage.
+type MockCityDirectoryStorageMockRecorder struct {
+	mock *MockCityDirectoryStorage
+}
+
+// NewMockCityDirectoryStorage creates a new mock instance.
+func NewMockCityDirectoryStorage(ctrl *gomock.Controller) *MockCityDirectoryStorage {
+	mock := &MockCityDirectoryStorage{ctrl: ctrl}
+	mock.recorder = &MockCityDirectoryStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityDirectoryStorage) EXPECT() *MockCityDirectoryStorageMockRecorder {
+	return m.recorder
+}
+
+// GetCityList mocks base method.
+func (m *MockCityDirectoryStorage) GetCityList(ctx context.Context) (*models.CityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCityList", ctx)
+	ret0, _ := ret[0].(*models.CityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCityList indicates an expected call of GetCityList.
+func (mr *MockCityDirectoryStorageMockRecorder) GetCityList(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCityList", reflect.TypeOf((*MockCityDirectoryStorage)(nil).GetCityList), ctx)
+}
@@ -29,9 +29,14 @@ func (cl *CityStorage) getCityList(ctx context.Context, tx pgx.Tx) (*models.City
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	SQLCityList := `SELECT id, name, translation FROM public.city;`
+	SQLCityList := `
+	SELECT c.id, c.name, c.translation, c.region_id, COUNT(a.id) AS adverts_number
+	FROM public.city c
+	LEFT JOIN public.advert a ON a.city_id = c.id AND a.advert_status = 'Активно'
+	GROUP BY c.id, c.name, c.translation, c.region_id
+	ORDER BY adverts_number DESC, c.name;`
 
-	logging.LogInfo(logger, "SELECT FROM city")
+	logging.LogInfo(logger, "SELECT FROM city, advert")
 
 	start := time.Now()
 
@@ -53,7 +58,8 @@ func (cl *CityStorage) getCityList(ctx context.Context, tx pgx.Tx) (*models.City
 
 	for rows.Next() {
 		city := models.City{}
-		if err := rows.Scan(&city.ID, &city.CityName, &city.Translation); err != nil {
+		if err := rows.Scan(&city.ID, &city.CityName, &city.Translation, &city.RegionID,
+			&city.AdvertsNum); err != nil {
 			return nil, err
 		}
 
@@ -69,6 +75,71 @@ func (cl *CityStorage) getCityList(ctx context.Context, tx pgx.Tx) (*models.City
 	return &cityList, nil
 }
 
+func (cl *CityStorage) getRegions(ctx context.Context, tx pgx.Tx) ([]*models.Region, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLRegions := `SELECT id, name, translation FROM public.region ORDER BY name;`
+
+	logging.LogInfo(logger, "SELECT FROM region")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLRegions)
+
+	cl.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger,
+			fmt.Errorf("something went wrong while executing select region query, err=%w", err))
+		cl.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var regions []*models.Region
+
+	for rows.Next() {
+		region := models.Region{}
+		if err := rows.Scan(&region.ID, &region.Name, &region.Translation); err != nil {
+			return nil, err
+		}
+
+		regions = append(regions, &region)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning region rows, err=%w", err))
+
+		return nil, err
+	}
+
+	return regions, nil
+}
+
+// groupByRegion раскладывает города по регионам, сохраняя порядок по популярности.
+func groupByRegion(cityList *models.CityList, regions []*models.Region) {
+	byID := make(map[uint]*models.Region, len(regions))
+
+	for _, region := range regions {
+		byID[region.ID] = region
+	}
+
+	for _, city := range cityList.CityItems {
+		if city.RegionID == nil {
+			continue
+		}
+
+		if region, ok := byID[*city.RegionID]; ok {
+			region.Cities = append(region.Cities, city)
+		}
+	}
+
+	cityList.Regions = regions
+}
+
 func (cl *CityStorage) GetCityList(ctx context.Context) (*models.CityList, error) {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
@@ -76,9 +147,19 @@ func (cl *CityStorage) GetCityList(ctx context.Context) (*models.CityList, error
 
 	err := pgx.BeginFunc(ctx, cl.pool, func(tx pgx.Tx) error {
 		cityListInner, err := cl.getCityList(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		regions, err := cl.getRegions(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		groupByRegion(cityListInner, regions)
 		cityList = cityListInner
 
-		return err
+		return nil
 	})
 
 	if err != nil {
@@ -10,4 +10,10 @@ import (
 
 type CityStorageInterface interface {
 	GetCityList(ctx context.Context) (*models.CityList, error)
+	SuggestCities(ctx context.Context, query string, limit int) ([]*models.City, error)
+}
+
+// CityDirectoryStorage - источник справочника, который кэширует CityCache.
+type CityDirectoryStorage interface {
+	GetCityList(ctx context.Context) (*models.CityList, error)
 }
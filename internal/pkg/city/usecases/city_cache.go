@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCityCacheTTL     = 10 * time.Minute
+	defaultCitySuggestLimit = 10
+)
+
+// CityCache держит справочник городов в памяти и перечитывает его из базы раз в ttl: сам сервис
+// городов не меняет, справочник обновляется только миграциями.
+// Если перечитать не удалось, отдаёт устаревшую копию, чтобы сбой базы не ронял выбор города.
+type CityCache struct {
+	storage      CityDirectoryStorage
+	ttl          time.Duration
+	suggestLimit int
+
+	mu       sync.RWMutex
+	cityList *models.CityList
+	loadedAt time.Time
+
+	// не даёт нескольким запросам одновременно перечитывать справочник
+	loadMu sync.Mutex
+}
+
+func NewCityCache(storage CityDirectoryStorage, cfg config.CitiesConfig) *CityCache {
+	cache := &CityCache{
+		storage:      storage,
+		ttl:          cfg.CacheTTL,
+		suggestLimit: cfg.SuggestLimit,
+	}
+
+	if cache.ttl <= 0 {
+		cache.ttl = defaultCityCacheTTL
+	}
+
+	if cache.suggestLimit <= 0 {
+		cache.suggestLimit = defaultCitySuggestLimit
+	}
+
+	return cache
+}
+
+func (c *CityCache) cached() (*models.CityList, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.cityList, c.cityList != nil && time.Since(c.loadedAt) < c.ttl
+}
+
+func (c *CityCache) GetCityList(ctx context.Context) (*models.CityList, error) {
+	if cityList, fresh := c.cached(); fresh {
+		return cityList, nil
+	}
+
+	c.loadMu.Lock()
+	defer c.loadMu.Unlock()
+
+	stale, fresh := c.cached()
+	if fresh {
+		return stale, nil
+	}
+
+	cityList, err := c.storage.GetCityList(ctx)
+	if err != nil {
+		if stale != nil {
+			logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+			logging.LogError(logger, fmt.Errorf("serving stale city list, err=%w", err))
+
+			return stale, nil
+		}
+
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.cityList = cityList
+	c.loadedAt = time.Now()
+	c.mu.Unlock()
+
+	return cityList, nil
+}
+
+func (c *CityCache) SuggestCities(ctx context.Context, query string, limit int) ([]*models.City, error) {
+	cityList, err := c.GetCityList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > c.suggestLimit {
+		limit = c.suggestLimit
+	}
+
+	return SuggestCities(cityList.CityItems, query, limit), nil
+}
@@ -0,0 +1,113 @@
+//nolint:all
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/city/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"go.uber.org/zap"
+)
+
+var testCities = []*models.City{
+	{ID: 1, CityName: "Москва", Translation: "Moscow", AdvertsNum: 100},
+	{ID: 2, CityName: "Санкт-Петербург", Translation: "Saint_Petersburg", AdvertsNum: 50},
+	{ID: 3, CityName: "Нижний Новгород", Translation: "Nizhny_Novgorod", AdvertsNum: 20},
+	{ID: 4, CityName: "Великий Новгород", Translation: "Veliky_Novgorod", AdvertsNum: 5},
+	{ID: 5, CityName: "Мурманск", Translation: "Murmansk", AdvertsNum: 3},
+}
+
+func cityIDs(cities []*models.City) []uint {
+	ids := make([]uint, 0, len(cities))
+
+	for _, city := range cities {
+		ids = append(ids, city.ID)
+	}
+
+	return ids
+}
+
+func TestSuggestCities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		want  []uint
+	}{
+		{name: "Empty query returns popular", query: "", want: []uint{1, 2, 3}},
+		{name: "Prefix by name", query: "мур", want: []uint{5}},
+		{name: "Prefix by translation", query: "sai", want: []uint{2}},
+		{name: "Word prefix", query: "новг", want: []uint{3, 4}},
+		{name: "Typo", query: "масква", want: []uint{1}},
+		{name: "Short query is not fuzzy", query: "мск", want: []uint{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := cityIDs(usecases.SuggestCities(testCities, tt.query, 3))
+			if len(got) != len(tt.want) {
+				t.Fatalf("SuggestCities() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("SuggestCities() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+type countingStorage struct {
+	calls int
+	err   error
+}
+
+func (s *countingStorage) GetCityList(_ context.Context) (*models.CityList, error) {
+	s.calls++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &models.CityList{CityItems: testCities}, nil
+}
+
+func TestCityCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), config.LoggerContextKey, zap.NewNop().Sugar())
+	storage := &countingStorage{}
+	cache := usecases.NewCityCache(storage, config.CitiesConfig{CacheTTL: 50 * time.Millisecond})
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.GetCityList(ctx); err != nil {
+			t.Fatalf("GetCityList() error = %v", err)
+		}
+	}
+
+	if storage.calls != 1 {
+		t.Errorf("storage calls = %d, want 1", storage.calls)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	storage.err = errors.New("db is down")
+
+	cityList, err := cache.GetCityList(ctx)
+	if err != nil || len(cityList.CityItems) != len(testCities) {
+		t.Errorf("GetCityList() after failed reload = %v, %v, want stale list", cityList, err)
+	}
+
+	if storage.calls != 2 {
+		t.Errorf("storage calls = %d, want 2", storage.calls)
+	}
+}
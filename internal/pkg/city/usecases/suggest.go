@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+const (
+	matchPrefix = iota
+	matchWordPrefix
+	matchSubstring
+	matchFuzzy
+	noMatch
+)
+
+// SuggestCities ищет города по началу названия или транслитерации, затем по началу слова, по подстроке
+// и, наконец, с опечатками. Внутри одного вида совпадения выше идут города с большим числом объявлений,
+// поэтому порядок cities (уже по популярности) сохраняется. Пустой запрос возвращает самые популярные.
+func SuggestCities(cities []*models.City, query string, limit int) []*models.City {
+	query = normalizeCityName(query)
+
+	type candidate struct {
+		city  *models.City
+		match int
+	}
+
+	candidates := make([]candidate, 0, len(cities))
+
+	for _, city := range cities {
+		match := min(cityMatch(normalizeCityName(city.CityName), query),
+			cityMatch(normalizeCityName(city.Translation), query))
+		if match != noMatch {
+			candidates = append(candidates, candidate{city: city, match: match})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].match != candidates[j].match {
+			return candidates[i].match < candidates[j].match
+		}
+
+		return candidates[i].city.AdvertsNum > candidates[j].city.AdvertsNum
+	})
+
+	suggestions := make([]*models.City, 0, min(limit, len(candidates)))
+
+	for _, candidate := range candidates[:min(limit, len(candidates))] {
+		suggestions = append(suggestions, candidate.city)
+	}
+
+	return suggestions
+}
+
+func normalizeCityName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, "ё", "е")
+
+	return strings.NewReplacer("-", " ", "_", " ").Replace(name)
+}
+
+func cityMatch(name, query string) int {
+	switch {
+	case strings.HasPrefix(name, query):
+		return matchPrefix
+	case strings.Contains(name, " "+query):
+		return matchWordPrefix
+	case strings.Contains(name, query):
+		return matchSubstring
+	}
+
+	queryRunes := []rune(query)
+	nameRunes := []rune(name)
+
+	typos := allowedTypos(len(queryRunes))
+	if typos == 0 {
+		return noMatch
+	}
+
+	// сравниваем с началом названия той же длины, чтобы недописанное название не считалось опечаткой
+	prefix := nameRunes[:min(len(nameRunes), len(queryRunes))]
+	if editDistance(prefix, queryRunes) <= typos {
+		return matchFuzzy
+	}
+
+	return noMatch
+}
+
+func allowedTypos(queryLen int) int {
+	switch {
+	case queryLen >= 8:
+		return 2
+	case queryLen >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -12,12 +12,14 @@ import (
 type RequestUUIDKey string
 type LoggerKey string
 type SessionKey string
+type InlineImagesKey string
 
 const (
-	RequestUUIDContextKey RequestUUIDKey = "requestUUID"
-	LoggerContextKey      LoggerKey      = "logger"
-	SessionContextKey     SessionKey     = "session"
-	cfgPath                              = "./internal/pkg/config/config.yaml"
+	RequestUUIDContextKey  RequestUUIDKey  = "requestUUID"
+	LoggerContextKey       LoggerKey       = "logger"
+	SessionContextKey      SessionKey      = "session"
+	InlineImagesContextKey InlineImagesKey = "inlineImages"
+	cfgPath                                = "./internal/pkg/config/config.yaml"
 )
 
 type CsrfConfig struct {
@@ -34,10 +36,225 @@ type ServerConfig struct {
 	AuthServicePort    string `yaml:"auth_service_port"`
 	ProfileServicePort string `yaml:"profile_service_port"`
 	CartServicePort    string `yaml:"cart_service_port"`
+	// Dev разрешает сервису авторизации стартовать без AUTH_TOKEN_SECRET, со случайным секретом
+	Dev bool `yaml:"dev"`
+}
+
+type PriceLimit struct {
+	Min uint `yaml:"min"`
+	Max uint `yaml:"max"`
+}
+
+type ModerationConfig struct {
+	AutoApprove  bool                  `yaml:"auto_approve"`
+	StopWords    []string              `yaml:"stop_words"`
+	RegexRules   []string              `yaml:"regex_rules"`
+	DetectPhones bool                  `yaml:"detect_phones"`
+	DetectURLs   bool                  `yaml:"detect_urls"`
+	PriceLimits  map[string]PriceLimit `yaml:"price_limits"`
+}
+
+type ImageRendition struct {
+	Name   string `yaml:"name"`
+	Width  int    `yaml:"width"`
+	Height int    `yaml:"height"`
+	Crop   bool   `yaml:"crop"`
+}
+
+// ImagesConfig - размеры картинок и формат. WebP кодируется без потерь и применяется ко всем размерам,
+// кроме full: тот остаётся JPEG, и quality влияет только на него.
+type ImagesConfig struct {
+	WebP       bool             `yaml:"webp"`
+	Quality    int              `yaml:"quality"`
+	Renditions []ImageRendition `yaml:"renditions"`
+}
+
+type LocalBlobStoreConfig struct {
+	Root    string `yaml:"root"`
+	BaseURL string `yaml:"base_url"`
+}
+
+// S3BlobStoreConfig описывает S3-совместимое хранилище. Ключи доступа берутся из переменных
+// окружения S3_ACCESS_KEY и S3_SECRET_KEY.
+type S3BlobStoreConfig struct {
+	Endpoint     string        `yaml:"endpoint"`
+	Region       string        `yaml:"region"`
+	Bucket       string        `yaml:"bucket"`
+	PathStyle    bool          `yaml:"path_style"`
+	SignedURLTTL time.Duration `yaml:"signed_url_ttl"`
+}
+
+type BlobStoreConfig struct {
+	Kind  string               `yaml:"kind"`
+	Local LocalBlobStoreConfig `yaml:"local"`
+	S3    S3BlobStoreConfig    `yaml:"s3"`
+}
+
+// RankingPolicyConfig задаёт, на каких позициях страницы (с единицы) стоят продвигаемые объявления
+// и как часто меняется их порядок. Нулевой RotationPeriod отключает ротацию.
+type RankingPolicyConfig struct {
+	PromotedSlots  []int         `yaml:"promoted_slots"`
+	RotationPeriod time.Duration `yaml:"rotation_period"`
+}
+
+// RankingConfig - политика по умолчанию и переопределения для отдельных выдач (city, category, search).
+type RankingConfig struct {
+	Default  RankingPolicyConfig            `yaml:"default"`
+	Listings map[string]RankingPolicyConfig `yaml:"listings"`
+}
+
+// UploadsConfig ограничивает принимаемые файлы: размеры в байтах, число файлов в запросе,
+// число пикселей после декодирования и допустимые MIME-типы по содержимому.
+type UploadsConfig struct {
+	MaxFileSize    int64    `yaml:"max_file_size"`
+	MaxRequestSize int64    `yaml:"max_request_size"`
+	MaxFiles       int      `yaml:"max_files"`
+	MaxPixels      int64    `yaml:"max_pixels"`
+	AllowedTypes   []string `yaml:"allowed_types"`
+	DocumentTypes  []string `yaml:"document_types"`
+}
+
+// UploadGCConfig управляет сборщиком файлов, на которые больше не ссылается база.
+type UploadGCConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	Interval    time.Duration `yaml:"interval"`
+	GracePeriod time.Duration `yaml:"grace_period"`
+	DryRun      bool          `yaml:"dry_run"`
+	Prefixes    []string      `yaml:"prefixes"`
+}
+
+// BumpConfig ограничивает разовое поднятие объявления: не чаще раза в Cooldown
+// и не больше MaxPerWindow раз за Window.
+type BumpConfig struct {
+	Cooldown     time.Duration `yaml:"cooldown"`
+	Window       time.Duration `yaml:"window"`
+	MaxPerWindow int           `yaml:"max_per_window"`
+}
+
+// CitiesConfig задаёт, как долго держать справочник городов в памяти и сколько подсказок отдавать.
+type CitiesConfig struct {
+	CacheTTL     time.Duration `yaml:"cache_ttl"`
+	SuggestLimit int           `yaml:"suggest_limit"`
+}
+
+// SearchSuggestionsConfig управляет индексом подсказок из журнала запросов. Запрос попадает в подсказки,
+// только если его искали не меньше MinFrequency раз за Window, чтобы не светить чужие единичные запросы.
+type SearchSuggestionsConfig struct {
+	RebuildInterval time.Duration `yaml:"rebuild_interval"`
+	Window          time.Duration `yaml:"window"`
+	TrendingWindow  time.Duration `yaml:"trending_window"`
+	Retention       time.Duration `yaml:"retention"`
+	MinFrequency    int           `yaml:"min_frequency"`
+	IndexSize       int           `yaml:"index_size"`
+	TrendingSize    int           `yaml:"trending_size"`
+}
+
+// DeliveryConfig - тарифы доставки в рублях за одну отправку от продавца. Курьер возит только по городу
+// продавца, почта - куда угодно, самовывоз бесплатный.
+type DeliveryConfig struct {
+	CourierSameCity uint `yaml:"courier_same_city"`
+	PostSameCity    uint `yaml:"post_same_city"`
+	PostSameRegion  uint `yaml:"post_same_region"`
+	PostOtherRegion uint `yaml:"post_other_region"`
+}
+
+// ReservationConfig - сколько объявление держится за покупателем, пока он оформляет заказ.
+type ReservationConfig struct {
+	Duration time.Duration `yaml:"duration"`
+}
+
+// SMTPMailerConfig - почтовый сервер. Пароль берётся из переменной окружения SMTP_PASSWORD.
+type SMTPMailerConfig struct {
+	Host     string        `yaml:"host"`
+	Port     int           `yaml:"port"`
+	Username string        `yaml:"username"`
+	StartTLS bool          `yaml:"starttls"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+type FileMailerConfig struct {
+	Dir string `yaml:"dir"`
+}
+
+// MailQueueConfig - очередь отправки: письмо пробуют отправить MaxAttempts раз,
+// каждый раз ожидая вдвое дольше, начиная с RetryDelay.
+type MailQueueConfig struct {
+	Size        int           `yaml:"size"`
+	Workers     int           `yaml:"workers"`
+	MaxAttempts int           `yaml:"max_attempts"`
+	RetryDelay  time.Duration `yaml:"retry_delay"`
+}
+
+// MailerConfig выбирает, куда уходят письма: smtp, file (файлы .eml в каталоге) или log.
+type MailerConfig struct {
+	Kind    string           `yaml:"kind"`
+	From    string           `yaml:"from"`
+	BaseURL string           `yaml:"base_url"`
+	SMTP    SMTPMailerConfig `yaml:"smtp"`
+	File    FileMailerConfig `yaml:"file"`
+	Queue   MailQueueConfig  `yaml:"queue"`
+}
+
+// SMSConfig выбирает шлюз для SMS; пока есть только log - сообщения пишутся в лог.
+type SMSConfig struct {
+	Kind   string `yaml:"kind"`
+	Sender string `yaml:"sender"`
+}
+
+// PhoneVerificationConfig - одноразовые коды подтверждения телефона. Повторно код можно запросить не чаще
+// раза в ResendInterval и не больше MaxSendsPerHour раз в час на пользователя и на номер.
+type PhoneVerificationConfig struct {
+	CodeLength      int           `yaml:"code_length"`
+	CodeTTL         time.Duration `yaml:"code_ttl"`
+	MaxAttempts     int           `yaml:"max_attempts"`
+	ResendInterval  time.Duration `yaml:"resend_interval"`
+	MaxSendsPerHour int           `yaml:"max_sends_per_hour"`
+	// RequireForAdverts запрещает создавать объявления без подтверждённого телефона
+	RequireForAdverts bool `yaml:"require_for_adverts"`
+}
+
+// EmailConfirmationConfig - письма со ссылкой подтверждения адреса. Ссылки подписываются секретом из
+// переменной окружения AUTH_TOKEN_SECRET; без неё сервис авторизации не стартует, а в dev-режиме берёт
+// случайный, и ссылки перестают работать после перезапуска.
+type EmailConfirmationConfig struct {
+	TokenTTL        time.Duration `yaml:"token_ttl"`
+	ResendInterval  time.Duration `yaml:"resend_interval"`
+	MaxSendsPerHour int           `yaml:"max_sends_per_hour"`
+}
+
+// PasswordResetConfig - письма со ссылкой сброса пароля, подписываются тем же AUTH_TOKEN_SECRET.
+type PasswordResetConfig struct {
+	TokenTTL        time.Duration `yaml:"token_ttl"`
+	ResendInterval  time.Duration `yaml:"resend_interval"`
+	MaxSendsPerHour int           `yaml:"max_sends_per_hour"`
+}
+
+// SessionsConfig - срок жизни сессий. Каждый запрос продлевает сессию на IdleTTL, но не дальше AbsoluteTTL
+// от входа; кука сессии живёт столько же.
+type SessionsConfig struct {
+	IdleTTL     time.Duration `yaml:"idle_ttl"`
+	AbsoluteTTL time.Duration `yaml:"absolute_ttl"`
 }
 
 type Config struct {
-	Server ServerConfig `yaml:"server"`
+	Server        ServerConfig            `yaml:"server"`
+	Moderation    ModerationConfig        `yaml:"moderation"`
+	Images        ImagesConfig            `yaml:"images"`
+	BlobStore     BlobStoreConfig         `yaml:"blob_store"`
+	UploadGC      UploadGCConfig          `yaml:"upload_gc"`
+	Uploads       UploadsConfig           `yaml:"uploads"`
+	Ranking       RankingConfig           `yaml:"ranking"`
+	Bump          BumpConfig              `yaml:"bump"`
+	Cities        CitiesConfig            `yaml:"cities"`
+	Search        SearchSuggestionsConfig `yaml:"search_suggestions"`
+	Delivery      DeliveryConfig          `yaml:"delivery"`
+	Reservation   ReservationConfig       `yaml:"reservation"`
+	Mailer        MailerConfig            `yaml:"mailer"`
+	SMS           SMSConfig               `yaml:"sms"`
+	Phone         PhoneVerificationConfig `yaml:"phone_verification"`
+	EmailConfirm  EmailConfirmationConfig `yaml:"email_confirmation"`
+	PasswordReset PasswordResetConfig     `yaml:"password_reset"`
+	Sessions      SessionsConfig          `yaml:"sessions"`
 }
 
 func ReadConfig() *Config {
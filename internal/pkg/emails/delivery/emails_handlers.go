@@ -0,0 +1,87 @@
+package delivery
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+type EmailsHandler struct {
+	storage    emailsusecases.EmailsStorageInterface
+	authClient authproto.AuthClient
+}
+
+func NewEmailsHandler(storage emailsusecases.EmailsStorageInterface, authClient authproto.AuthClient) *EmailsHandler {
+	return &EmailsHandler{
+		storage:    storage,
+		authClient: authClient,
+	}
+}
+
+func (h *EmailsHandler) GetPreferences(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	preferences, err := h.storage.GetPreferences(ctx, userID)
+	if err != nil {
+		sendEmailsError(writer, request, logger, err, responses.StatusInternalServerError,
+			responses.ErrInternalServer)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get email preferences for user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(preferences))
+}
+
+// SetPreferences сохраняет настройки целиком: флаги, которых нет в запросе, выключаются.
+func (h *EmailsHandler) SetPreferences(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var preferences models.EmailPreferences
+
+	data, _ := io.ReadAll(request.Body)
+
+	if err := preferences.UnmarshalJSON(data); err != nil {
+		sendEmailsError(writer, request, logger, err, responses.StatusBadRequest, responses.ErrBadRequest)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	if err := h.storage.SetPreferences(ctx, userID, &preferences); err != nil {
+		sendEmailsError(writer, request, logger, err, responses.StatusInternalServerError,
+			responses.ErrInternalServer)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Set email preferences for user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(preferences))
+}
+
+func sendEmailsError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger, err error,
+	code int, message string) {
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type EmailsStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewEmailsStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *EmailsStorage {
+	return &EmailsStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (storage *EmailsStorage) getRecipient(ctx context.Context, tx pgx.Tx,
+	userID uint) (*models.EmailRecipient, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetRecipient := `
+	SELECT u.email, COALESCE(ep.orders, TRUE), COALESCE(ep.payments, TRUE)
+	FROM public."user" u
+	LEFT JOIN public.email_preference ep ON ep.user_id = u.id
+	WHERE u.id = $1;`
+
+	logging.LogInfo(logger, "SELECT FROM user, email_preference")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetRecipient, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	recipient := models.EmailRecipient{}
+
+	err := line.Scan(&recipient.Email, &recipient.Preferences.Orders, &recipient.Preferences.Payments)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, emailsusecases.ErrRecipientNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting email recipient, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &recipient, nil
+}
+
+func (storage *EmailsStorage) GetRecipient(ctx context.Context, userID uint) (*models.EmailRecipient, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var recipient *models.EmailRecipient
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		recipientInner, err := storage.getRecipient(ctx, tx, userID)
+		recipient = recipientInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting email recipient, err=%w", err))
+
+		return nil, err
+	}
+
+	return recipient, nil
+}
+
+func (storage *EmailsStorage) GetPreferences(ctx context.Context, userID uint) (*models.EmailPreferences, error) {
+	recipient, err := storage.GetRecipient(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recipient.Preferences, nil
+}
+
+func (storage *EmailsStorage) setPreferences(ctx context.Context, tx pgx.Tx, userID uint,
+	preferences *models.EmailPreferences) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetPreferences := `
+	INSERT INTO public.email_preference (user_id, orders, payments)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_id) DO UPDATE
+	SET orders = EXCLUDED.orders, payments = EXCLUDED.payments, updated_time = NOW();`
+
+	logging.LogInfo(logger, "INSERT INTO email_preference")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLSetPreferences, userID, preferences.Orders, preferences.Payments)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while saving email preferences, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *EmailsStorage) SetPreferences(ctx context.Context, userID uint,
+	preferences *models.EmailPreferences) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		return storage.setPreferences(ctx, tx, userID, preferences)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting email preferences, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *EmailsStorage) getAdvertTitle(ctx context.Context, tx pgx.Tx, advertID uint) (string, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetAdvertTitle := `SELECT title FROM public.advert WHERE id = $1;`
+
+	logging.LogInfo(logger, "SELECT FROM advert")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetAdvertTitle, advertID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	var title string
+
+	if err := line.Scan(&title); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting advert title, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return "", err
+	}
+
+	return title, nil
+}
+
+func (storage *EmailsStorage) GetAdvertTitle(ctx context.Context, advertID uint) (string, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var title string
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		titleInner, err := storage.getAdvertTitle(ctx, tx, advertID)
+		title = titleInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting advert title, err=%w", err))
+
+		return "", err
+	}
+
+	return title, nil
+}
@@ -0,0 +1,159 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/mailer"
+)
+
+var ErrRecipientNotFound = errors.New("email recipient not found")
+
+type EmailsStorageInterface interface {
+	// GetPreferences отдаёт настройки пользователя, а если он их не менял - значения по умолчанию.
+	GetPreferences(ctx context.Context, userID uint) (*models.EmailPreferences, error)
+	SetPreferences(ctx context.Context, userID uint, preferences *models.EmailPreferences) error
+	GetRecipient(ctx context.Context, userID uint) (*models.EmailRecipient, error)
+	GetAdvertTitle(ctx context.Context, advertID uint) (string, error)
+}
+
+// MailQueue - очередь фоновой отправки, в приложении это *mailer.Queue.
+type MailQueue interface {
+	Enqueue(msg *mailer.Message) error
+}
+
+// Emailer собирает письма по шаблонам и ставит их в очередь, учитывая настройки получателя.
+// Письмо - дополнение к действию пользователя, поэтому вызывающие только логируют ошибки.
+type Emailer struct {
+	storage   EmailsStorageInterface
+	queue     MailQueue
+	templates *mailer.Templates
+	baseURL   string
+}
+
+func NewEmailer(storage EmailsStorageInterface, queue MailQueue, templates *mailer.Templates,
+	baseURL string) *Emailer {
+	return &Emailer{
+		storage:   storage,
+		queue:     queue,
+		templates: templates,
+		baseURL:   baseURL,
+	}
+}
+
+// Welcome приветствует нового пользователя; если передан токен, в письме будет и ссылка подтверждения адреса.
+func (emailer *Emailer) Welcome(email, confirmToken string) error {
+	data := mailer.WelcomeData{
+		BaseURL: emailer.baseURL,
+		Email:   email,
+	}
+
+	if confirmToken != "" {
+		data.ConfirmURL = emailer.confirmURL(confirmToken)
+	}
+
+	return emailer.send(mailer.TemplateWelcome, email, data)
+}
+
+// ConfirmEmail отправляет ссылку подтверждения на адрес, который её ждёт, - в том числе на новый, ещё не
+// подтверждённый, поэтому настройки рассылок здесь не учитываются.
+func (emailer *Emailer) ConfirmEmail(email, confirmToken string) error {
+	return emailer.send(mailer.TemplateConfirmEmail, email, mailer.ConfirmEmailData{
+		BaseURL:    emailer.baseURL,
+		Email:      email,
+		ConfirmURL: emailer.confirmURL(confirmToken),
+	})
+}
+
+func (emailer *Emailer) OrderPlaced(ctx context.Context, buyerID uint, items []*models.ReceivedOrderItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	recipient, err := emailer.storage.GetRecipient(ctx, buyerID)
+	if err != nil || !recipient.Preferences.Orders {
+		return err
+	}
+
+	data := mailer.OrderPlacedData{
+		BaseURL: emailer.baseURL,
+		Items:   make([]mailer.OrderLine, 0, len(items)),
+	}
+
+	for _, item := range items {
+		data.Items = append(data.Items, mailer.OrderLine{
+			AdvertID:      item.AdvertID,
+			Title:         item.Title,
+			Price:         item.Price,
+			DeliveryPrice: item.DeliveryPrice,
+		})
+
+		data.Total += item.Price + item.DeliveryPrice
+	}
+
+	return emailer.send(mailer.TemplateOrderPlaced, recipient.Email, data)
+}
+
+func (emailer *Emailer) OrderStatusChanged(ctx context.Context, userID, advertID uint, status string) error {
+	recipient, err := emailer.storage.GetRecipient(ctx, userID)
+	if err != nil || !recipient.Preferences.Orders {
+		return err
+	}
+
+	title, err := emailer.storage.GetAdvertTitle(ctx, advertID)
+	if err != nil {
+		return err
+	}
+
+	return emailer.send(mailer.TemplateOrderStatusChanged, recipient.Email, mailer.OrderStatusData{
+		BaseURL:  emailer.baseURL,
+		AdvertID: advertID,
+		Title:    title,
+		Status:   status,
+	})
+}
+
+func (emailer *Emailer) PaymentSucceeded(ctx context.Context, sellerID, advertID uint) error {
+	recipient, err := emailer.storage.GetRecipient(ctx, sellerID)
+	if err != nil || !recipient.Preferences.Payments {
+		return err
+	}
+
+	title, err := emailer.storage.GetAdvertTitle(ctx, advertID)
+	if err != nil {
+		return err
+	}
+
+	return emailer.send(mailer.TemplatePaymentSucceeded, recipient.Email, mailer.PaymentData{
+		BaseURL:  emailer.baseURL,
+		AdvertID: advertID,
+		Title:    title,
+	})
+}
+
+// PasswordReset отправляет ссылку сброса пароля; письмо нужно для входа, поэтому настройки рассылок
+// тоже не учитываются.
+func (emailer *Emailer) PasswordReset(email, resetToken string) error {
+	return emailer.send(mailer.TemplatePasswordReset, email, mailer.PasswordResetData{
+		BaseURL:  emailer.baseURL,
+		Email:    email,
+		ResetURL: emailer.baseURL + "/reset-password?token=" + url.QueryEscape(resetToken),
+	})
+}
+
+// confirmURL ведёт на страницу фронтенда, которая отправляет токен в /api/auth/email/confirm.
+func (emailer *Emailer) confirmURL(token string) string {
+	return emailer.baseURL + "/confirm-email?token=" + url.QueryEscape(token)
+}
+
+func (emailer *Emailer) send(template, to string, data any) error {
+	msg, err := emailer.templates.Render(template, to, data)
+	if err != nil {
+		return fmt.Errorf("render %s: %w", template, err)
+	}
+
+	return emailer.queue.Enqueue(msg)
+}
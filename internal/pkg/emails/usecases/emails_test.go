@@ -0,0 +1,140 @@
+//nolint:all
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/mailer"
+)
+
+type storageStub struct {
+	usecases.EmailsStorageInterface
+
+	recipients map[uint]*models.EmailRecipient
+}
+
+func (s *storageStub) GetRecipient(_ context.Context, userID uint) (*models.EmailRecipient, error) {
+	recipient, ok := s.recipients[userID]
+	if !ok {
+		return nil, usecases.ErrRecipientNotFound
+	}
+
+	return recipient, nil
+}
+
+func (s *storageStub) GetAdvertTitle(_ context.Context, _ uint) (string, error) {
+	return "Велосипед", nil
+}
+
+type queueStub struct {
+	messages []*mailer.Message
+}
+
+func (q *queueStub) Enqueue(msg *mailer.Message) error {
+	q.messages = append(q.messages, msg)
+
+	return nil
+}
+
+func newEmailer(t *testing.T) (*usecases.Emailer, *queueStub) {
+	templates, err := mailer.LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage := &storageStub{recipients: map[uint]*models.EmailRecipient{
+		1: {Email: "all@example.com", Preferences: models.DefaultEmailPreferences()},
+		2: {Email: "none@example.com"},
+	}}
+	queue := &queueStub{}
+
+	return usecases.NewEmailer(storage, queue, templates, "http://vol-4-ok.ru"), queue
+}
+
+func TestEmailerRespectsPreferences(t *testing.T) {
+	t.Parallel()
+
+	items := []*models.ReceivedOrderItem{{AdvertID: 7, Title: "Велосипед", Price: 1000, DeliveryPrice: 300}}
+
+	tests := []struct {
+		name    string
+		send    func(emailer *usecases.Emailer) error
+		wantTo  string
+		wantErr error
+	}{
+		{name: "welcome", send: func(e *usecases.Emailer) error { return e.Welcome("new@example.com", "") },
+			wantTo: "new@example.com"},
+		{name: "confirm email", send: func(e *usecases.Emailer) error {
+			return e.ConfirmEmail("changed@example.com", "token")
+		}, wantTo: "changed@example.com"},
+		{name: "password reset", send: func(e *usecases.Emailer) error {
+			return e.PasswordReset("user@example.com", "token")
+		}, wantTo: "user@example.com"},
+		{name: "order placed", send: func(e *usecases.Emailer) error {
+			return e.OrderPlaced(context.Background(), 1, items)
+		}, wantTo: "all@example.com"},
+		{name: "order placed, orders off", send: func(e *usecases.Emailer) error {
+			return e.OrderPlaced(context.Background(), 2, items)
+		}},
+		{name: "order status changed", send: func(e *usecases.Emailer) error {
+			return e.OrderStatusChanged(context.Background(), 1, 7, "доставлен")
+		}, wantTo: "all@example.com"},
+		{name: "payment succeeded", send: func(e *usecases.Emailer) error {
+			return e.PaymentSucceeded(context.Background(), 1, 7)
+		}, wantTo: "all@example.com"},
+		{name: "payment succeeded, payments off", send: func(e *usecases.Emailer) error {
+			return e.PaymentSucceeded(context.Background(), 2, 7)
+		}},
+		{name: "unknown user", send: func(e *usecases.Emailer) error {
+			return e.PaymentSucceeded(context.Background(), 3, 7)
+		}, wantErr: usecases.ErrRecipientNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			emailer, queue := newEmailer(t)
+
+			if err := tt.send(emailer); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("send error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantTo == "" {
+				if len(queue.messages) != 0 {
+					t.Errorf("queued %d messages, want none", len(queue.messages))
+				}
+
+				return
+			}
+
+			if len(queue.messages) != 1 || queue.messages[0].To != tt.wantTo {
+				t.Fatalf("queued %v, want one message to %s", queue.messages, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestEmailerOrderPlacedTotal(t *testing.T) {
+	t.Parallel()
+
+	emailer, queue := newEmailer(t)
+
+	err := emailer.OrderPlaced(context.Background(), 1, []*models.ReceivedOrderItem{
+		{AdvertID: 7, Title: "Велосипед", Price: 1000, DeliveryPrice: 300},
+		{AdvertID: 8, Title: "Шлем", Price: 200},
+	})
+	if err != nil {
+		t.Fatalf("OrderPlaced() error = %v", err)
+	}
+
+	if len(queue.messages) != 1 || !strings.Contains(queue.messages[0].Text, "Итого: 1500") {
+		t.Errorf("queued %v, want total 1500", queue.messages)
+	}
+}
@@ -0,0 +1,336 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	favouritesusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/favourites/usecases"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+func (favouritesHandler *FavouritesHandler) GetCollections(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	collections, err := favouritesHandler.storage.GetCollections(ctx, userID)
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get favourite collections for user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(collections))
+}
+
+func (favouritesHandler *FavouritesHandler) GetCollection(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+	collectionID, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	view, err := favouritesHandler.storage.GetCollection(ctx, userID, uint(collectionID))
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(view))
+}
+
+func (favouritesHandler *FavouritesHandler) CreateCollection(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	name, ok := readCollectionName(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	collection, err := favouritesHandler.storage.CreateCollection(ctx, userID, name)
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(collection))
+}
+
+func (favouritesHandler *FavouritesHandler) RenameCollection(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	name, ok := readCollectionName(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	collectionID, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	err := favouritesHandler.storage.RenameCollection(ctx, userID, uint(collectionID), name)
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.FavouriteCollection{ID: uint(collectionID),
+		Name: name}))
+}
+
+// DeleteCollection удаляет папку; объявления из неё остаются в избранном.
+func (favouritesHandler *FavouritesHandler) DeleteCollection(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	collectionID, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	err := favouritesHandler.storage.DeleteCollection(ctx, userID, uint(collectionID))
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.FavouriteCollection{ID: uint(collectionID)}))
+}
+
+// ShareCollection включает или отзывает публичную ссылку на подборку.
+func (favouritesHandler *FavouritesHandler) ShareCollection(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var data models.ReceivedCollectionShare
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	collectionID, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	token, err := favouritesHandler.storage.ShareCollection(ctx, userID, uint(collectionID), data.Enabled)
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.FavouriteCollection{ID: uint(collectionID),
+		ShareToken: token}))
+}
+
+// MoveFavourite переносит объявление между подборками, при необходимости добавляя его в избранное.
+func (favouritesHandler *FavouritesHandler) MoveFavourite(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var data models.ReceivedFavouriteMove
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil || data.AdvertID == 0 {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	if _, err := favouritesHandler.advertStorage.GetAdvert(ctx, 0, data.AdvertID); err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusNotFound)
+		log.Println(err, responses.StatusNotFound)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusNotFound,
+			responses.ErrAdvertNotExist))
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	if err := favouritesHandler.storage.MoveFavourite(ctx, userID, &data); err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.FavouriteItem{CollectionID: data.CollectionID}))
+}
+
+func (favouritesHandler *FavouritesHandler) SetFavouriteNote(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var data models.ReceivedFavouriteNote
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err == nil {
+		err = favouritesusecases.ValidateFavouriteNote(data.Note)
+	}
+
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrNotValidData))
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	if err := favouritesHandler.storage.SetFavouriteNote(ctx, userID, &data); err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.FavouriteItem{Note: data.Note}))
+}
+
+// GetSharedCollection отдаёт подборку по публичной ссылке, авторизация не нужна.
+func (favouritesHandler *FavouritesHandler) GetSharedCollection(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodGet {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	token := mux.Vars(request)["token"]
+
+	if _, err := uuid.Parse(token); err != nil {
+		sendCollectionError(writer, request, logger, favouritesusecases.ErrCollectionNotFound)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, favouritesHandler.authClient)
+
+	view, err := favouritesHandler.storage.GetSharedCollection(ctx, token, userID)
+	if err != nil {
+		sendCollectionError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(view))
+}
+
+func readCollectionName(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger) (string, bool) {
+	var data models.ReceivedFavouriteCollection
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err == nil {
+		data.Name, err = favouritesusecases.NormalizeCollectionName(data.Name)
+	}
+
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrNotValidData))
+
+		return "", false
+	}
+
+	return data.Name, true
+}
+
+func sendCollectionError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger, err error) {
+	code, message := responses.StatusBadRequest, responses.ErrBadRequest
+
+	switch {
+	case errors.Is(err, favouritesusecases.ErrCollectionNotFound):
+		code, message = responses.StatusNotFound, responses.ErrCollectionNotExist
+	case errors.Is(err, favouritesusecases.ErrFavouriteNotFound):
+		code, message = responses.StatusNotFound, responses.ErrFavouriteNotExist
+	case errors.Is(err, favouritesusecases.ErrCollectionExists):
+		code, message = responses.StatusConflict, responses.ErrCollectionExists
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
@@ -0,0 +1,573 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	favouritesusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/favourites/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+func (favouritesStorage *FavouritesStorage) getCollections(ctx context.Context, tx pgx.Tx,
+	userID uint) ([]*models.FavouriteCollection, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetCollections := `
+	SELECT fc.id, fc.name, fc.share_token::TEXT, fc.created_time, COUNT(f.advert_id)
+	FROM public.favourite_collection fc
+	LEFT JOIN public.favourite f ON f.collection_id = fc.id
+	WHERE fc.user_id = $1
+	GROUP BY fc.id, fc.name, fc.share_token, fc.created_time
+	ORDER BY fc.created_time, fc.id;`
+
+	logging.LogInfo(logger, "SELECT FROM favourite_collection, favourite")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetCollections, userID)
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting collections, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	collections := []*models.FavouriteCollection{}
+
+	for rows.Next() {
+		collection := models.FavouriteCollection{}
+
+		if err := rows.Scan(&collection.ID, &collection.Name, &collection.ShareToken, &collection.CreatedTime,
+			&collection.AdvertsNum); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning collections, err=%w", err))
+			favouritesStorage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		collections = append(collections, &collection)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning collections, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+func (favouritesStorage *FavouritesStorage) GetCollections(ctx context.Context,
+	userID uint) ([]*models.FavouriteCollection, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var collections []*models.FavouriteCollection
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		collectionsInner, err := favouritesStorage.getCollections(ctx, tx, userID)
+		collections = collectionsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting collections, err=%w", err))
+
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+// getCollectionHeader находит подборку по владельцу и id или, если ownerID = 0, по токену ссылки.
+func (favouritesStorage *FavouritesStorage) getCollectionHeader(ctx context.Context, tx pgx.Tx, ownerID,
+	collectionID uint, token string) (*models.FavouriteCollection, uint, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetCollection := `
+	SELECT fc.id, fc.user_id, fc.name, fc.share_token::TEXT, fc.created_time,
+		(SELECT COUNT(*) FROM public.favourite f WHERE f.collection_id = fc.id)
+	FROM public.favourite_collection fc
+	WHERE ($1::BIGINT <> 0 AND fc.user_id = $1 AND fc.id = $2)
+		OR ($1::BIGINT = 0 AND fc.share_token::TEXT = $3);`
+
+	logging.LogInfo(logger, "SELECT FROM favourite_collection")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetCollection, ownerID, collectionID, token)
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	var (
+		collection models.FavouriteCollection
+		userID     uint
+	)
+
+	err := line.Scan(&collection.ID, &userID, &collection.Name, &collection.ShareToken, &collection.CreatedTime,
+		&collection.AdvertsNum)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, 0, favouritesusecases.ErrCollectionNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning collection, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, 0, err
+	}
+
+	return &collection, userID, nil
+}
+
+// getCollectionItems возвращает объявления подборки; флаги избранного и корзины считаются для viewerID.
+// Чужому зрителю снятые и непроверенные объявления не показываются.
+func (favouritesStorage *FavouritesStorage) getCollectionItems(ctx context.Context, tx pgx.Tx, ownerID,
+	collectionID, viewerID uint) ([]*models.FavouriteItem, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetCollectionItems := `SELECT a.id, c.translation, category.translation, a.title, a.price,
+	(SELECT array_agg(url) FROM 
+	                           (SELECT url 
+	                            FROM advert_image 
+	                            WHERE advert_id = a.id 
+	                            ORDER BY position, id) AS ordered_images) AS image_urls,
+	EXISTS (SELECT 1 FROM favourite vf WHERE vf.user_id = $3 AND vf.advert_id = a.id) AS in_favourites,
+	EXISTS (SELECT 1 FROM cart ct WHERE ct.user_id = $3 AND ct.advert_id = a.id) AS in_cart,
+	a.advert_status = 'Активно' AS is_active,
+	EXISTS (SELECT 1 FROM advert_reservation r
+		WHERE r.advert_id = a.id AND r.user_id <> $3 AND r.reserved_until > NOW()) AS reserved,
+	f.collection_id, f.note
+	FROM public.favourite f
+	INNER JOIN public.advert a ON a.id = f.advert_id
+	INNER JOIN city c ON a.city_id = c.id
+	INNER JOIN category ON a.category_id = category.id
+	WHERE f.user_id = $1 AND f.collection_id = $2 AND (f.user_id = $3 OR a.advert_status = 'Активно')
+	ORDER BY a.id;`
+
+	logging.LogInfo(logger, "SELECT FROM favourite, advert, city, category, advert_image, cart")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetCollectionItems, ownerID, collectionID, viewerID)
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting collection items, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	items := []*models.FavouriteItem{}
+
+	for rows.Next() {
+		advert := models.ReturningAdInList{}
+		photoPad := models.PhotoPad{}
+		item := models.FavouriteItem{Advert: &advert}
+
+		if err := rows.Scan(&advert.ID, &advert.City, &advert.Category, &advert.Title, &advert.Price,
+			&photoPad.Photo, &advert.InFavourites, &advert.InCart, &advert.IsActive, &advert.Reserved,
+			&item.CollectionID, &item.Note); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning collection items, err=%w",
+				err))
+			favouritesStorage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		for _, ptr := range photoPad.Photo {
+			if ptr != nil {
+				advert.Photos = append(advert.Photos, *ptr)
+			}
+		}
+
+		advert.Photos, advert.PhotosIMG = utils.MediaImages(ctx, favouritesStorage.blobs, advert.Photos)
+
+		advert.Sanitize()
+
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning collection items, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (favouritesStorage *FavouritesStorage) GetCollection(ctx context.Context, userID,
+	collectionID uint) (*models.FavouriteCollectionView, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var view *models.FavouriteCollectionView
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		collection, _, err := favouritesStorage.getCollectionHeader(ctx, tx, userID, collectionID, "")
+		if err != nil {
+			return err
+		}
+
+		items, err := favouritesStorage.getCollectionItems(ctx, tx, userID, collection.ID, userID)
+		if err != nil {
+			return err
+		}
+
+		view = &models.FavouriteCollectionView{Collection: *collection, Items: items}
+
+		return nil
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting collection, err=%w", err))
+
+		return nil, err
+	}
+
+	return view, nil
+}
+
+func (favouritesStorage *FavouritesStorage) GetSharedCollection(ctx context.Context, token string,
+	viewerID uint) (*models.FavouriteCollectionView, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var view *models.FavouriteCollectionView
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		collection, ownerID, err := favouritesStorage.getCollectionHeader(ctx, tx, 0, 0, token)
+		if err != nil {
+			return err
+		}
+
+		items, err := favouritesStorage.getCollectionItems(ctx, tx, ownerID, collection.ID, viewerID)
+		if err != nil {
+			return err
+		}
+
+		// заметки личные, а токен знает и так тот, кто открыл ссылку
+		for _, item := range items {
+			item.Note = ""
+		}
+
+		collection.ShareToken = nil
+		view = &models.FavouriteCollectionView{Collection: *collection, Items: items}
+
+		return nil
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting shared collection, err=%w", err))
+
+		return nil, err
+	}
+
+	return view, nil
+}
+
+func (favouritesStorage *FavouritesStorage) createCollection(ctx context.Context, tx pgx.Tx, userID uint,
+	name string) (*models.FavouriteCollection, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLCreateCollection := `
+	INSERT INTO public.favourite_collection (user_id, name)
+	VALUES ($1, $2)
+	RETURNING id, created_time;`
+
+	logging.LogInfo(logger, "INSERT INTO favourite_collection")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCreateCollection, userID, name)
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	collection := models.FavouriteCollection{Name: name}
+
+	if err := line.Scan(&collection.ID, &collection.CreatedTime); err != nil {
+		if isUniqueViolation(err) {
+			return nil, favouritesusecases.ErrCollectionExists
+		}
+
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting collection, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+func (favouritesStorage *FavouritesStorage) CreateCollection(ctx context.Context, userID uint,
+	name string) (*models.FavouriteCollection, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var collection *models.FavouriteCollection
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		collectionInner, err := favouritesStorage.createCollection(ctx, tx, userID, name)
+		collection = collectionInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while creating collection, err=%w", err))
+
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// execOwnCollection выполняет запрос, который трогает подборку $1 пользователя $2, и проверяет, что она нашлась.
+func (favouritesStorage *FavouritesStorage) execOwnCollection(ctx context.Context, tx pgx.Tx, funcName,
+	query string, args ...any) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	start := time.Now()
+
+	tag, err := tx.Exec(ctx, query, args...)
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	if isUniqueViolation(err) {
+		return favouritesusecases.ErrCollectionExists
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while changing collection, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return favouritesusecases.ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+func (favouritesStorage *FavouritesStorage) RenameCollection(ctx context.Context, userID, collectionID uint,
+	name string) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLRenameCollection := `UPDATE public.favourite_collection SET name = $3 WHERE id = $1 AND user_id = $2;`
+
+	logging.LogInfo(logger, "UPDATE favourite_collection")
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		return favouritesStorage.execOwnCollection(ctx, tx, funcName, SQLRenameCollection, collectionID, userID,
+			name)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while renaming collection, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCollection удаляет только папку: объявления из неё остаются в избранном без подборки.
+func (favouritesStorage *FavouritesStorage) DeleteCollection(ctx context.Context, userID, collectionID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLDeleteCollection := `DELETE FROM public.favourite_collection WHERE id = $1 AND user_id = $2;`
+
+	logging.LogInfo(logger, "DELETE FROM favourite_collection")
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		return favouritesStorage.execOwnCollection(ctx, tx, funcName, SQLDeleteCollection, collectionID, userID)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting collection, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (favouritesStorage *FavouritesStorage) shareCollection(ctx context.Context, tx pgx.Tx, userID,
+	collectionID uint, enabled bool) (*string, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	// повторное включение сохраняет старую ссылку, выключение её отзывает
+	SQLShareCollection := `
+	UPDATE public.favourite_collection
+	SET share_token = CASE WHEN $3 THEN COALESCE(share_token, $4::UUID) ELSE NULL END
+	WHERE id = $1 AND user_id = $2
+	RETURNING share_token::TEXT;`
+
+	logging.LogInfo(logger, "UPDATE favourite_collection")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLShareCollection, collectionID, userID, enabled, uuid.New().String())
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	var token *string
+
+	err := line.Scan(&token)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, favouritesusecases.ErrCollectionNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sharing collection, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (favouritesStorage *FavouritesStorage) ShareCollection(ctx context.Context, userID, collectionID uint,
+	enabled bool) (*string, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var token *string
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		tokenInner, err := favouritesStorage.shareCollection(ctx, tx, userID, collectionID, enabled)
+		token = tokenInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sharing collection, err=%w", err))
+
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (favouritesStorage *FavouritesStorage) moveFavourite(ctx context.Context, tx pgx.Tx, userID uint,
+	data *models.ReceivedFavouriteMove) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLMoveFavourite := `
+	INSERT INTO public.favourite (user_id, advert_id, collection_id)
+	SELECT $1, $2, $3
+	WHERE $3::BIGINT IS NULL
+		OR EXISTS (SELECT 1 FROM public.favourite_collection WHERE id = $3 AND user_id = $1)
+	ON CONFLICT (user_id, advert_id) DO UPDATE SET collection_id = EXCLUDED.collection_id;`
+
+	logging.LogInfo(logger, "INSERT INTO favourite")
+
+	start := time.Now()
+
+	tag, err := tx.Exec(ctx, SQLMoveFavourite, userID, data.AdvertID, data.CollectionID)
+
+	favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while moving favourite, err=%w", err))
+		favouritesStorage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return favouritesusecases.ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+func (favouritesStorage *FavouritesStorage) MoveFavourite(ctx context.Context, userID uint,
+	data *models.ReceivedFavouriteMove) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		return favouritesStorage.moveFavourite(ctx, tx, userID, data)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while moving favourite, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (favouritesStorage *FavouritesStorage) SetFavouriteNote(ctx context.Context, userID uint,
+	data *models.ReceivedFavouriteNote) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetNote := `UPDATE public.favourite SET note = $3 WHERE user_id = $1 AND advert_id = $2;`
+
+	logging.LogInfo(logger, "UPDATE favourite")
+
+	err := pgx.BeginFunc(ctx, favouritesStorage.pool, func(tx pgx.Tx) error {
+		start := time.Now()
+
+		tag, err := tx.Exec(ctx, SQLSetNote, userID, data.AdvertID, data.Note)
+
+		favouritesStorage.metrics.AddDuration(funcName, time.Since(start))
+
+		if err != nil {
+			favouritesStorage.metrics.IncreaseErrors(funcName)
+
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return favouritesusecases.ErrFavouriteNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting favourite note, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+// isUniqueViolation отличает повтор названия подборки у пользователя от прочих ошибок базы.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -8,6 +8,7 @@ import (
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
 	"github.com/jackc/pgx/v5"
@@ -18,12 +19,14 @@ import (
 type FavouritesStorage struct {
 	pool    *pgxpool.Pool
 	metrics *mymetrics.DatabaseMetrics
+	blobs   blobstore.BlobStore
 }
 
-func NewFavouritesStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *FavouritesStorage {
+func NewFavouritesStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics, blobs blobstore.BlobStore) *FavouritesStorage {
 	return &FavouritesStorage{
 		pool:    pool,
 		metrics: metrics,
+		blobs:   blobs,
 	}
 }
 
@@ -37,9 +40,11 @@ func (favouritesStorage *FavouritesStorage) getFavouritesByUserID(ctx context.Co
 	                           (SELECT url 
 	                            FROM advert_image 
 	                            WHERE advert_id = a.id 
-	                            ORDER BY id) AS ordered_images) AS image_urls,
+	                            ORDER BY position, id) AS ordered_images) AS image_urls,
 	CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $1 AND c.advert_id = a.id)
-		THEN 1 ELSE 0 END AS bool) AS in_cart
+		THEN 1 ELSE 0 END AS bool) AS in_cart,
+	EXISTS (SELECT 1 FROM advert_reservation r
+		WHERE r.advert_id = a.id AND r.user_id <> $1 AND r.reserved_until > NOW()) AS reserved
 	FROM public.advert a
 	INNER JOIN city c ON a.city_id = c.id
 	INNER JOIN category ON a.category_id = category.id
@@ -75,7 +80,7 @@ func (favouritesStorage *FavouritesStorage) getFavouritesByUserID(ctx context.Co
 
 		if err := rows.Scan(&returningAdInList.ID, &returningAdInList.City, &returningAdInList.Category,
 			&returningAdInList.Title, &returningAdInList.Price, &photoPad.Photo,
-			&returningAdInList.InCart); err != nil {
+			&returningAdInList.InCart, &returningAdInList.Reserved); err != nil {
 			return nil, err
 		}
 
@@ -88,23 +93,8 @@ func (favouritesStorage *FavouritesStorage) getFavouritesByUserID(ctx context.Co
 			}
 		}
 
-		for i := 0; i < len(returningAdInList.Photos); i++ {
-			image, err := utils.DecodeImage(returningAdInList.Photos[i])
-			if err != nil {
-				logging.LogError(logger, fmt.Errorf("error occurred while decoding advert_image %v, err=%w",
-					returningAdInList.Photos[i], err))
-
-				return nil, err
-			}
-
-			returningAdInList.PhotosIMG = append(returningAdInList.PhotosIMG, image)
-		}
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
+		returningAdInList.Photos, returningAdInList.PhotosIMG =
+			utils.MediaImages(ctx, favouritesStorage.blobs, returningAdInList.Photos)
 
 		returningAdInList.Sanitize()
 
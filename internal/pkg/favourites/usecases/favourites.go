@@ -2,12 +2,58 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 )
 
+const (
+	maxCollectionNameLen = 100
+	maxFavouriteNoteLen  = 500
+)
+
+var (
+	ErrCollectionNotFound = errors.New("favourite collection not found")
+	ErrFavouriteNotFound  = errors.New("advert is not in favourites")
+	ErrCollectionNotValid = errors.New("favourite collection data is not valid")
+	ErrCollectionExists   = errors.New("favourite collection with same name already exists")
+)
+
 type FavouritesStorageInterface interface {
 	GetFavouritesByUserID(ctx context.Context, userID uint) ([]*models.ReturningAdInList, error)
 	DeleteAdvByIDs(ctx context.Context, userID uint, advertID uint) error
 	AppendAdvByIDs(ctx context.Context, userID uint, advertID uint) bool
+
+	GetCollections(ctx context.Context, userID uint) ([]*models.FavouriteCollection, error)
+	GetCollection(ctx context.Context, userID, collectionID uint) (*models.FavouriteCollectionView, error)
+	CreateCollection(ctx context.Context, userID uint, name string) (*models.FavouriteCollection, error)
+	RenameCollection(ctx context.Context, userID, collectionID uint, name string) error
+	DeleteCollection(ctx context.Context, userID, collectionID uint) error
+	// ShareCollection включает или выключает ссылку; возвращает токен ссылки или nil, если она выключена.
+	ShareCollection(ctx context.Context, userID, collectionID uint, enabled bool) (*string, error)
+	MoveFavourite(ctx context.Context, userID uint, data *models.ReceivedFavouriteMove) error
+	SetFavouriteNote(ctx context.Context, userID uint, data *models.ReceivedFavouriteNote) error
+	// GetSharedCollection открывает подборку по ссылке; viewerID - кто смотрит (0 - аноним).
+	GetSharedCollection(ctx context.Context, token string, viewerID uint) (*models.FavouriteCollectionView, error)
+}
+
+// NormalizeCollectionName обрезает пробелы и проверяет длину названия подборки.
+func NormalizeCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" || utf8.RuneCountInString(name) > maxCollectionNameLen {
+		return "", ErrCollectionNotValid
+	}
+
+	return name, nil
+}
+
+func ValidateFavouriteNote(note string) error {
+	if utf8.RuneCountInString(note) > maxFavouriteNoteLen {
+		return ErrCollectionNotValid
+	}
+
+	return nil
 }
@@ -0,0 +1,54 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/favourites/usecases"
+)
+
+func TestNormalizeCollectionName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "trimmed", input: "  Дача  ", want: "Дача"},
+		{name: "empty", input: "   ", wantErr: usecases.ErrCollectionNotValid},
+		{name: "max length in runes", input: strings.Repeat("я", 100), want: strings.Repeat("я", 100)},
+		{name: "too long", input: strings.Repeat("я", 101), wantErr: usecases.ErrCollectionNotValid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := usecases.NormalizeCollectionName(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NormalizeCollectionName() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("NormalizeCollectionName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFavouriteNote(t *testing.T) {
+	t.Parallel()
+
+	if err := usecases.ValidateFavouriteNote(strings.Repeat("ж", 500)); err != nil {
+		t.Errorf("ValidateFavouriteNote() error = %v, want nil", err)
+	}
+
+	if err := usecases.ValidateFavouriteNote(strings.Repeat("ж", 501)); !errors.Is(err, usecases.ErrCollectionNotValid) {
+		t.Errorf("ValidateFavouriteNote() error = %v, want %v", err, usecases.ErrCollectionNotValid)
+	}
+}
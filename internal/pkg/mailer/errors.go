@@ -0,0 +1,31 @@
+package mailer
+
+import "errors"
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent помечает ошибку, после которой очередь не повторяет отправку:
+// неверный адрес, отказ сервера с кодом 5xx.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+
+	return errors.As(err, &permanent) || errors.Is(err, ErrNoRecipient)
+}
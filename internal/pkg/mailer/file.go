@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const defaultMailDir = "./mail"
+
+// FileSender складывает письма в каталог файлами .eml - их открывает любой почтовый клиент.
+type FileSender struct {
+	dir  string
+	from string
+	now  func() time.Time
+}
+
+func NewFileSender(cfg config.FileMailerConfig, from string) (*FileSender, error) {
+	dir := cfg.Dir
+	if dir == "" {
+		dir = defaultMailDir
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FileSender{
+		dir:  dir,
+		from: from,
+		now:  time.Now,
+	}, nil
+}
+
+func (sender *FileSender) Send(_ context.Context, msg *Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	now := sender.now()
+
+	data, err := buildMessage(sender.from, msg, now)
+	if err != nil {
+		return Permanent(err)
+	}
+
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+
+	name := now.UTC().Format("20060102T150405.000000000") + "-" + hex.EncodeToString(suffix) + ".eml"
+
+	return os.WriteFile(filepath.Join(sender.dir, name), data, 0o600)
+}
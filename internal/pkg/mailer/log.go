@@ -0,0 +1,27 @@
+package mailer
+
+import (
+	"context"
+	"log"
+)
+
+// LogSender ничего не отправляет, а пишет письмо в лог - для локального запуска без почтового сервера.
+type LogSender struct {
+	from string
+}
+
+func NewLogSender(from string) *LogSender {
+	return &LogSender{
+		from: from,
+	}
+}
+
+func (sender *LogSender) Send(_ context.Context, msg *Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	log.Printf("mail from %s to %s: %s\n%s", sender.from, msg.To, msg.Subject, msg.Text)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	KindSMTP = "smtp"
+	KindFile = "file"
+	KindLog  = "log"
+)
+
+var (
+	ErrNoRecipient = errors.New("mail recipient is empty")
+	errUnknownKind = errors.New("unknown mailer kind")
+)
+
+// Message - готовое письмо: текстовая и HTML-версии уходят одним multipart/alternative.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender доставляет письмо синхронно; для фоновой отправки с повторами есть Queue.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+func New(cfg config.MailerConfig) (Sender, error) {
+	switch cfg.Kind {
+	case "", KindLog:
+		return NewLogSender(cfg.From), nil
+	case KindFile:
+		return NewFileSender(cfg.File, cfg.From)
+	case KindSMTP:
+		return NewSMTPSender(cfg.SMTP, cfg.From, os.Getenv("SMTP_PASSWORD")), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownKind, cfg.Kind)
+	}
+}
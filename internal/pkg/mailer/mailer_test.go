@@ -0,0 +1,484 @@
+//nolint:all
+package mailer_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/mailer"
+)
+
+const testFrom = "Vol-4-ok <noreply@vol-4-ok.ru>"
+
+// fakeSMTP - минимальный SMTP-сервер для тестов: принимает письма в память,
+// первые failFirst попыток отвечает на DATA кодом failCode.
+type fakeSMTP struct {
+	listener  net.Listener
+	failFirst int
+	failCode  string
+
+	mu       sync.Mutex
+	attempts int
+	received []string
+}
+
+func newFakeSMTP(t *testing.T, failFirst int, failCode string) *fakeSMTP {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &fakeSMTP{listener: listener, failFirst: failFirst, failCode: failCode}
+
+	go server.serve()
+
+	t.Cleanup(func() { listener.Close() })
+
+	return server
+}
+
+func (server *fakeSMTP) config() config.SMTPMailerConfig {
+	addr := server.listener.Addr().(*net.TCPAddr)
+
+	return config.SMTPMailerConfig{Host: "127.0.0.1", Port: addr.Port, Timeout: 5 * time.Second}
+}
+
+func (server *fakeSMTP) messages() []string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return append([]string(nil), server.received...)
+}
+
+func (server *fakeSMTP) serve() {
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go server.handle(conn)
+	}
+}
+
+func (server *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 fake ESMTP")
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 fake")
+		case strings.HasPrefix(command, "MAIL"), strings.HasPrefix(command, "RCPT"), command == "RSET", command == "NOOP":
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 go ahead")
+
+			var data strings.Builder
+
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if dataLine == ".\r\n" {
+					break
+				}
+
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+
+			server.mu.Lock()
+			server.attempts++
+			fail := server.attempts <= server.failFirst
+			if !fail {
+				server.received = append(server.received, data.String())
+			}
+			server.mu.Unlock()
+
+			if fail {
+				reply(server.failCode + " try later")
+			} else {
+				reply("250 queued")
+			}
+		case command == "QUIT":
+			reply("221 bye")
+
+			return
+		default:
+			reply("502 unknown command")
+		}
+	}
+}
+
+// parseParts разбирает multipart/alternative и возвращает части по их Content-Type.
+func parseParts(t *testing.T, raw string) (*mail.Message, map[string]string) {
+	msg, err := mail.ReadMessage(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("unexpected content type %q: %v", msg.Header.Get("Content-Type"), err)
+	}
+
+	parts := map[string]string{}
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, err := io.ReadAll(quotedprintable.NewReader(part))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		// текстовые части по RFC 2045 переносятся через CRLF
+		parts[partType] = strings.ReplaceAll(string(body), "\r\n", "\n")
+	}
+
+	return msg, parts
+}
+
+func TestSMTPSenderDelivers(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTP(t, 0, "")
+	sender := mailer.NewSMTPSender(server.config(), testFrom, "")
+
+	err := sender.Send(context.Background(), &mailer.Message{
+		To:      "buyer@example.com",
+		Subject: "Заказ оформлен",
+		Text:    "Итого: 1500 ₽\n",
+		HTML:    "<p>Итого: 1500&nbsp;₽</p>",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	received := server.messages()
+	if len(received) != 1 {
+		t.Fatalf("received %d messages, want 1", len(received))
+	}
+
+	msg, parts := parseParts(t, received[0])
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Заказ оформлен" {
+		t.Errorf("Subject = %q (%v)", subject, err)
+	}
+
+	if to := msg.Header.Get("To"); !strings.Contains(to, "buyer@example.com") {
+		t.Errorf("To = %q", to)
+	}
+
+	if parts["text/plain"] != "Итого: 1500 ₽\n" {
+		t.Errorf("text part = %q", parts["text/plain"])
+	}
+
+	if parts["text/html"] != "<p>Итого: 1500&nbsp;₽</p>" {
+		t.Errorf("html part = %q", parts["text/html"])
+	}
+}
+
+func TestSMTPSenderPermanentError(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTP(t, 1, "550")
+	sender := mailer.NewSMTPSender(server.config(), testFrom, "")
+
+	err := sender.Send(context.Background(), &mailer.Message{To: "buyer@example.com", Subject: "s", Text: "t"})
+	if !mailer.IsPermanent(err) {
+		t.Fatalf("Send() error = %v, want permanent", err)
+	}
+}
+
+func TestQueueRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		failFirst int
+		failCode  string
+		delivered int
+		attempts  int
+	}{
+		{name: "temporary failure is retried", failFirst: 2, failCode: "451", delivered: 1, attempts: 3},
+		{name: "permanent failure is not retried", failFirst: 1, failCode: "554", delivered: 0, attempts: 1},
+		{name: "gives up after max attempts", failFirst: 10, failCode: "421", delivered: 0, attempts: 3},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newFakeSMTP(t, tt.failFirst, tt.failCode)
+			queue := mailer.NewQueue(mailer.NewSMTPSender(server.config(), testFrom, ""), config.MailQueueConfig{
+				Size:        1,
+				Workers:     1,
+				MaxAttempts: 3,
+				RetryDelay:  time.Millisecond,
+			})
+
+			queue.Start(context.Background())
+
+			if err := queue.Enqueue(&mailer.Message{To: "buyer@example.com", Subject: "s", Text: "t"}); err != nil {
+				t.Fatalf("Enqueue() error = %v", err)
+			}
+
+			if err := queue.Close(context.Background()); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			if got := len(server.messages()); got != tt.delivered {
+				t.Errorf("delivered = %d, want %d", got, tt.delivered)
+			}
+
+			server.mu.Lock()
+			attempts := server.attempts
+			server.mu.Unlock()
+
+			if attempts != tt.attempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.attempts)
+			}
+
+			if err := queue.Enqueue(&mailer.Message{To: "buyer@example.com"}); !errors.Is(err, mailer.ErrQueueClosed) {
+				t.Errorf("Enqueue() after Close error = %v, want %v", err, mailer.ErrQueueClosed)
+			}
+		})
+	}
+}
+
+func TestQueueCloseDeadline(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTP(t, 100, "451")
+	queue := mailer.NewQueue(mailer.NewSMTPSender(server.config(), testFrom, ""), config.MailQueueConfig{
+		Size:        10,
+		Workers:     1,
+		MaxAttempts: 5,
+		RetryDelay:  time.Hour,
+	})
+
+	queue.Start(context.Background())
+
+	for i := 0; i < 3; i++ {
+		if err := queue.Enqueue(&mailer.Message{To: "buyer@example.com", Subject: "s", Text: "t"}); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	if err := queue.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Close() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Close() took %v, want it bounded by ctx", elapsed)
+	}
+}
+
+func TestQueueRejectsEmptyRecipient(t *testing.T) {
+	t.Parallel()
+
+	queue := mailer.NewQueue(mailer.NewLogSender(testFrom), config.MailQueueConfig{})
+
+	if err := queue.Enqueue(&mailer.Message{Subject: "s"}); !errors.Is(err, mailer.ErrNoRecipient) {
+		t.Errorf("Enqueue() error = %v, want %v", err, mailer.ErrNoRecipient)
+	}
+}
+
+func TestTemplatesRender(t *testing.T) {
+	t.Parallel()
+
+	templates, err := mailer.LoadTemplates()
+	if err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+
+	const baseURL = "http://vol-4-ok.ru"
+
+	tests := []struct {
+		name    string
+		data    any
+		subject string
+		text    []string
+		html    []string
+	}{
+		{
+			name:    mailer.TemplateWelcome,
+			data:    mailer.WelcomeData{BaseURL: baseURL, Email: "new@example.com"},
+			subject: "Добро пожаловать на Vol-4-ok",
+			text:    []string{"new@example.com"},
+			html:    []string{"new@example.com", `href="http://vol-4-ok.ru"`},
+		},
+		{
+			name: mailer.TemplateWelcome,
+			data: mailer.WelcomeData{BaseURL: baseURL, Email: "new@example.com",
+				ConfirmURL: "http://vol-4-ok.ru/confirm-email?token=abc"},
+			subject: "Добро пожаловать на Vol-4-ok",
+			text:    []string{"Подтвердите его по ссылке: http://vol-4-ok.ru/confirm-email?token=abc\n"},
+			html:    []string{`href="http://vol-4-ok.ru/confirm-email?token=abc"`},
+		},
+		{
+			name: mailer.TemplateConfirmEmail,
+			data: mailer.ConfirmEmailData{BaseURL: baseURL, Email: "changed@example.com",
+				ConfirmURL: "http://vol-4-ok.ru/confirm-email?token=abc"},
+			subject: "Подтвердите адрес почты",
+			text:    []string{"changed@example.com", "http://vol-4-ok.ru/confirm-email?token=abc"},
+			html:    []string{"changed@example.com", `href="http://vol-4-ok.ru/confirm-email?token=abc"`},
+		},
+		{
+			name: mailer.TemplateOrderPlaced,
+			data: mailer.OrderPlacedData{
+				BaseURL: baseURL,
+				Items: []mailer.OrderLine{
+					{AdvertID: 7, Title: "Велосипед <BMX>", Price: 1000, DeliveryPrice: 300},
+					{AdvertID: 8, Title: "Шлем", Price: 200},
+				},
+				Total: 1500,
+			},
+			subject: "Заказ оформлен",
+			text:    []string{"- Велосипед <BMX>: 1000 ₽, доставка 300 ₽", "- Шлем: 200 ₽\n", "Итого: 1500 ₽"},
+			html:    []string{"Велосипед &lt;BMX&gt;", `href="http://vol-4-ok.ru/advert/8"`, "Итого: 1500"},
+		},
+		{
+			name: mailer.TemplatePasswordReset,
+			data: mailer.PasswordResetData{BaseURL: baseURL, Email: "user@example.com",
+				ResetURL: "http://vol-4-ok.ru/reset-password?token=abc"},
+			subject: "Сброс пароля",
+			text:    []string{"user@example.com", "http://vol-4-ok.ru/reset-password?token=abc"},
+			html:    []string{`href="http://vol-4-ok.ru/reset-password?token=abc"`},
+		},
+		{
+			name:    mailer.TemplateOrderStatusChanged,
+			data:    mailer.OrderStatusData{BaseURL: baseURL, AdvertID: 3, Title: "Диван", Status: "доставлен"},
+			subject: "Статус заказа изменён",
+			text:    []string{"«Диван»: доставлен"},
+			html:    []string{`href="http://vol-4-ok.ru/advert/3"`, "<b>доставлен</b>"},
+		},
+		{
+			name:    mailer.TemplatePaymentSucceeded,
+			data:    mailer.PaymentData{BaseURL: baseURL, AdvertID: 5, Title: "Телефон"},
+			subject: "Оплата прошла",
+			text:    []string{"«Телефон»", "http://vol-4-ok.ru/advert/5"},
+			html:    []string{`href="http://vol-4-ok.ru/advert/5"`},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msg, err := templates.Render(tt.name, "user@example.com", tt.data)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			if msg.To != "user@example.com" || msg.Subject != tt.subject {
+				t.Errorf("Render() = to %q subject %q, want subject %q", msg.To, msg.Subject, tt.subject)
+			}
+
+			for _, want := range tt.text {
+				if !strings.Contains(msg.Text, want) {
+					t.Errorf("text does not contain %q:\n%s", want, msg.Text)
+				}
+			}
+
+			for _, want := range append(tt.html, "<html", "/profile/settings") {
+				if !strings.Contains(msg.HTML, want) {
+					t.Errorf("html does not contain %q:\n%s", want, msg.HTML)
+				}
+			}
+		})
+	}
+
+	if _, err := templates.Render("unknown", "user@example.com", nil); err == nil {
+		t.Error("Render() of unknown template error = nil")
+	}
+}
+
+func TestFileSenderWritesEML(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "mail")
+
+	sender, err := mailer.New(config.MailerConfig{Kind: mailer.KindFile, From: testFrom, File: config.FileMailerConfig{Dir: dir}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := sender.Send(context.Background(), &mailer.Message{To: "user@example.com", Subject: "s", Text: "привет\n"}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("eml files = %v (%v), want one", files, err)
+	}
+
+	raw, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, parts := parseParts(t, string(raw))
+	if parts["text/plain"] != "привет\n" {
+		t.Errorf("text part = %q", parts["text/plain"])
+	}
+
+	if err := sender.Send(context.Background(), &mailer.Message{To: "not an address"}); !mailer.IsPermanent(err) {
+		t.Errorf("Send() to bad address error = %v, want permanent", err)
+	}
+}
+
+func TestNewUnknownKind(t *testing.T) {
+	t.Parallel()
+
+	if _, err := mailer.New(config.MailerConfig{Kind: "pigeon"}); err == nil {
+		t.Error("New() error = nil, want error")
+	}
+}
@@ -0,0 +1,92 @@
+package mailer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// buildMessage собирает письмо в формате RFC 5322 с текстовой и HTML-частями в кодировке quoted-printable.
+func buildMessage(from string, msg *Message, now time.Time) ([]byte, error) {
+	fromAddr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("bad sender address %q: %w", from, err)
+	}
+
+	toAddr, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("bad recipient address %q: %w", msg.To, err)
+	}
+
+	var out bytes.Buffer
+
+	// граница известна сразу, а пишет в буфер multipart только после заголовков
+	body := multipart.NewWriter(&out)
+
+	header := []string{
+		"From: " + fromAddr.String(),
+		"To: " + toAddr.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + now.Format(time.RFC1123Z),
+		"Message-ID: " + messageID(fromAddr.Address),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + body.Boundary(),
+	}
+
+	out.WriteString(strings.Join(header, "\r\n"))
+	out.WriteString("\r\n\r\n")
+
+	if err := writePart(body, "text/plain; charset=utf-8", msg.Text); err != nil {
+		return nil, err
+	}
+
+	if msg.HTML != "" {
+		if err := writePart(body, "text/html; charset=utf-8", msg.HTML); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+func writePart(body *multipart.Writer, contentType, content string) error {
+	part, err := body.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	encoder := quotedprintable.NewWriter(part)
+
+	if _, err := encoder.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+func messageID(from string) string {
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">"
+}
@@ -0,0 +1,167 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	defaultQueueSize   = 1000
+	defaultWorkers     = 1
+	defaultMaxAttempts = 5
+	defaultRetryDelay  = 2 * time.Second
+	sendTimeout        = 30 * time.Second
+)
+
+var (
+	ErrQueueFull   = errors.New("mail queue is full")
+	ErrQueueClosed = errors.New("mail queue is closed")
+)
+
+// Queue отправляет письма в фоне: Enqueue не ждёт почтового сервера, временные ошибки
+// повторяются с растущей паузой. Close дожидается, пока очередь опустеет, но не дольше своего ctx.
+type Queue struct {
+	sender      Sender
+	messages    chan *Message
+	workers     int
+	maxAttempts int
+	retryDelay  time.Duration
+
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+	// прерывает отправку и паузы между повторами, когда ждать очередь больше нельзя
+	cancel context.CancelFunc
+}
+
+func NewQueue(sender Sender, cfg config.MailQueueConfig) *Queue {
+	if cfg.Size <= 0 {
+		cfg.Size = defaultQueueSize
+	}
+
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultWorkers
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = defaultRetryDelay
+	}
+
+	return &Queue{
+		sender:      sender,
+		messages:    make(chan *Message, cfg.Size),
+		workers:     cfg.Workers,
+		maxAttempts: cfg.MaxAttempts,
+		retryDelay:  cfg.RetryDelay,
+	}
+}
+
+// Start запускает отправителей; отмена ctx прерывает паузы между повторами.
+func (queue *Queue) Start(ctx context.Context) {
+	ctx, queue.cancel = context.WithCancel(ctx)
+
+	for i := 0; i < queue.workers; i++ {
+		queue.wg.Add(1)
+
+		go func() {
+			defer queue.wg.Done()
+
+			for msg := range queue.messages {
+				queue.send(ctx, msg)
+			}
+		}()
+	}
+}
+
+func (queue *Queue) Enqueue(msg *Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	queue.mu.RLock()
+	defer queue.mu.RUnlock()
+
+	if queue.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case queue.messages <- msg:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close перестаёт принимать письма и ждёт, пока отправители разберут очередь. Если ctx истёк раньше,
+// оставшиеся письма отбрасываются без повторов и возвращается ошибка ctx.
+func (queue *Queue) Close(ctx context.Context) error {
+	queue.mu.Lock()
+
+	if !queue.closed {
+		queue.closed = true
+		close(queue.messages)
+	}
+
+	queue.mu.Unlock()
+
+	drained := make(chan struct{})
+
+	go func() {
+		queue.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+	}
+
+	if queue.cancel != nil {
+		queue.cancel()
+	}
+
+	<-drained
+
+	return ctx.Err()
+}
+
+func (queue *Queue) send(ctx context.Context, msg *Message) {
+	delay := queue.retryDelay
+
+	for attempt := 1; ; attempt++ {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := queue.sender.Send(sendCtx, msg)
+		cancel()
+
+		if err == nil {
+			return
+		}
+
+		if IsPermanent(err) || attempt >= queue.maxAttempts {
+			log.Printf("mail to %s dropped after %d attempts: %v", msg.To, attempt, err)
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("mail to %s dropped on shutdown: %v", msg.To, err)
+
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+	}
+}
@@ -0,0 +1,130 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const defaultSMTPTimeout = 10 * time.Second
+
+// SMTPSender открывает новое соединение на каждое письмо: писем немного, а держать сессию дольше,
+// чем разрешает сервер, сложнее, чем переподключиться.
+type SMTPSender struct {
+	cfg      config.SMTPMailerConfig
+	from     string
+	password string
+	now      func() time.Time
+}
+
+func NewSMTPSender(cfg config.SMTPMailerConfig, from, password string) *SMTPSender {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultSMTPTimeout
+	}
+
+	return &SMTPSender{
+		cfg:      cfg,
+		from:     from,
+		password: password,
+		now:      time.Now,
+	}
+}
+
+func (sender *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	if msg.To == "" {
+		return ErrNoRecipient
+	}
+
+	data, err := buildMessage(sender.from, msg, sender.now())
+	if err != nil {
+		return Permanent(err)
+	}
+
+	fromAddr, _ := mail.ParseAddress(sender.from)
+	toAddr, _ := mail.ParseAddress(msg.To)
+
+	dialer := net.Dialer{Timeout: sender.cfg.Timeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(sender.cfg.Host, strconv.Itoa(sender.cfg.Port)))
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(sender.cfg.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, sender.cfg.Host)
+	if err != nil {
+		conn.Close()
+
+		return err
+	}
+
+	defer client.Close()
+
+	if err := sender.deliver(client, fromAddr.Address, toAddr.Address, data); err != nil {
+		return classifySMTPError(err)
+	}
+
+	return client.Quit()
+}
+
+func (sender *SMTPSender) deliver(client *smtp.Client, from, to string, data []byte) error {
+	if sender.cfg.StartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: sender.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+
+	if sender.cfg.Username != "" {
+		auth := smtp.PlainAuth("", sender.cfg.Username, sender.password, sender.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// classifySMTPError помечает ответы 5xx как окончательные: повтор их не исправит.
+func classifySMTPError(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return Permanent(err)
+	}
+
+	return err
+}
@@ -0,0 +1,144 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	TemplateWelcome            = "welcome"
+	TemplateConfirmEmail       = "confirm_email"
+	TemplatePasswordReset      = "password_reset"
+	TemplateOrderPlaced        = "order_placed"
+	TemplateOrderStatusChanged = "order_status_changed"
+	TemplatePaymentSucceeded   = "payment_succeeded"
+)
+
+var templateNames = []string{
+	TemplateWelcome,
+	TemplateConfirmEmail,
+	TemplatePasswordReset,
+	TemplateOrderPlaced,
+	TemplateOrderStatusChanged,
+	TemplatePaymentSucceeded,
+}
+
+//go:embed templates
+var templateFiles embed.FS
+
+type WelcomeData struct {
+	BaseURL string
+	Email   string
+	// ConfirmURL - ссылка подтверждения адреса; если пустая, блок с ней не выводится
+	ConfirmURL string
+}
+
+type ConfirmEmailData struct {
+	BaseURL    string
+	Email      string
+	ConfirmURL string
+}
+
+type PasswordResetData struct {
+	BaseURL  string
+	Email    string
+	ResetURL string
+}
+
+type OrderLine struct {
+	AdvertID      uint
+	Title         string
+	Price         uint
+	DeliveryPrice uint
+}
+
+type OrderPlacedData struct {
+	BaseURL string
+	Items   []OrderLine
+	Total   uint
+}
+
+type OrderStatusData struct {
+	BaseURL  string
+	AdvertID uint
+	Title    string
+	Status   string
+}
+
+type PaymentData struct {
+	BaseURL  string
+	AdvertID uint
+	Title    string
+}
+
+// Templates - письма из каталога templates: в <имя>.txt блоки subject и text,
+// в <имя>.html блок content, который вставляется в общий layout.html.
+type Templates struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+func LoadTemplates() (*Templates, error) {
+	layout, err := htmltemplate.ParseFS(templateFiles, "templates/layout.html")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := &Templates{
+		text: make(map[string]*texttemplate.Template, len(templateNames)),
+		html: make(map[string]*htmltemplate.Template, len(templateNames)),
+	}
+
+	for _, name := range templateNames {
+		text, err := texttemplate.ParseFS(templateFiles, "templates/"+name+".txt")
+		if err != nil {
+			return nil, err
+		}
+
+		html, err := layout.Clone()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := html.ParseFS(templateFiles, "templates/"+name+".html"); err != nil {
+			return nil, err
+		}
+
+		templates.text[name] = text
+		templates.html[name] = html
+	}
+
+	return templates, nil
+}
+
+func (templates *Templates) Render(name, to string, data any) (*Message, error) {
+	text, ok := templates.text[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown mail template %q", name)
+	}
+
+	var subject, body, html bytes.Buffer
+
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+
+	if err := text.ExecuteTemplate(&body, "text", data); err != nil {
+		return nil, err
+	}
+
+	if err := templates.html[name].ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(body.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
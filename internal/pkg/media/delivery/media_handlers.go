@@ -0,0 +1,100 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// имена загруженных файлов случайные и никогда не переиспользуются, поэтому кэшируем надолго
+	cacheControl = "public, max-age=31536000, immutable"
+	// ссылка на удалённое хранилище подписана и живёт ограниченное время, сам редирект не кэшируем
+	redirectCacheControl = "no-cache"
+)
+
+var (
+	errMediaIsDirectory = errors.New("media path is a directory")
+	errMediaIsPrivate   = errors.New("media is not public")
+)
+
+type MediaHandler struct {
+	store blobstore.BlobStore
+}
+
+func NewMediaHandler(store blobstore.BlobStore) *MediaHandler {
+	return &MediaHandler{
+		store: store,
+	}
+}
+
+// ServeMedia отдаёт загруженные файлы. Файлы с локального диска отдаются с ETag, Last-Modified,
+// Cache-Control и поддержкой Range через http.ServeContent, для удалённого хранилища
+// клиент перенаправляется на подписанную ссылку, а кэширование и Range обеспечивает само хранилище.
+func (h *MediaHandler) ServeMedia(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	key, err := blobstore.NormalizeKey(strings.TrimPrefix(request.URL.Path, utils.MediaURLPrefix))
+	if err == nil && utils.IsPrivateMediaKey(key) {
+		err = errMediaIsPrivate
+	}
+
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusNotFound)
+		http.NotFound(writer, request)
+
+		return
+	}
+
+	opener, ok := h.store.(blobstore.FileOpener)
+	if !ok {
+		signedURL, err := h.store.SignedURL(ctx, key, 0)
+		if err != nil {
+			logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
+			http.Error(writer, responses.ErrInternalServer, responses.StatusInternalServerError)
+
+			return
+		}
+
+		writer.Header().Set("Cache-Control", redirectCacheControl)
+		logging.LogHandlerInfo(logger, "redirect", http.StatusFound)
+		http.Redirect(writer, request, signedURL, http.StatusFound)
+
+		return
+	}
+
+	file, err := opener.Open(key)
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusNotFound)
+		http.NotFound(writer, request)
+
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err == nil && info.IsDir() {
+		err = errMediaIsDirectory
+	}
+
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusNotFound)
+		http.NotFound(writer, request)
+
+		return
+	}
+
+	writer.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()))
+	writer.Header().Set("Cache-Control", cacheControl)
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	http.ServeContent(writer, request, info.Name(), info.ModTime(), file)
+}
@@ -0,0 +1,219 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	messagingusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/usecases"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type MessagingHandler struct {
+	storage    messagingusecases.MessagingStorageInterface
+	hub        *messagingusecases.Hub
+	authClient authproto.AuthClient
+}
+
+func NewMessagingHandler(storage messagingusecases.MessagingStorageInterface, hub *messagingusecases.Hub,
+	authClient authproto.AuthClient) *MessagingHandler {
+	return &MessagingHandler{
+		storage:    storage,
+		hub:        hub,
+		authClient: authClient,
+	}
+}
+
+// StartConversation открывает беседу с продавцом по объявлению; повторный вызов вернёт ту же беседу.
+func (h *MessagingHandler) StartConversation(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var data models.ReceivedConversation
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil || data.AdvertID == 0 {
+		sendMessagingError(writer, request, logger, messagingusecases.ErrMessageNotValid)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	conversation, err := h.storage.StartConversation(ctx, userID, data.AdvertID)
+	if err != nil {
+		sendMessagingError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Conversation %d started", conversation.ID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(conversation))
+}
+
+// GetConversations отдаёт беседы пользователя как покупателя и как продавца, свежие сверху.
+func (h *MessagingHandler) GetConversations(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	conversations, err := h.storage.GetConversations(ctx, userID)
+	if err != nil {
+		sendMessagingError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(conversations))
+}
+
+// GetMessages отдаёт историю беседы страницами; следующую страницу просят с before=nextBefore.
+func (h *MessagingHandler) GetMessages(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conversationID, _ := strconv.Atoi(mux.Vars(request)["id"])
+	before, _ := strconv.Atoi(request.URL.Query().Get("before"))
+	limitQuery, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+
+	if before < 0 {
+		before = 0
+	}
+
+	limit := messagingusecases.MessagesPageLimit(limitQuery)
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	messages, err := h.storage.GetMessages(ctx, userID, uint(conversationID), uint(before), limit+1)
+	if err != nil {
+		sendMessagingError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(messagingusecases.NewMessagesPage(messages, limit)))
+}
+
+func (h *MessagingHandler) SendMessage(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var data models.ReceivedMessage
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err == nil {
+		data.Text, err = messagingusecases.NormalizeMessageText(data.Text)
+	}
+
+	if err != nil {
+		sendMessagingError(writer, request, logger, messagingusecases.ErrMessageNotValid)
+
+		return
+	}
+
+	conversationID, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	message, err := h.storage.SendMessage(ctx, userID, uint(conversationID), data.Text)
+	if err != nil {
+		sendMessagingError(writer, request, logger, err)
+
+		return
+	}
+
+	// отправителю тоже, чтобы сообщение появилось в его других вкладках
+	event := &models.ChatEvent{Type: models.ChatEventMessage, Message: message}
+	h.hub.Publish(message.RecipientID, event)
+	h.hub.Publish(message.SenderID, event)
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Message %d sent", message.ID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(message))
+}
+
+// MarkRead ставит отметку о прочтении и сообщает о ней отправителю.
+func (h *MessagingHandler) MarkRead(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	var data models.ReceivedMessagesRead
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil || data.LastMessageID == 0 {
+		sendMessagingError(writer, request, logger, messagingusecases.ErrMessageNotValid)
+
+		return
+	}
+
+	conversationID, _ := strconv.Atoi(mux.Vars(request)["id"])
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	read, peerID, err := h.storage.MarkRead(ctx, userID, uint(conversationID), data.LastMessageID)
+	if err != nil {
+		sendMessagingError(writer, request, logger, err)
+
+		return
+	}
+
+	if read != nil {
+		event := &models.ChatEvent{Type: models.ChatEventRead, Read: read}
+		h.hub.Publish(peerID, event)
+		h.hub.Publish(userID, event)
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(read))
+}
+
+func sendMessagingError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger, err error) {
+	code, message := responses.StatusInternalServerError, responses.ErrInternalServer
+
+	switch {
+	case errors.Is(err, messagingusecases.ErrMessageNotValid):
+		code, message = responses.StatusBadRequest, responses.ErrNotValidData
+	case errors.Is(err, messagingusecases.ErrConversationNotFound):
+		code, message = responses.StatusNotFound, responses.ErrConversationNotExist
+	case errors.Is(err, messagingusecases.ErrAdvertNotFound):
+		code, message = responses.StatusNotFound, responses.ErrAdvertNotExist
+	case errors.Is(err, messagingusecases.ErrOwnAdvert):
+		code, message = responses.StatusBadRequest, responses.ErrOwnAdvertChat
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
@@ -0,0 +1,130 @@
+package delivery
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	wsWriteTimeout   = 10 * time.Second
+	wsPingPeriod     = 30 * time.Second
+	wsMaxPayloadSize = 1024
+)
+
+// Connect поднимает вебсокет, по которому пользователю приходят новые сообщения и отметки о прочтении.
+// Отправка идёт через REST, входящие кадры клиента нужны только чтобы заметить закрытие соединения.
+func (h *MessagingHandler) Connect(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.GetLoggerFromContext(request.Context()).With(zap.String("func", logging.GetFunctionName()))
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	server := websocket.Server{
+		Handshake: checkSameOrigin,
+		Handler: func(conn *websocket.Conn) {
+			h.serveConnection(conn, userID, logger)
+		},
+	}
+
+	server.ServeHTTP(writer, request)
+}
+
+func (h *MessagingHandler) serveConnection(conn *websocket.Conn, userID uint, logger *zap.SugaredLogger) {
+	// таймауты http.Server остаются на соединении после hijack, дальше они выставляются на каждую запись
+	_ = conn.SetDeadline(time.Time{})
+	conn.MaxPayloadBytes = wsMaxPayloadSize
+
+	sub := h.hub.Register(userID)
+	defer h.hub.Unregister(sub)
+
+	logging.LogInfo(logger, fmt.Sprintf("chat connection opened for user %d", userID))
+
+	closed := make(chan struct{})
+
+	go func() {
+		defer close(closed)
+
+		var frame []byte
+
+		for {
+			if err := websocket.Message.Receive(conn, &frame); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		var event *models.ChatEvent
+
+		select {
+		case <-closed:
+			logging.LogInfo(logger, fmt.Sprintf("chat connection closed for user %d", userID))
+
+			return
+		case <-ticker.C:
+			event = &models.ChatEvent{Type: models.ChatEventPing}
+		case next, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+
+			event = next
+		}
+
+		if err := writeEvent(conn, event); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while writing chat event, err=%w", err))
+
+			return
+		}
+	}
+}
+
+func writeEvent(conn *websocket.Conn, event *models.ChatEvent) error {
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return err
+	}
+
+	return websocket.Message.Send(conn, string(data))
+}
+
+// checkSameOrigin не пускает вебсокеты со сторонних сайтов: браузер приложит к ним куки сессии.
+// Клиенты без Origin (не браузеры) проходят, их авторизует та же кука.
+func checkSameOrigin(config *websocket.Config, request *http.Request) error {
+	origin := request.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return err
+	}
+
+	host, _, err := net.SplitHostPort(request.Host)
+	if err != nil {
+		host = request.Host
+	}
+
+	if originURL.Hostname() != host {
+		return fmt.Errorf("cross-origin websocket from %s is not allowed", origin)
+	}
+
+	config.Origin = originURL
+
+	return nil
+}
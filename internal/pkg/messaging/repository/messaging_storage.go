@@ -0,0 +1,469 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	messagingusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/usecases"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type MessagingStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewMessagingStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *MessagingStorage {
+	return &MessagingStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (storage *MessagingStorage) startConversation(ctx context.Context, tx pgx.Tx, buyerID,
+	advertID uint) (*models.Conversation, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLAdvertSeller := `SELECT user_id, title FROM public.advert WHERE id = $1;`
+
+	logging.LogInfo(logger, "SELECT FROM advert")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLAdvertSeller, advertID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	conversation := models.Conversation{AdvertID: advertID, BuyerID: buyerID}
+
+	err := line.Scan(&conversation.SellerID, &conversation.AdvertTitle)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, messagingusecases.ErrAdvertNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting advert seller, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	if conversation.SellerID == buyerID {
+		return nil, messagingusecases.ErrOwnAdvert
+	}
+
+	// пустое обновление нужно, чтобы RETURNING вернул уже существующую беседу
+	SQLStartConversation := `
+	INSERT INTO public.conversation (advert_id, buyer_id, seller_id)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (advert_id, buyer_id) DO UPDATE SET advert_id = EXCLUDED.advert_id
+	RETURNING id, created_time, updated_time;`
+
+	logging.LogInfo(logger, "INSERT INTO conversation")
+
+	start = time.Now()
+
+	line = tx.QueryRow(ctx, SQLStartConversation, advertID, buyerID, conversation.SellerID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err := line.Scan(&conversation.ID, &conversation.CreatedTime, &conversation.UpdatedTime); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting conversation, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &conversation, nil
+}
+
+func (storage *MessagingStorage) StartConversation(ctx context.Context, buyerID,
+	advertID uint) (*models.Conversation, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var conversation *models.Conversation
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		conversationInner, err := storage.startConversation(ctx, tx, buyerID, advertID)
+		conversation = conversationInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while starting conversation, err=%w", err))
+
+		return nil, err
+	}
+
+	return conversation, nil
+}
+
+func (storage *MessagingStorage) getConversations(ctx context.Context, tx pgx.Tx,
+	userID uint) ([]*models.Conversation, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetConversations := `
+	SELECT c.id, c.advert_id, a.title, c.buyer_id, c.seller_id, c.created_time, c.updated_time,
+		(SELECT COUNT(*) FROM public.message m
+		 WHERE m.conversation_id = c.id AND m.recipient_id = $1 AND m.read_time IS NULL),
+		lm.id, lm.sender_id, lm.recipient_id, lm.text, lm.created_time, lm.read_time
+	FROM public.conversation c
+	JOIN public.advert a ON a.id = c.advert_id
+	LEFT JOIN LATERAL (
+		SELECT id, sender_id, recipient_id, text, created_time, read_time
+		FROM public.message
+		WHERE conversation_id = c.id
+		ORDER BY id DESC
+		LIMIT 1
+	) lm ON TRUE
+	WHERE c.buyer_id = $1 OR c.seller_id = $1
+	ORDER BY c.updated_time DESC, c.id DESC;`
+
+	logging.LogInfo(logger, "SELECT FROM conversation, advert, message")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetConversations, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting conversations, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	conversations := []*models.Conversation{}
+
+	for rows.Next() {
+		conversation := models.Conversation{}
+
+		var (
+			lastID, lastSenderID, lastRecipientID *uint
+			lastText                              *string
+			lastCreated                           *time.Time
+			lastRead                              *time.Time
+		)
+
+		if err := rows.Scan(&conversation.ID, &conversation.AdvertID, &conversation.AdvertTitle,
+			&conversation.BuyerID, &conversation.SellerID, &conversation.CreatedTime, &conversation.UpdatedTime,
+			&conversation.UnreadCount, &lastID, &lastSenderID, &lastRecipientID, &lastText, &lastCreated,
+			&lastRead); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning conversations, err=%w", err))
+			storage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		if lastID != nil {
+			conversation.LastMessage = &models.Message{
+				ID:             *lastID,
+				ConversationID: conversation.ID,
+				SenderID:       *lastSenderID,
+				RecipientID:    *lastRecipientID,
+				Text:           *lastText,
+				CreatedTime:    *lastCreated,
+				ReadTime:       lastRead,
+			}
+		}
+
+		conversations = append(conversations, &conversation)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning conversations, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return conversations, nil
+}
+
+func (storage *MessagingStorage) GetConversations(ctx context.Context, userID uint) ([]*models.Conversation, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var conversations []*models.Conversation
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		conversationsInner, err := storage.getConversations(ctx, tx, userID)
+		conversations = conversationsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting conversations, err=%w", err))
+
+		return nil, err
+	}
+
+	return conversations, nil
+}
+
+// getPeer проверяет, что пользователь участвует в беседе, и возвращает его собеседника.
+func (storage *MessagingStorage) getPeer(ctx context.Context, tx pgx.Tx, userID, conversationID uint) (uint, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetPeer := `
+	SELECT CASE WHEN buyer_id = $2 THEN seller_id ELSE buyer_id END
+	FROM public.conversation
+	WHERE id = $1 AND (buyer_id = $2 OR seller_id = $2);`
+
+	logging.LogInfo(logger, "SELECT FROM conversation")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetPeer, conversationID, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	var peerID uint
+
+	err := line.Scan(&peerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, messagingusecases.ErrConversationNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting conversation, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return 0, err
+	}
+
+	return peerID, nil
+}
+
+func (storage *MessagingStorage) getMessages(ctx context.Context, tx pgx.Tx, userID, conversationID, before,
+	limit uint) ([]*models.Message, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if _, err := storage.getPeer(ctx, tx, userID, conversationID); err != nil {
+		return nil, err
+	}
+
+	SQLGetMessages := `
+	SELECT id, conversation_id, sender_id, recipient_id, text, created_time, read_time
+	FROM public.message
+	WHERE conversation_id = $1 AND ($2 = 0 OR id < $2)
+	ORDER BY id DESC
+	LIMIT $3;`
+
+	logging.LogInfo(logger, "SELECT FROM message")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetMessages, conversationID, before, limit)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting messages, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	messages := []*models.Message{}
+
+	for rows.Next() {
+		message := models.Message{}
+
+		if err := rows.Scan(&message.ID, &message.ConversationID, &message.SenderID, &message.RecipientID,
+			&message.Text, &message.CreatedTime, &message.ReadTime); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning messages, err=%w", err))
+			storage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		messages = append(messages, &message)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning messages, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (storage *MessagingStorage) GetMessages(ctx context.Context, userID, conversationID, before,
+	limit uint) ([]*models.Message, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var messages []*models.Message
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		messagesInner, err := storage.getMessages(ctx, tx, userID, conversationID, before, limit)
+		messages = messagesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting messages, err=%w", err))
+
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (storage *MessagingStorage) sendMessage(ctx context.Context, tx pgx.Tx, userID, conversationID uint,
+	text string) (*models.Message, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	peerID, err := storage.getPeer(ctx, tx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	SQLSendMessage := `
+	INSERT INTO public.message (conversation_id, sender_id, recipient_id, text)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, created_time;`
+
+	logging.LogInfo(logger, "INSERT INTO message")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLSendMessage, conversationID, userID, peerID, text)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	message := models.Message{
+		ConversationID: conversationID,
+		SenderID:       userID,
+		RecipientID:    peerID,
+		Text:           text,
+	}
+
+	if err := line.Scan(&message.ID, &message.CreatedTime); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting message, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (storage *MessagingStorage) SendMessage(ctx context.Context, userID, conversationID uint,
+	text string) (*models.Message, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var message *models.Message
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		messageInner, err := storage.sendMessage(ctx, tx, userID, conversationID, text)
+		message = messageInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sending message, err=%w", err))
+
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (storage *MessagingStorage) markRead(ctx context.Context, tx pgx.Tx, userID, conversationID,
+	lastMessageID uint) (*models.MessagesRead, uint, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	peerID, err := storage.getPeer(ctx, tx, userID, conversationID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	SQLMarkRead := `
+	WITH updated AS (
+		UPDATE public.message
+		SET read_time = NOW()
+		WHERE conversation_id = $1 AND recipient_id = $2 AND read_time IS NULL AND id <= $3
+		RETURNING id, read_time
+	)
+	SELECT MAX(id), MAX(read_time) FROM updated;`
+
+	logging.LogInfo(logger, "UPDATE message")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLMarkRead, conversationID, userID, lastMessageID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	var (
+		readID   *uint
+		readTime *time.Time
+	)
+
+	if err := line.Scan(&readID, &readTime); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while marking messages read, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, 0, err
+	}
+
+	if readID == nil {
+		return nil, peerID, nil
+	}
+
+	return &models.MessagesRead{
+		ConversationID: conversationID,
+		ReaderID:       userID,
+		LastMessageID:  *readID,
+		ReadTime:       *readTime,
+	}, peerID, nil
+}
+
+func (storage *MessagingStorage) MarkRead(ctx context.Context, userID, conversationID,
+	lastMessageID uint) (*models.MessagesRead, uint, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var (
+		read   *models.MessagesRead
+		peerID uint
+	)
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		readInner, peerInner, err := storage.markRead(ctx, tx, userID, conversationID, lastMessageID)
+		read, peerID = readInner, peerInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while marking messages read, err=%w", err))
+
+		return nil, 0, err
+	}
+
+	return read, peerID, nil
+}
@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"sync"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+const subscriptionBuffer = 32
+
+// Subscription - одно открытое соединение пользователя; у пользователя их может быть несколько (вкладки, устройства).
+type Subscription struct {
+	userID uint
+	events chan *models.ChatEvent
+	once   sync.Once
+}
+
+func (sub *Subscription) Events() <-chan *models.ChatEvent {
+	return sub.events
+}
+
+// Hub раздаёт события чата открытым соединениям в пределах одного процесса.
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[uint]map[*Subscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[uint]map[*Subscription]struct{}),
+	}
+}
+
+func (hub *Hub) Register(userID uint) *Subscription {
+	sub := &Subscription{
+		userID: userID,
+		events: make(chan *models.ChatEvent, subscriptionBuffer),
+	}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if hub.subscribers[userID] == nil {
+		hub.subscribers[userID] = make(map[*Subscription]struct{})
+	}
+
+	hub.subscribers[userID][sub] = struct{}{}
+
+	return sub
+}
+
+// Unregister отключает соединение и закрывает его канал; повторный вызов ничего не делает.
+func (hub *Hub) Unregister(sub *Subscription) {
+	sub.once.Do(func() {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+
+		delete(hub.subscribers[sub.userID], sub)
+
+		if len(hub.subscribers[sub.userID]) == 0 {
+			delete(hub.subscribers, sub.userID)
+		}
+
+		close(sub.events)
+	})
+}
+
+// Publish не ждёт медленных клиентов: если буфер соединения полон, событие ему не достанется,
+// клиент доберёт пропущенное через историю.
+func (hub *Hub) Publish(userID uint, event *models.ChatEvent) {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+
+	for sub := range hub.subscribers[userID] {
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
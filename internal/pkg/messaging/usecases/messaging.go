@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+const (
+	maxMessageLen = 2000
+
+	DefaultMessagesPage = 50
+	MaxMessagesPage     = 100
+)
+
+var (
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrOwnAdvert            = errors.New("can not start conversation about own advert")
+	ErrAdvertNotFound       = errors.New("advert not found")
+	ErrMessageNotValid      = errors.New("message is not valid")
+)
+
+type MessagingStorageInterface interface {
+	// StartConversation возвращает беседу покупателя по объявлению, создавая её при первом обращении.
+	StartConversation(ctx context.Context, buyerID, advertID uint) (*models.Conversation, error)
+	GetConversations(ctx context.Context, userID uint) ([]*models.Conversation, error)
+	// GetMessages отдаёт limit сообщений старше before (0 - с самого нового), новые сверху.
+	GetMessages(ctx context.Context, userID, conversationID, before, limit uint) ([]*models.Message, error)
+	SendMessage(ctx context.Context, userID, conversationID uint, text string) (*models.Message, error)
+	// MarkRead отмечает прочитанными входящие сообщения беседы до lastMessageID включительно и возвращает
+	// отметку вместе с собеседником, которому о ней сообщить; отметка nil, если отмечать было нечего.
+	MarkRead(ctx context.Context, userID, conversationID, lastMessageID uint) (*models.MessagesRead, uint, error)
+}
+
+// NormalizeMessageText обрезает пробелы по краям и проверяет длину сообщения.
+func NormalizeMessageText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+
+	if text == "" || utf8.RuneCountInString(text) > maxMessageLen {
+		return "", ErrMessageNotValid
+	}
+
+	return text, nil
+}
+
+// MessagesPageLimit приводит размер страницы истории к допустимому.
+func MessagesPageLimit(limit int) uint {
+	return utils.PageLimit(limit, DefaultMessagesPage, MaxMessagesPage)
+}
+
+// NewMessagesPage собирает страницу истории; запрошено на одно сообщение больше, чтобы понять, есть ли ещё.
+func NewMessagesPage(messages []*models.Message, limit uint) *models.MessagesPage {
+	page := &models.MessagesPage{Messages: messages}
+
+	if uint(len(messages)) > limit {
+		page.Messages = messages[:limit]
+		nextBefore := page.Messages[limit-1].ID
+		page.NextBefore = &nextBefore
+	}
+
+	if page.Messages == nil {
+		page.Messages = []*models.Message{}
+	}
+
+	return page
+}
@@ -0,0 +1,155 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/usecases"
+)
+
+func TestNormalizeMessageText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "trimmed", input: "  Ещё продаётся?\n", want: "Ещё продаётся?"},
+		{name: "empty", input: " \t\n", wantErr: usecases.ErrMessageNotValid},
+		{name: "max length in runes", input: strings.Repeat("я", 2000), want: strings.Repeat("я", 2000)},
+		{name: "too long", input: strings.Repeat("я", 2001), wantErr: usecases.ErrMessageNotValid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := usecases.NormalizeMessageText(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NormalizeMessageText() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("NormalizeMessageText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessagesPageLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input int
+		want  uint
+	}{
+		{name: "default", input: 0, want: usecases.DefaultMessagesPage},
+		{name: "negative", input: -5, want: usecases.DefaultMessagesPage},
+		{name: "as is", input: 20, want: 20},
+		{name: "capped", input: 1000, want: usecases.MaxMessagesPage},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := usecases.MessagesPageLimit(tt.input); got != tt.want {
+				t.Errorf("MessagesPageLimit() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMessagesPage(t *testing.T) {
+	t.Parallel()
+
+	messages := []*models.Message{{ID: 9}, {ID: 8}, {ID: 7}}
+
+	page := usecases.NewMessagesPage(messages, 2)
+	if len(page.Messages) != 2 || page.NextBefore == nil || *page.NextBefore != 8 {
+		t.Errorf("NewMessagesPage() with more history = %+v", page)
+	}
+
+	page = usecases.NewMessagesPage(messages, 3)
+	if len(page.Messages) != 3 || page.NextBefore != nil {
+		t.Errorf("NewMessagesPage() on last page = %+v", page)
+	}
+
+	page = usecases.NewMessagesPage(nil, 3)
+	if page.Messages == nil || len(page.Messages) != 0 || page.NextBefore != nil {
+		t.Errorf("NewMessagesPage() on empty history = %+v", page)
+	}
+}
+
+func TestHub(t *testing.T) {
+	t.Parallel()
+
+	hub := usecases.NewHub()
+
+	first := hub.Register(1)
+	second := hub.Register(1)
+	other := hub.Register(2)
+
+	event := &models.ChatEvent{Type: models.ChatEventMessage, Message: &models.Message{ID: 1, Text: "Привет"}}
+	hub.Publish(1, event)
+
+	for _, sub := range []*usecases.Subscription{first, second} {
+		select {
+		case got := <-sub.Events():
+			if got != event {
+				t.Errorf("Publish() delivered %+v, want %+v", got, event)
+			}
+		default:
+			t.Errorf("Publish() did not deliver event to every connection of the user")
+		}
+	}
+
+	select {
+	case got := <-other.Events():
+		t.Errorf("Publish() delivered %+v to another user", got)
+	default:
+	}
+
+	hub.Unregister(first)
+	hub.Unregister(first)
+
+	if _, ok := <-first.Events(); ok {
+		t.Errorf("Unregister() did not close events channel")
+	}
+
+	hub.Publish(1, event)
+
+	if got := <-second.Events(); got != event {
+		t.Errorf("Publish() after Unregister delivered %+v, want %+v", got, event)
+	}
+}
+
+func TestHubDoesNotBlockOnSlowClient(t *testing.T) {
+	t.Parallel()
+
+	hub := usecases.NewHub()
+	sub := hub.Register(1)
+
+	for i := 0; i < 1000; i++ {
+		hub.Publish(1, &models.ChatEvent{Type: models.ChatEventMessage})
+	}
+
+	hub.Unregister(sub)
+
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+
+	if received == 0 || received >= 1000 {
+		t.Errorf("slow client received %d events, want a full buffer", received)
+	}
+}
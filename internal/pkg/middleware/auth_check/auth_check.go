@@ -34,10 +34,19 @@ func CreateAuthCheckMiddleware(authClient authproto.AuthClient) mux.MiddlewareFu
 				return
 			}
 
-			user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{
+			user, err := authClient.GetCurrentUser(ctx, &authproto.SessionData{
 				SessionID: session.Value,
+				Client:    ClientInfo(request),
 			})
 
+			if err != nil {
+				logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
+				responses.SendErrResponse(request, writer, responses.NewErrResponse(
+					responses.StatusInternalServerError, responses.ErrInternalServer))
+
+				return
+			}
+
 			if !user.IsAuth {
 				err = errors.New("user not authorized")
 				logging.LogHandlerError(logger, err, responses.StatusUnauthorized)
@@ -47,6 +56,9 @@ func CreateAuthCheckMiddleware(authClient authproto.AuthClient) mux.MiddlewareFu
 				return
 			}
 
+			// сессия на сервере продлилась, кука продлевается вместе с ней
+			http.SetCookie(writer, NewSessionCookie(session.Value, user.ExpiresAt))
+
 			sessionInstance := models.Session{
 				UserID: uint32(user.ID),
 				Value:  session.Value,
@@ -0,0 +1,65 @@
+package authcheck
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+)
+
+// NewSessionCookie - кука сессии, которая истекает вместе с сессией на сервере. expiresAt - unix-время
+// из ответа сервиса авторизации.
+func NewSessionCookie(sessionID string, expiresAt int64) *http.Cookie {
+	return &http.Cookie{
+		Name:     "session_id",
+		Value:    sessionID,
+		Path:     "/",
+		Expires:  time.Unix(expiresAt, 0),
+		HttpOnly: true,
+	}
+}
+
+// CurrentUserID - id пользователя по куке сессии; для анонима и при ошибке сервиса авторизации - 0.
+func CurrentUserID(request *http.Request, authClient authproto.AuthClient) uint {
+	session, err := request.Cookie("session_id")
+	if err != nil {
+		return 0
+	}
+
+	user, err := authClient.GetCurrentUser(request.Context(), &authproto.SessionData{SessionID: session.Value})
+	if err != nil || user == nil {
+		return 0
+	}
+
+	return uint(user.ID)
+}
+
+// ClientInfo - адрес и User-Agent запроса для списка сессий. Адрес берётся из заголовков прокси,
+// он нужен только для показа пользователю, поэтому заголовкам можно доверять.
+func ClientInfo(request *http.Request) *authproto.ClientInfo {
+	return &authproto.ClientInfo{
+		IP:        clientIP(request),
+		UserAgent: request.UserAgent(),
+	}
+}
+
+func clientIP(request *http.Request) string {
+	if forwarded := request.Header.Get("X-Forwarded-For"); forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+
+		return strings.TrimSpace(first)
+	}
+
+	if realIP := request.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,58 @@
+package media
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	InlineImagesHeader = "X-Inline-Images"
+	inlineImagesQuery  = "inlineImages"
+	inlineImagesMD     = "x-inline-images"
+)
+
+func isEnabled(value string) bool {
+	return value == "1" || value == "true"
+}
+
+// CreateInlineImagesMiddleware включает встраивание картинок в base64 для старых клиентов,
+// которые передают заголовок X-Inline-Images или параметр inlineImages.
+func CreateInlineImagesMiddleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if isEnabled(request.Header.Get(InlineImagesHeader)) || isEnabled(request.URL.Query().Get(inlineImagesQuery)) {
+				ctx := context.WithValue(request.Context(), config.InlineImagesContextKey, true)
+				request = request.WithContext(ctx)
+			}
+
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// InlineImagesClientInterceptor передаёт флаг встраивания картинок в микросервисы через метаданные gRPC.
+func InlineImagesClientInterceptor(ctx context.Context, method string, req, reply interface{},
+	conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if utils.InlineImagesRequested(ctx) {
+		ctx = metadata.AppendToOutgoingContext(ctx, inlineImagesMD, "1")
+	}
+
+	return invoker(ctx, method, req, reply, conn, opts...)
+}
+
+func InlineImagesServerInterceptor(ctx context.Context, req interface{},
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(inlineImagesMD); len(values) > 0 && isEnabled(values[0]) {
+			ctx = context.WithValue(ctx, config.InlineImagesContextKey, true)
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,162 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPendingCount = 20
+)
+
+var errNotModerator = errors.New("user is not a moderator")
+
+type ModerationHandler struct {
+	storage    moderationusecases.ModerationStorageInterface
+	authClient authproto.AuthClient
+}
+
+func NewModerationHandler(storage moderationusecases.ModerationStorageInterface,
+	authClient authproto.AuthClient) *ModerationHandler {
+	return &ModerationHandler{
+		storage:    storage,
+		authClient: authClient,
+	}
+}
+
+func (h *ModerationHandler) GetPendingList(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	storage := h.storage
+	authClient := h.authClient
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	if !storage.IsModerator(ctx, uint(user.ID)) {
+		logging.LogHandlerError(logger, errNotModerator, responses.StatusForbidden)
+		log.Println(errNotModerator, responses.StatusForbidden)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusForbidden,
+			responses.ErrForbidden))
+
+		return
+	}
+
+	count, err := strconv.Atoi(request.URL.Query().Get("count"))
+	if err != nil || count <= 0 {
+		count = defaultPendingCount
+	}
+
+	startID, _ := strconv.Atoi(request.URL.Query().Get("startId"))
+
+	moderationList, err := storage.GetPendingAdverts(ctx, uint(startID), uint(count))
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(moderationList))
+}
+
+func (h *ModerationHandler) ResolveAdvert(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	storage := h.storage
+	authClient := h.authClient
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	if !storage.IsModerator(ctx, uint(user.ID)) {
+		logging.LogHandlerError(logger, errNotModerator, responses.StatusForbidden)
+		log.Println(errNotModerator, responses.StatusForbidden)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusForbidden,
+			responses.ErrForbidden))
+
+		return
+	}
+
+	var data models.ReceivedModerationDecision
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	err := data.UnmarshalJSON(reqData)
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	if !data.Approved && len(data.Reasons) == 0 {
+		err = errors.New("rejection without reasons")
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	err = storage.ResolveAdvert(ctx, uint(user.ID), &data)
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Advert %d moderated by %d, approved=%t", data.AdvertID, user.ID,
+		data.Approved), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(data))
+}
+
+// GetMyModerationList возвращает продавцу его объявления на модерации и отклонённые вместе с причинами.
+func (h *ModerationHandler) GetMyModerationList(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	storage := h.storage
+	authClient := h.authClient
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	moderationList, err := storage.GetUserModerationList(ctx, uint(user.ID))
+	if err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(moderationList))
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: moderation.go
+
+// Package mock_usecases is a generated GoMock package.
+package mock_usecases
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockModerationStorageInterface is a mock of ModerationStorageInterface interface.
+type MockModerationStorageInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationStorageInterfaceMockRecorder
+}
+
+// MockModerationStorageInterfaceMockRecorder is the mock recorder for MockModerationStorageInterface.
+type MockModerationStorageInterfaceMockRecorder struct {
+	mock *MockModerationStorageInterface
+}
+
+// NewMockModerationStorageInterface creates a new mock instance.
+func NewMockModerationStorageInterface(ctrl *gomock.Controller) *MockModerationStorageInterface {
+	mock := &MockModerationStorageInterface{ctrl: ctrl}
+	mock.recorder = &MockModerationStorageInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationStorageInterface) EXPECT() *MockModerationStorageInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetPendingAdverts mocks base method.
+func (m *MockModerationStorageInterface) GetPendingAdverts(ctx context.Context, startID, num uint) ([]*models.ModerationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingAdverts", ctx, startID, num)
+	ret0, _ := ret[0].([]*models.ModerationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingAdverts indicates an expected call of GetPendingAdverts.
+func (mr *MockModerationStorageInterfaceMockRecorder) GetPendingAdverts(ctx, startID, num interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingAdverts", reflect.TypeOf((*MockModerationStorageInterface)(nil).GetPendingAdverts), ctx, startID, num)
+}
+
+// GetUserModerationList mocks base method.
+func (m *MockModerationStorageInterface) GetUserModerationList(ctx context.Context, userID uint) ([]*models.ModerationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserModerationList", ctx, userID)
+	ret0, _ := ret[0].([]*models.ModerationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserModerationList indicates an expected call of GetUserModerationList.
+func (mr *MockModerationStorageInterfaceMockRecorder) GetUserModerationList(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserModerationList", reflect.TypeOf((*MockModerationStorageInterface)(nil).GetUserModerationList), ctx, userID)
+}
+
+// IsModerator mocks base method.
+func (m *MockModerationStorageInterface) IsModerator(ctx context.Context, userID uint) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsModerator", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsModerator indicates an expected call of IsModerator.
+func (mr *MockModerationStorageInterfaceMockRecorder) IsModerator(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsModerator", reflect.TypeOf((*MockModerationStorageInterface)(nil).IsModerator), ctx, userID)
+}
+
+// ResolveAdvert mocks base method.
+func (m *MockModerationStorageInterface) ResolveAdvert(ctx context.Context, moderatorID uint, data *models.ReceivedModerationDecision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveAdvert", ctx, moderatorID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveAdvert indicates an expected call of ResolveAdvert.
+func (mr *MockModerationStorageInterfaceMockRecorder) ResolveAdvert(ctx, moderatorID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAdvert", reflect.TypeOf((*MockModerationStorageInterface)(nil).ResolveAdvert), ctx, moderatorID, data)
+}
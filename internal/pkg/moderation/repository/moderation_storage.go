@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+var errAdvertNotPending = errors.New("advert is not waiting for moderation")
+
+type ModerationStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewModerationStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *ModerationStorage {
+	return &ModerationStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (ms *ModerationStorage) isModerator(ctx context.Context, tx pgx.Tx, userID uint) (bool, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLIsModerator := `SELECT EXISTS(SELECT 1 FROM public.moderator WHERE user_id = $1);`
+
+	logging.LogInfo(logger, "SELECT FROM moderator")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLIsModerator, userID)
+
+	ms.metrics.AddDuration(funcName, time.Since(start))
+
+	var exists bool
+
+	if err := line.Scan(&exists); err != nil {
+		logging.LogError(logger, fmt.Errorf("error while scanning moderator exists, err=%w", err))
+		ms.metrics.IncreaseErrors(funcName)
+
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (ms *ModerationStorage) IsModerator(ctx context.Context, userID uint) bool {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var exists bool
+
+	err := pgx.BeginFunc(ctx, ms.pool, func(tx pgx.Tx) error {
+		existsInner, err := ms.isModerator(ctx, tx, userID)
+		exists = existsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("error while executing moderator exists query, err=%w", err))
+
+		return false
+	}
+
+	return exists
+}
+
+func (ms *ModerationStorage) insertDecision(ctx context.Context, tx pgx.Tx, advertID, moderatorID uint,
+	decision string, reasons []string) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLInsertDecision := `
+	INSERT INTO public.advert_moderation (advert_id, moderator_id, decision, reasons)
+	VALUES ($1, NULLIF($2, 0), $3, $4);`
+
+	logging.LogInfo(logger, "INSERT INTO advert_moderation")
+
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLInsertDecision, advertID, moderatorID, decision, reasons)
+
+	ms.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting moderation decision, err=%w", err))
+		ms.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (ms *ModerationStorage) setAdvertStatus(ctx context.Context, tx pgx.Tx, advertID uint, approved bool) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetAdvertStatus := `
+	UPDATE public.advert
+	SET advert_status = (CASE WHEN $2 THEN 'Активно' ELSE 'Отклонено' END)::advert_status
+	WHERE id = $1 AND advert_status = 'На модерации'
+	RETURNING id;`
+
+	logging.LogInfo(logger, "UPDATE advert")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLSetAdvertStatus, advertID, approved)
+
+	ms.metrics.AddDuration(funcName, time.Since(start))
+
+	var id uint
+
+	if err := line.Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errAdvertNotPending
+		}
+
+		logging.LogError(logger, fmt.Errorf("something went wrong while updating advert status, err=%w", err))
+		ms.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (ms *ModerationStorage) ResolveAdvert(ctx context.Context, moderatorID uint,
+	data *models.ReceivedModerationDecision) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	decision := models.ModerationRejected
+	if data.Approved {
+		decision = models.ModerationApproved
+	}
+
+	err := pgx.BeginFunc(ctx, ms.pool, func(tx pgx.Tx) error {
+		err := ms.setAdvertStatus(ctx, tx, data.AdvertID, data.Approved)
+		if err != nil {
+			return err
+		}
+
+		return ms.insertDecision(ctx, tx, data.AdvertID, moderatorID, decision, data.Reasons)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while resolving advert moderation, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (ms *ModerationStorage) getModerationList(ctx context.Context, tx pgx.Tx, SQLQuery string,
+	args ...any) ([]*models.ModerationItem, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	logging.LogInfo(logger, "SELECT FROM advert, city, category, advert_image, advert_moderation")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLQuery, args...)
+
+	ms.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while executing select moderation list, err=%w",
+			err))
+		ms.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var moderationList []*models.ModerationItem
+
+	for rows.Next() {
+		var (
+			item        models.ModerationItem
+			photoPad    models.PhotoPadSoloImage
+			moderatorID *uint
+		)
+
+		advert := &item.Advert
+
+		if err := rows.Scan(&advert.Advert.ID, &advert.Advert.UserID, &advert.City.ID, &advert.City.CityName,
+			&advert.City.Translation, &advert.Category.ID, &advert.Category.Name, &advert.Category.Translation,
+			&advert.Advert.Title, &advert.Advert.Description, &advert.Advert.Price, &advert.Advert.CreatedTime,
+			&advert.Advert.Phone, &photoPad.Photo, &item.Decision.Decision, &item.Decision.Reasons, &moderatorID,
+			&item.Decision.CreatedTime); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning moderation list, err=%w", err))
+
+			return nil, err
+		}
+
+		advert.Advert.CityID = advert.City.ID
+		advert.Advert.CategoryID = advert.Category.ID
+		item.Decision.AdvertID = advert.Advert.ID
+
+		if moderatorID != nil {
+			item.Decision.ModeratorID = *moderatorID
+		}
+
+		if photoPad.Photo != nil {
+			advert.Photos = append(advert.Photos, *photoPad.Photo)
+		}
+
+		moderationList = append(moderationList, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning moderation rows, err=%w", err))
+
+		return nil, err
+	}
+
+	if moderationList == nil {
+		moderationList = []*models.ModerationItem{}
+	}
+
+	return moderationList, nil
+}
+
+const sqlModerationListSelect = `
+	SELECT
+		a.id,
+		a.user_id,
+		a.city_id,
+		c.name,
+		c.translation,
+		a.category_id,
+		cat.name,
+		cat.translation,
+		a.title,
+		a.description,
+		a.price,
+		a.created_time,
+		a.phone,
+		(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY position, id LIMIT 1) AS first_image_url,
+		COALESCE(m.decision, 'pending'),
+		COALESCE(m.reasons, ARRAY[]::TEXT[]),
+		m.moderator_id,
+		COALESCE(m.created_time, a.created_time)
+	FROM public.advert a
+	LEFT JOIN public.city c ON a.city_id = c.id
+	LEFT JOIN public.category cat ON a.category_id = cat.id
+	LEFT JOIN LATERAL (
+		SELECT decision, reasons, moderator_id, created_time
+		FROM public.advert_moderation
+		WHERE advert_id = a.id
+		ORDER BY id DESC
+		LIMIT 1
+	) m ON TRUE`
+
+func (ms *ModerationStorage) GetPendingAdverts(ctx context.Context, startID,
+	num uint) ([]*models.ModerationItem, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLPendingAdverts := sqlModerationListSelect + `
+	WHERE a.advert_status = 'На модерации' AND a.id > $1
+	ORDER BY a.id
+	LIMIT $2;`
+
+	var moderationList []*models.ModerationItem
+
+	err := pgx.BeginFunc(ctx, ms.pool, func(tx pgx.Tx) error {
+		moderationListInner, err := ms.getModerationList(ctx, tx, SQLPendingAdverts, startID, num)
+		moderationList = moderationListInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting pending adverts, err=%w", err))
+
+		return nil, err
+	}
+
+	return moderationList, nil
+}
+
+func (ms *ModerationStorage) GetUserModerationList(ctx context.Context,
+	userID uint) ([]*models.ModerationItem, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLUserModerationList := sqlModerationListSelect + `
+	WHERE a.user_id = $1 AND a.advert_status IN ('На модерации', 'Отклонено')
+	ORDER BY a.id;`
+
+	var moderationList []*models.ModerationItem
+
+	err := pgx.BeginFunc(ctx, ms.pool, func(tx pgx.Tx) error {
+		moderationListInner, err := ms.getModerationList(ctx, tx, SQLUserModerationList, userID)
+		moderationList = moderationListInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting user moderation list, err=%w", err))
+
+		return nil, err
+	}
+
+	return moderationList, nil
+}
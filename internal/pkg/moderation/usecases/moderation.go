@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+//go:generate mockgen -source=moderation.go -destination=../mocks/moderation_mocks.go
+
+type ModerationStorageInterface interface {
+	IsModerator(ctx context.Context, userID uint) bool
+	ResolveAdvert(ctx context.Context, moderatorID uint, data *models.ReceivedModerationDecision) error
+	GetPendingAdverts(ctx context.Context, startID, num uint) ([]*models.ModerationItem, error)
+	GetUserModerationList(ctx context.Context, userID uint) ([]*models.ModerationItem, error)
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+var (
+	phonePattern = regexp.MustCompile(`(?:\+7|\b8)[\s\-(]*\d{3}[\s\-)]*\d{3}[\s\-]*\d{2}[\s\-]*\d{2}`)
+	urlPattern   = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+|\b[a-z0-9\-]+\.(?:ru|com|net|org|su|io|me|info)\b`)
+)
+
+// AdvertModerator проверяет объявление по правилам из конфига до того, как оно попадёт в выдачу.
+type AdvertModerator struct {
+	autoApprove  bool
+	stopWords    map[string]struct{}
+	regexRules   []*regexp.Regexp
+	detectPhones bool
+	detectURLs   bool
+	priceLimits  map[string]config.PriceLimit
+}
+
+func NewAdvertModerator(cfg config.ModerationConfig) (*AdvertModerator, error) {
+	moderator := &AdvertModerator{
+		autoApprove:  cfg.AutoApprove,
+		stopWords:    make(map[string]struct{}, len(cfg.StopWords)),
+		detectPhones: cfg.DetectPhones,
+		detectURLs:   cfg.DetectURLs,
+		priceLimits:  cfg.PriceLimits,
+	}
+
+	for _, word := range cfg.StopWords {
+		moderator.stopWords[strings.ToLower(strings.TrimSpace(word))] = struct{}{}
+	}
+
+	for _, rule := range cfg.RegexRules {
+		compiled, err := regexp.Compile(rule)
+		if err != nil {
+			return nil, fmt.Errorf("bad moderation rule %q, err=%w", rule, err)
+		}
+
+		moderator.regexRules = append(moderator.regexRules, compiled)
+	}
+
+	return moderator, nil
+}
+
+func (m *AdvertModerator) Check(data models.ReceivedAdData) *models.ModerationVerdict {
+	text := data.Title + "\n" + data.Description
+	reasons := []string{}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	found := make(map[string]struct{})
+
+	for _, word := range words {
+		if _, ok := m.stopWords[word]; ok {
+			if _, reported := found[word]; !reported {
+				reasons = append(reasons, fmt.Sprintf("Запрещённое слово: %s", word))
+				found[word] = struct{}{}
+			}
+		}
+	}
+
+	for _, rule := range m.regexRules {
+		if rule.MatchString(text) {
+			reasons = append(reasons, fmt.Sprintf("Текст нарушает правило: %s", rule.String()))
+		}
+	}
+
+	if m.detectPhones && phonePattern.MatchString(data.Description) {
+		reasons = append(reasons, "Номер телефона в описании")
+	}
+
+	if m.detectURLs && urlPattern.MatchString(data.Description) {
+		reasons = append(reasons, "Ссылка в описании")
+	}
+
+	if limit, ok := m.priceLimits[data.Category]; ok {
+		if data.Price < limit.Min || (limit.Max != 0 && data.Price > limit.Max) {
+			reasons = append(reasons, fmt.Sprintf("Цена вне допустимого диапазона для категории: %d-%d",
+				limit.Min, limit.Max))
+		}
+	}
+
+	return &models.ModerationVerdict{
+		Approved: m.autoApprove && len(reasons) == 0,
+		Reasons:  reasons,
+	}
+}
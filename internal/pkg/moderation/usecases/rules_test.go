@@ -0,0 +1,63 @@
+//nolint:all
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	usecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+)
+
+func TestAdvertModeratorCheck(t *testing.T) {
+	t.Parallel()
+
+	moderator, err := usecases.NewAdvertModerator(config.ModerationConfig{
+		AutoApprove:  true,
+		StopWords:    []string{"казино"},
+		RegexRules:   []string{`(?i)без\s+документов`},
+		DetectPhones: true,
+		DetectURLs:   true,
+		PriceLimits:  map[string]config.PriceLimit{"auto": {Min: 10000, Max: 100000000}},
+	})
+	if err != nil {
+		t.Fatalf("NewAdvertModerator() err = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		data     models.ReceivedAdData
+		approved bool
+		reasons  int
+	}{
+		{"Clean advert", models.ReceivedAdData{Title: "Велосипед", Description: "Почти новый", Price: 5000}, true, 0},
+		{"Stop word", models.ReceivedAdData{Title: "Фишки для КАЗИНО", Description: "Торг"}, false, 1},
+		{"Regex rule", models.ReceivedAdData{Title: "Машина", Description: "Продаю без  документов"}, false, 1},
+		{"Phone", models.ReceivedAdData{Title: "Диван", Description: "Звоните +7 (999) 123-45-67"}, false, 1},
+		{"Phone with eight", models.ReceivedAdData{Title: "Диван", Description: "тел 8 999 123 45 67"}, false, 1},
+		{"URL", models.ReceivedAdData{Title: "Диван", Description: "Подробнее на example.com"}, false, 1},
+		{"Cheap car", models.ReceivedAdData{Title: "Машина", Category: "auto", Price: 100}, false, 1},
+		{"Normal car", models.ReceivedAdData{Title: "Машина", Category: "auto", Price: 500000}, true, 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			verdict := moderator.Check(tt.data)
+			if verdict.Approved != tt.approved || len(verdict.Reasons) != tt.reasons {
+				t.Errorf("Check() = %v %v, want approved=%v with %d reasons", verdict.Approved, verdict.Reasons,
+					tt.approved, tt.reasons)
+			}
+		})
+	}
+}
+
+func TestNewAdvertModeratorBadRule(t *testing.T) {
+	t.Parallel()
+
+	if _, err := usecases.NewAdvertModerator(config.ModerationConfig{RegexRules: []string{"("}}); err == nil {
+		t.Errorf("NewAdvertModerator() with broken rule err = nil")
+	}
+}
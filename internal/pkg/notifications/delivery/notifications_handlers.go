@@ -0,0 +1,145 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	notificationsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type NotificationsHandler struct {
+	storage    notificationsusecases.NotificationsStorageInterface
+	broker     *notificationsusecases.Broker
+	authClient authproto.AuthClient
+}
+
+func NewNotificationsHandler(storage notificationsusecases.NotificationsStorageInterface,
+	broker *notificationsusecases.Broker, authClient authproto.AuthClient) *NotificationsHandler {
+	return &NotificationsHandler{
+		storage:    storage,
+		broker:     broker,
+		authClient: authClient,
+	}
+}
+
+// GetNotifications отдаёт уведомления текущего пользователя, новые сверху;
+// следующую страницу просят с before равным id последнего полученного уведомления.
+func (h *NotificationsHandler) GetNotifications(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+	before, _ := strconv.Atoi(request.URL.Query().Get("before"))
+	limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+
+	if before < 0 {
+		before = 0
+	}
+
+	notifications, err := h.storage.GetNotifications(ctx, userID, uint(before),
+		notificationsusecases.NotificationsPageLimit(limit))
+	if err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get notifications for user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(notifications))
+}
+
+func (h *NotificationsHandler) GetUnreadCount(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	unread, err := h.storage.GetUnreadCount(ctx, authcheck.CurrentUserID(request, h.authClient))
+	if err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.NotificationsUnread{Unread: unread}))
+}
+
+func (h *NotificationsHandler) MarkRead(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+	notificationID, _ := strconv.Atoi(mux.Vars(request)["id"])
+
+	if err := h.storage.MarkRead(ctx, userID, uint(notificationID)); err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	h.sendUnreadCount(writer, request, logger, userID)
+}
+
+func (h *NotificationsHandler) MarkAllRead(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	if err := h.storage.MarkAllRead(ctx, userID); err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.NotificationsUnread{}))
+}
+
+// sendUnreadCount отвечает новым числом непрочитанных, чтобы фронтенду не ходить за ним отдельно.
+func (h *NotificationsHandler) sendUnreadCount(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger, userID uint) {
+	unread, err := h.storage.GetUnreadCount(request.Context(), userID)
+	if err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.NotificationsUnread{Unread: unread}))
+}
+
+func sendNotificationError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger,
+	err error) {
+	code, message := responses.StatusInternalServerError, responses.ErrInternalServer
+
+	if errors.Is(err, notificationsusecases.ErrNotificationNotFound) {
+		code, message = responses.StatusNotFound, responses.ErrNotificationNotExist
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
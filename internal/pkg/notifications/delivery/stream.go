@@ -0,0 +1,103 @@
+package delivery
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+const (
+	streamHeartbeatPeriod = 30 * time.Second
+	streamRetryMillis     = 5000
+)
+
+// Stream - поток Server-Sent Events: сначала событие unread с числом непрочитанных,
+// потом notification на каждое новое уведомление. Комментарии-пинги не дают прокси закрыть соединение.
+func (h *NotificationsHandler) Stream(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	controller := http.NewResponseController(writer)
+
+	// WriteTimeout сервера рассчитан на обычные ответы, поток живёт дольше
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	unread, err := h.storage.GetUnreadCount(ctx, userID)
+	if err != nil {
+		sendNotificationError(writer, request, logger, err)
+
+		return
+	}
+
+	sub := h.broker.Subscribe(userID)
+	defer h.broker.Unsubscribe(sub)
+
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Set("Connection", "keep-alive")
+	writer.Header().Set("X-Accel-Buffering", "no")
+	writer.WriteHeader(responses.StatusOk)
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Notification stream opened for user %d", userID), responses.StatusOk)
+
+	unreadData, _ := models.NotificationsUnread{Unread: unread}.MarshalJSON()
+
+	if _, err := fmt.Fprintf(writer, "retry: %d\nevent: unread\ndata: %s\n\n", streamRetryMillis, unreadData); err != nil {
+		return
+	}
+
+	if err := controller.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatPeriod)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(writer, ": ping\n\n")
+		case notification, ok := <-sub.Notifications():
+			if !ok {
+				return
+			}
+
+			err = writeNotificationEvent(writer, notification)
+		}
+
+		if err == nil {
+			err = controller.Flush()
+		}
+
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("notification stream for user %d closed, err=%w", userID, err))
+
+			return
+		}
+	}
+}
+
+func writeNotificationEvent(writer http.ResponseWriter, notification *models.Notification) error {
+	data, err := notification.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer, "id: %d\nevent: notification\ndata: %s\n\n", notification.ID, data)
+
+	return err
+}
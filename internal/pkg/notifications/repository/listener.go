@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+// notificationChannel заполняет триггер publish_notification.
+const notificationChannel = "notification_created"
+
+type createdNotification struct {
+	ID     uint `json:"id"`
+	UserID uint `json:"userId"`
+}
+
+// Listen держит отдельное соединение под LISTEN и отдаёт в publish новые уведомления тех пользователей,
+// которых пропускает wanted. Возвращается только с ошибкой соединения или отменой ctx.
+func (storage *NotificationsStorage) Listen(ctx context.Context, wanted func(userID uint) bool,
+	publish func(notification *models.Notification)) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn, err := storage.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "UNLISTEN *")
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notificationChannel); err != nil {
+		return err
+	}
+
+	for {
+		received, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var created createdNotification
+
+		if err := json.Unmarshal([]byte(received.Payload), &created); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while parsing notification payload, err=%w", err))
+
+			continue
+		}
+
+		if !wanted(created.UserID) {
+			continue
+		}
+
+		notification, err := storage.GetNotification(ctx, created.ID)
+		if err != nil {
+			continue
+		}
+
+		publish(notification)
+	}
+}
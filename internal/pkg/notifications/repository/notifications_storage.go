@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	notificationsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const notificationColumns = `n.id, n.user_id, n.kind, n.advert_id, COALESCE(a.title, ''), n.actor_id, n.is_read,
+	n.created_time`
+
+type NotificationsStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewNotificationsStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *NotificationsStorage {
+	return &NotificationsStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func scanNotification(row pgx.Row) (*models.Notification, error) {
+	notification := models.Notification{}
+
+	err := row.Scan(&notification.ID, &notification.UserID, &notification.Kind, &notification.AdvertID,
+		&notification.AdvertTitle, &notification.ActorID, &notification.IsRead, &notification.CreatedTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (storage *NotificationsStorage) getNotifications(ctx context.Context, tx pgx.Tx, userID, before,
+	limit uint) ([]*models.Notification, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetNotifications := `
+	SELECT ` + notificationColumns + `
+	FROM public.notification n
+	LEFT JOIN public.advert a ON a.id = n.advert_id
+	WHERE n.user_id = $1 AND ($2 = 0 OR n.id < $2)
+	ORDER BY n.id DESC
+	LIMIT $3;`
+
+	logging.LogInfo(logger, "SELECT FROM notification, advert")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetNotifications, userID, before, limit)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting notifications, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	notifications := []*models.Notification{}
+
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning notifications, err=%w", err))
+			storage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning notifications, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (storage *NotificationsStorage) GetNotifications(ctx context.Context, userID, before,
+	limit uint) ([]*models.Notification, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var notifications []*models.Notification
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		notificationsInner, err := storage.getNotifications(ctx, tx, userID, before, limit)
+		notifications = notificationsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting notifications, err=%w", err))
+
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (storage *NotificationsStorage) getNotification(ctx context.Context, tx pgx.Tx,
+	notificationID uint) (*models.Notification, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetNotification := `
+	SELECT ` + notificationColumns + `
+	FROM public.notification n
+	LEFT JOIN public.advert a ON a.id = n.advert_id
+	WHERE n.id = $1;`
+
+	logging.LogInfo(logger, "SELECT FROM notification, advert")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetNotification, notificationID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	notification, err := scanNotification(line)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notificationsusecases.ErrNotificationNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting notification, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func (storage *NotificationsStorage) GetNotification(ctx context.Context,
+	notificationID uint) (*models.Notification, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var notification *models.Notification
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		notificationInner, err := storage.getNotification(ctx, tx, notificationID)
+		notification = notificationInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting notification, err=%w", err))
+
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func (storage *NotificationsStorage) getUnreadCount(ctx context.Context, tx pgx.Tx, userID uint) (uint, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetUnreadCount := `SELECT COUNT(*) FROM public.notification WHERE user_id = $1 AND NOT is_read;`
+
+	logging.LogInfo(logger, "SELECT COUNT FROM notification")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetUnreadCount, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	var unread uint
+
+	if err := line.Scan(&unread); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while counting notifications, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return 0, err
+	}
+
+	return unread, nil
+}
+
+func (storage *NotificationsStorage) GetUnreadCount(ctx context.Context, userID uint) (uint, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var unread uint
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		unreadInner, err := storage.getUnreadCount(ctx, tx, userID)
+		unread = unreadInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting unread count, err=%w", err))
+
+		return 0, err
+	}
+
+	return unread, nil
+}
+
+func (storage *NotificationsStorage) markRead(ctx context.Context, tx pgx.Tx, userID, notificationID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLMarkRead := `UPDATE public.notification SET is_read = TRUE WHERE id = $1 AND user_id = $2;`
+
+	logging.LogInfo(logger, "UPDATE notification")
+
+	start := time.Now()
+
+	tag, err := tx.Exec(ctx, SQLMarkRead, notificationID, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while marking notification read, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return notificationsusecases.ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (storage *NotificationsStorage) MarkRead(ctx context.Context, userID, notificationID uint) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		return storage.markRead(ctx, tx, userID, notificationID)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while marking notification read, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *NotificationsStorage) markAllRead(ctx context.Context, tx pgx.Tx, userID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLMarkAllRead := `UPDATE public.notification SET is_read = TRUE WHERE user_id = $1 AND NOT is_read;`
+
+	logging.LogInfo(logger, "UPDATE notification")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLMarkAllRead, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while marking notifications read, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *NotificationsStorage) MarkAllRead(ctx context.Context, userID uint) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		return storage.markAllRead(ctx, tx, userID)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while marking notifications read, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *NotificationsStorage) createNotification(ctx context.Context, tx pgx.Tx,
+	data *models.NewNotification) (*models.Notification, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLCreateNotification := `
+	INSERT INTO public.notification (user_id, kind, advert_id, actor_id)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, is_read, created_time;`
+
+	logging.LogInfo(logger, "INSERT INTO notification")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLCreateNotification, data.UserID, data.Kind, data.AdvertID, data.ActorID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	notification := models.Notification{
+		UserID:   data.UserID,
+		Kind:     data.Kind,
+		AdvertID: data.AdvertID,
+		ActorID:  data.ActorID,
+	}
+
+	if err := line.Scan(&notification.ID, &notification.IsRead, &notification.CreatedTime); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting notification, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (storage *NotificationsStorage) CreateNotification(ctx context.Context,
+	data *models.NewNotification) (*models.Notification, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var notification *models.Notification
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		notificationInner, err := storage.createNotification(ctx, tx, data)
+		notification = notificationInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while creating notification, err=%w", err))
+
+		return nil, err
+	}
+
+	return notification, nil
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"sync"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+const subscriptionBuffer = 16
+
+// Subscription - один открытый SSE-поток пользователя.
+type Subscription struct {
+	userID        uint
+	notifications chan *models.Notification
+	once          sync.Once
+}
+
+func (sub *Subscription) Notifications() <-chan *models.Notification {
+	return sub.notifications
+}
+
+// Broker раздаёт новые уведомления открытым потокам в пределах одного процесса.
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[uint]map[*Subscription]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: make(map[uint]map[*Subscription]struct{}),
+	}
+}
+
+func (broker *Broker) Subscribe(userID uint) *Subscription {
+	sub := &Subscription{
+		userID:        userID,
+		notifications: make(chan *models.Notification, subscriptionBuffer),
+	}
+
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	if broker.subscribers[userID] == nil {
+		broker.subscribers[userID] = make(map[*Subscription]struct{})
+	}
+
+	broker.subscribers[userID][sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe закрывает канал потока; повторный вызов ничего не делает.
+func (broker *Broker) Unsubscribe(sub *Subscription) {
+	sub.once.Do(func() {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+
+		delete(broker.subscribers[sub.userID], sub)
+
+		if len(broker.subscribers[sub.userID]) == 0 {
+			delete(broker.subscribers, sub.userID)
+		}
+
+		close(sub.notifications)
+	})
+}
+
+// Publish не ждёт медленных клиентов: переполненный поток пропустит уведомление и увидит его в списке.
+func (broker *Broker) Publish(notification *models.Notification) {
+	broker.mu.RLock()
+	defer broker.mu.RUnlock()
+
+	for sub := range broker.subscribers[notification.UserID] {
+		select {
+		case sub.notifications <- notification:
+		default:
+		}
+	}
+}
+
+// HasSubscribers позволяет слушателю не ходить в базу за уведомлениями тех, кто сейчас не подключён.
+func (broker *Broker) HasSubscribers(userID uint) bool {
+	broker.mu.RLock()
+	defer broker.mu.RUnlock()
+
+	return len(broker.subscribers[userID]) > 0
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+const (
+	DefaultNotificationsPage = 50
+	MaxNotificationsPage     = 100
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrNotificationNotValid = errors.New("notification is not valid")
+)
+
+// knownKinds - типы, которые умеет показывать фронтенд; advert_sold, advert_closed и payment_succeeded
+// создаются триггерами в базе.
+var knownKinds = map[string]struct{}{
+	models.NotificationAdvertSold:       {},
+	models.NotificationAdvertClosed:     {},
+	models.NotificationOrderCreated:     {},
+	models.NotificationPaymentSucceeded: {},
+}
+
+type NotificationsStorageInterface interface {
+	// GetNotifications отдаёт limit уведомлений старше before (0 - с самого нового), новые сверху.
+	GetNotifications(ctx context.Context, userID, before, limit uint) ([]*models.Notification, error)
+	GetNotification(ctx context.Context, notificationID uint) (*models.Notification, error)
+	GetUnreadCount(ctx context.Context, userID uint) (uint, error)
+	MarkRead(ctx context.Context, userID, notificationID uint) error
+	MarkAllRead(ctx context.Context, userID uint) error
+	CreateNotification(ctx context.Context, notification *models.NewNotification) (*models.Notification, error)
+}
+
+func ValidateNotification(notification *models.NewNotification) error {
+	if notification == nil || notification.UserID == 0 {
+		return ErrNotificationNotValid
+	}
+
+	if _, ok := knownKinds[notification.Kind]; !ok {
+		return ErrNotificationNotValid
+	}
+
+	return nil
+}
+
+// NotificationsPageLimit приводит размер страницы к допустимому.
+func NotificationsPageLimit(limit int) uint {
+	return utils.PageLimit(limit, DefaultNotificationsPage, MaxNotificationsPage)
+}
+
+// Notifier - точка входа для пакетов, которым нужно что-то сообщить пользователю.
+// Уведомление сохраняется в базе, а в открытые потоки его доставляет слушатель NOTIFY,
+// поэтому оно дойдёт до пользователя, к какому бы экземпляру сервера он ни был подключён.
+type Notifier struct {
+	storage NotificationsStorageInterface
+}
+
+func NewNotifier(storage NotificationsStorageInterface) *Notifier {
+	return &Notifier{
+		storage: storage,
+	}
+}
+
+func (notifier *Notifier) Notify(ctx context.Context, notification *models.NewNotification) error {
+	if err := ValidateNotification(notification); err != nil {
+		return err
+	}
+
+	_, err := notifier.storage.CreateNotification(ctx, notification)
+
+	return err
+}
@@ -0,0 +1,138 @@
+//nolint:all
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
+)
+
+type storageStub struct {
+	usecases.NotificationsStorageInterface
+
+	created []*models.NewNotification
+}
+
+func (s *storageStub) CreateNotification(_ context.Context,
+	notification *models.NewNotification) (*models.Notification, error) {
+	s.created = append(s.created, notification)
+
+	return &models.Notification{ID: uint(len(s.created)), UserID: notification.UserID, Kind: notification.Kind}, nil
+}
+
+func TestNotifierNotify(t *testing.T) {
+	t.Parallel()
+
+	advertID := uint(7)
+
+	tests := []struct {
+		name         string
+		notification *models.NewNotification
+		wantErr      error
+	}{
+		{name: "order created", notification: &models.NewNotification{UserID: 1,
+			Kind: models.NotificationOrderCreated, AdvertID: &advertID}},
+		{name: "unknown kind", notification: &models.NewNotification{UserID: 1, Kind: "spam"},
+			wantErr: usecases.ErrNotificationNotValid},
+		{name: "no recipient", notification: &models.NewNotification{Kind: models.NotificationOrderCreated},
+			wantErr: usecases.ErrNotificationNotValid},
+		{name: "nil", wantErr: usecases.ErrNotificationNotValid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := &storageStub{}
+
+			err := usecases.NewNotifier(storage).Notify(context.Background(), tt.notification)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Notify() error = %v, want %v", err, tt.wantErr)
+			}
+
+			wantCreated := 0
+			if tt.wantErr == nil {
+				wantCreated = 1
+			}
+
+			if len(storage.created) != wantCreated {
+				t.Errorf("Notify() stored %d notifications, want %d", len(storage.created), wantCreated)
+			}
+		})
+	}
+}
+
+func TestNotificationsPageLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input int
+		want  uint
+	}{
+		{input: 0, want: usecases.DefaultNotificationsPage},
+		{input: -1, want: usecases.DefaultNotificationsPage},
+		{input: 10, want: 10},
+		{input: 500, want: usecases.MaxNotificationsPage},
+	}
+
+	for _, tt := range tests {
+		if got := usecases.NotificationsPageLimit(tt.input); got != tt.want {
+			t.Errorf("NotificationsPageLimit(%d) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestBroker(t *testing.T) {
+	t.Parallel()
+
+	broker := usecases.NewBroker()
+
+	sub := broker.Subscribe(1)
+	other := broker.Subscribe(2)
+
+	if !broker.HasSubscribers(1) || broker.HasSubscribers(3) {
+		t.Fatalf("HasSubscribers() does not match subscriptions")
+	}
+
+	notification := &models.Notification{ID: 10, UserID: 1, Kind: models.NotificationOrderCreated}
+	broker.Publish(notification)
+
+	select {
+	case got := <-sub.Notifications():
+		if got != notification {
+			t.Errorf("Publish() delivered %+v, want %+v", got, notification)
+		}
+	default:
+		t.Errorf("Publish() did not deliver notification")
+	}
+
+	select {
+	case got := <-other.Notifications():
+		t.Errorf("Publish() delivered %+v to another user", got)
+	default:
+	}
+
+	for i := 0; i < 100; i++ {
+		broker.Publish(notification)
+	}
+
+	broker.Unsubscribe(sub)
+	broker.Unsubscribe(sub)
+
+	if broker.HasSubscribers(1) {
+		t.Errorf("HasSubscribers() after Unsubscribe = true")
+	}
+
+	received := 0
+	for range sub.Notifications() {
+		received++
+	}
+
+	if received == 0 || received >= 100 {
+		t.Errorf("slow subscriber received %d notifications, want a full buffer", received)
+	}
+}
@@ -1,12 +1,16 @@
 package delivery
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"time"
 
 	advertusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases"
+	profileproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/profile/delivery/protobuf"
 	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
@@ -16,24 +20,40 @@ import (
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
 
 	cartusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/usecases"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	notificationsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
 	orderusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/usecases"
 )
 
+var errAdvertNotInCart = errors.New("advert is not available in the cart")
+
 type OrderHandler struct {
-	storage       orderusecases.OrderStorageInterface
-	cartStorage   cartusecases.CartStorageInterface
-	authClient    authproto.AuthClient
-	advertStorage advertusecases.AdvertsStorageInterface
+	storage         orderusecases.OrderStorageInterface
+	cartStorage     cartusecases.CartStorageInterface
+	authClient      authproto.AuthClient
+	profileClient   profileproto.ProfileClient
+	advertStorage   advertusecases.AdvertsStorageInterface
+	deliveryPricing *cartusecases.DeliveryPricing
+	reservationTTL  time.Duration
+	notifier        *notificationsusecases.Notifier
+	emailer         *emailsusecases.Emailer
 }
 
 func NewOrderHandler(storage orderusecases.OrderStorageInterface, cartStorage cartusecases.CartStorageInterface,
-	authClient authproto.AuthClient,
-	advertStorage advertusecases.AdvertsStorageInterface) *OrderHandler {
+	authClient authproto.AuthClient, profileClient profileproto.ProfileClient,
+	advertStorage advertusecases.AdvertsStorageInterface, deliveryPricing *cartusecases.DeliveryPricing,
+	reservationTTL time.Duration, notifier *notificationsusecases.Notifier,
+	emailer *emailsusecases.Emailer) *OrderHandler {
 	return &OrderHandler{
-		storage:       storage,
-		cartStorage:   cartStorage,
-		advertStorage: advertStorage,
-		authClient:    authClient,
+		storage:         storage,
+		cartStorage:     cartStorage,
+		advertStorage:   advertStorage,
+		authClient:      authClient,
+		profileClient:   profileClient,
+		deliveryPricing: deliveryPricing,
+		reservationTTL:  reservationTTL,
+		notifier:        notifier,
+		emailer:         emailer,
 	}
 }
 
@@ -115,30 +135,226 @@ func (orderHandler *OrderHandler) CreateOrder(writer http.ResponseWriter, reques
 		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
 		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
 			responses.ErrInternalServer))
+
+		return
 	}
 
 	session, _ := request.Cookie("session_id")
 
 	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
 
-	for _, receivedOrderItem := range data.Adverts {
-		isDeleted := cartStorage.DeleteAdvByIDs(ctx, uint(user.ID), receivedOrderItem.AdvertID)
+	if err := orderHandler.priceDelivery(ctx, user.ID, data.Adverts); err != nil {
+		sendOrderError(writer, request, logger, err)
+
+		return
+	}
 
-		if isDeleted != nil {
-			log.Println("Can not create an order", receivedOrderItem.AdvertID, "for user", user.ID)
-			logging.LogHandlerInfo(logger, fmt.Sprintf("Can not create an order %s for user %s",
-				fmt.Sprint(receivedOrderItem.AdvertID), fmt.Sprint(user.ID)),
-				responses.StatusInternalServerError)
-			responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
-				responses.ErrInternalServer))
+	if err := storage.CreateOrders(ctx, uint(user.ID), data.Adverts); err != nil {
+		sendOrderError(writer, request, logger, err)
 
-			return
+		return
+	}
+
+	// заказы уже оформлены, так что ошибка здесь лишь оставит объявление в корзине
+	for _, receivedOrderItem := range data.Adverts {
+		if err := cartStorage.DeleteAdvByIDs(ctx, uint(user.ID), receivedOrderItem.AdvertID); err != nil {
+			logging.LogHandlerError(logger, err, responses.StatusOk)
 		}
 
-		_ = storage.CreateOrderByID(ctx, uint(user.ID), receivedOrderItem)
 		log.Println("An order", receivedOrderItem.AdvertID, "for user", user.ID, "successfully created")
+
+		orderHandler.notifySeller(ctx, logger, uint(user.ID), receivedOrderItem)
+	}
+
+	if err := orderHandler.emailer.OrderPlaced(ctx, uint(user.ID), data.Adverts); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sending order email, err=%w", err))
 	}
 
 	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
 	responses.SendOkResponse(writer, responses.NewOkResponse(models.OrderCreated{IsCreated: true}))
 }
+
+// ReserveAdverts бронирует объявления за покупателем на время оформления заказа.
+func (orderHandler *OrderHandler) ReserveAdverts(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var data models.ReceivedReservation
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil || len(data.AdvertIDs) == 0 {
+		log.Println(err, responses.StatusBadRequest)
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := orderHandler.authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	reservations, err := orderHandler.storage.ReserveAdverts(ctx, uint(user.ID), data.AdvertIDs,
+		time.Now().Add(orderHandler.reservationTTL))
+	if err != nil {
+		sendOrderError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Adverts %v reserved for user %d", data.AdvertIDs, user.ID),
+		responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(reservations))
+}
+
+// ChangeOrderStatus - продавец переводит заказ по своему объявлению в следующий статус, покупатель
+// получает письмо.
+func (orderHandler *OrderHandler) ChangeOrderStatus(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var data models.ReceivedOrderStatus
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil || data.OrderID == 0 {
+		log.Println(err, responses.StatusBadRequest)
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	session, _ := request.Cookie("session_id")
+
+	user, _ := orderHandler.authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
+
+	order, err := orderHandler.storage.UpdateOrderStatus(ctx, uint(user.ID), data.OrderID, data.Status)
+	if err != nil {
+		sendOrderError(writer, request, logger, err)
+
+		return
+	}
+
+	// статус уже сменён, письмо не должно откатывать переход
+	if err := orderHandler.emailer.OrderStatusChanged(ctx, order.UserID, order.AdvertID, order.Status); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sending order status email, err=%w", err))
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Order %d moved to %s", order.ID, order.Status), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(order))
+}
+
+func sendOrderError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger, err error) {
+	code, message := responses.StatusInternalServerError, responses.ErrInternalServer
+
+	switch {
+	case errors.Is(err, errAdvertNotInCart), errors.Is(err, orderusecases.ErrAdvertNotActive):
+		code, message = responses.StatusConflict, responses.ErrAdvertUnavailable
+	case errors.Is(err, orderusecases.ErrAdvertReserved):
+		code, message = responses.StatusConflict, responses.ErrAdvertReserved
+	case errors.Is(err, orderusecases.ErrReservationLost):
+		code, message = responses.StatusConflict, responses.ErrReservationLost
+	case errors.Is(err, orderusecases.ErrOrderNotFound):
+		code, message = responses.StatusNotFound, responses.ErrOrderNotExist
+	case errors.Is(err, orderusecases.ErrWrongOrderStatus):
+		code, message = responses.StatusConflict, responses.ErrOrderStatus
+	case errors.Is(err, cartusecases.ErrDeliveryMethod):
+		code, message = responses.StatusBadRequest, responses.ErrDeliveryNotValid
+	}
+
+	log.Println(err, code)
+	logging.LogHandlerError(logger, err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
+
+// priceDelivery проставляет заказам способ и цену доставки, посчитанные сервером. Доставка берётся
+// один раз за продавца: её цена уходит в первый заказ группы, остальные заказы продавца идут с нулём.
+// Заказать можно только доступное объявление из своей корзины.
+func (orderHandler *OrderHandler) priceDelivery(ctx context.Context, userID uint64,
+	items []*models.ReceivedOrderItem) error {
+	cart, err := orderHandler.cartStorage.GetCartByUserID(ctx, uint(userID))
+	if err != nil {
+		return err
+	}
+
+	inCart := make(map[uint]*models.ReturningAdvert, len(cart))
+
+	for _, advert := range cart {
+		inCart[advert.Advert.ID] = advert
+	}
+
+	ordered := make([]*models.ReturningAdvert, 0, len(items))
+	methods := make(map[uint]string)
+
+	for _, item := range items {
+		advert, ok := inCart[item.AdvertID]
+		if !ok || !advert.Advert.Active {
+			return fmt.Errorf("%w: advert %d", errAdvertNotInCart, item.AdvertID)
+		}
+
+		ordered = append(ordered, advert)
+
+		if _, ok := methods[advert.Advert.UserID]; !ok {
+			methods[advert.Advert.UserID] = item.DeliveryMethod
+		}
+	}
+
+	content := cartusecases.NewCartContent(ordered)
+
+	var buyerCityID uint
+
+	profile, err := orderHandler.profileClient.GetProfile(ctx, &profileproto.ProfileIDRequest{ID: userID})
+	if err == nil {
+		buyerCityID = uint(profile.CityID)
+	}
+
+	if err := orderHandler.deliveryPricing.Apply(ctx, content, buyerCityID, methods); err != nil {
+		return err
+	}
+
+	groups := make(map[uint]*models.CartSellerGroup, len(content.Sellers))
+
+	for _, group := range content.Sellers {
+		groups[group.SellerID] = group
+	}
+
+	charged := make(map[uint]bool, len(groups))
+
+	for _, item := range items {
+		sellerID := inCart[item.AdvertID].Advert.UserID
+		group := groups[sellerID]
+
+		item.SellerID = sellerID
+		item.Title = inCart[item.AdvertID].Advert.Title
+		item.Price = inCart[item.AdvertID].Advert.Price
+		item.DeliveryMethod = group.DeliveryMethod
+		item.DeliveryPrice = 0
+
+		if !charged[sellerID] {
+			item.DeliveryPrice = group.DeliveryPrice
+			charged[sellerID] = true
+		}
+	}
+
+	return nil
+}
+
+// notifySeller сообщает продавцу о новом заказе; заказ уже оформлен, поэтому ошибка только логируется.
+func (orderHandler *OrderHandler) notifySeller(ctx context.Context, logger *zap.SugaredLogger, buyerID uint,
+	item *models.ReceivedOrderItem) {
+	advertID := item.AdvertID
+
+	err := orderHandler.notifier.Notify(ctx, &models.NewNotification{
+		UserID:   item.SellerID,
+		Kind:     models.NotificationOrderCreated,
+		AdvertID: &advertID,
+		ActorID:  &buyerID,
+	})
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while notifying seller, err=%w", err))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
 	"github.com/jackc/pgx/v5"
@@ -18,12 +19,14 @@ import (
 type OrderStorage struct {
 	pool    *pgxpool.Pool
 	metrics *mymetrics.DatabaseMetrics
+	blobs   blobstore.BlobStore
 }
 
-func NewOrderStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *OrderStorage {
+func NewOrderStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics, blobs blobstore.BlobStore) *OrderStorage {
 	return &OrderStorage{
 		pool:    pool,
 		metrics: metrics,
+		blobs:   blobs,
 	}
 }
 
@@ -43,6 +46,7 @@ func (ol *OrderStorage) getBoughtOrdersByUserID(ctx context.Context, tx pgx.Tx,
 		ord.name AS order_name, 
 		ord.email AS order_email, 
 		ord.delivery_price AS order_delivery_price,
+		ord.delivery_method AS order_delivery_method,
 		ord.delivery_address AS order_delivery_address,
 		a.id AS advert_id, 
 		a.user_id,
@@ -58,7 +62,7 @@ func (ol *OrderStorage) getBoughtOrdersByUserID(ctx context.Context, tx pgx.Tx,
 		a.created_time, 
 		a.closed_time, 
 		a.is_used,
-		(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY id LIMIT 1) AS first_image_url	
+		(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY position, id LIMIT 1) AS first_image_url	
 	FROM 
 		public.advert a
 	LEFT JOIN 
@@ -97,7 +101,8 @@ func (ol *OrderStorage) getBoughtOrdersByUserID(ctx context.Context, tx pgx.Tx,
 		orderItem := models.OrderItem{}
 
 		if err := rows.Scan(&orderItem.ID, &orderItem.Status, &orderItem.Created, &orderItem.Updated, &orderItem.Closed,
-			&orderItem.Phone, &orderItem.Name, &orderItem.Email, &orderItem.DeliveryPrice, &orderItem.Address,
+			&orderItem.Phone, &orderItem.Name, &orderItem.Email, &orderItem.DeliveryPrice,
+			&orderItem.DeliveryMethod, &orderItem.Address,
 			&advertModel.ID, &advertModel.UserID, &cityModel.ID, &cityModel.CityName, &cityModel.Translation,
 			&categoryModel.ID, &categoryModel.Name, &categoryModel.Translation, &advertModel.Title,
 			&advertModel.Description, &advertModel.Price, &advertModel.CreatedTime, &advertModel.ClosedTime,
@@ -122,16 +127,7 @@ func (ol *OrderStorage) getBoughtOrdersByUserID(ctx context.Context, tx pgx.Tx,
 			Category: categoryModel,
 		}
 
-		decodedImage, err := utils.DecodeImage(photoURLToInsert)
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		returningAdvert.Photos = append(returningAdvert.Photos, photoURLToInsert)
-		returningAdvert.PhotosIMG = append(returningAdvert.PhotosIMG, decodedImage)
+		returningAdvert.Photos, returningAdvert.PhotosIMG = utils.MediaImages(ctx, ol.blobs, []string{photoURLToInsert})
 
 		ReturningOrder := models.ReturningOrder{
 			OrderItem:       orderItem,
@@ -185,6 +181,7 @@ func (ol *OrderStorage) getSoldOrdersByUserID(ctx context.Context, tx pgx.Tx,
 		ord.name AS order_name, 
 		ord.email AS order_email, 
 		ord.delivery_price AS order_delivery_price,
+		ord.delivery_method AS order_delivery_method,
 		ord.delivery_address AS order_delivery_address,
 		a.id AS advert_id, 
 		a.user_id,
@@ -200,7 +197,7 @@ func (ol *OrderStorage) getSoldOrdersByUserID(ctx context.Context, tx pgx.Tx,
 		a.created_time, 
 		a.closed_time, 
 		a.is_used,
-		(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY id LIMIT 1) AS first_image_url	
+		(SELECT url FROM advert_image WHERE advert_id = a.id ORDER BY position, id LIMIT 1) AS first_image_url	
 	FROM 
 		public.advert a
 	LEFT JOIN 
@@ -239,7 +236,8 @@ func (ol *OrderStorage) getSoldOrdersByUserID(ctx context.Context, tx pgx.Tx,
 		orderItem := models.OrderItem{}
 
 		if err := rows.Scan(&orderItem.ID, &orderItem.Status, &orderItem.Created, &orderItem.Updated, &orderItem.Closed,
-			&orderItem.Phone, &orderItem.Name, &orderItem.Email, &orderItem.DeliveryPrice, &orderItem.Address,
+			&orderItem.Phone, &orderItem.Name, &orderItem.Email, &orderItem.DeliveryPrice,
+			&orderItem.DeliveryMethod, &orderItem.Address,
 			&advertModel.ID, &advertModel.UserID, &cityModel.ID, &cityModel.CityName, &cityModel.Translation,
 			&categoryModel.ID, &categoryModel.Name, &categoryModel.Translation, &advertModel.Title,
 			&advertModel.Description, &advertModel.Price, &advertModel.CreatedTime, &advertModel.ClosedTime,
@@ -263,16 +261,7 @@ func (ol *OrderStorage) getSoldOrdersByUserID(ctx context.Context, tx pgx.Tx,
 			Category: categoryModel,
 		}
 
-		decodedImage, err := utils.DecodeImage(photoURLToInsert)
-
-		if err != nil {
-			logging.LogError(logger, fmt.Errorf("something went wrong while decoding image, err=%w", err))
-
-			return nil, err
-		}
-
-		returningAdvert.Photos = append(returningAdvert.Photos, photoURLToInsert)
-		returningAdvert.PhotosIMG = append(returningAdvert.PhotosIMG, decodedImage)
+		returningAdvert.Photos, returningAdvert.PhotosIMG = utils.MediaImages(ctx, ol.blobs, []string{photoURLToInsert})
 
 		ReturningOrder := models.ReturningOrder{
 			OrderItem:       orderItem,
@@ -317,8 +306,9 @@ func (ol *OrderStorage) createOrderByID(ctx context.Context, tx pgx.Tx, userID u
 
 	SQLCreateOrder :=
 		`INSERT INTO public."order"(
-			user_id, advert_id, order_status, phone, name, surname, patronymic, email, delivery_price, delivery_address)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
+			user_id, advert_id, order_status, phone, name, surname, patronymic, email, delivery_price, delivery_address,
+			delivery_method)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`
 
 	logging.LogInfo(logger, "INSERT INTO user")
 
@@ -333,7 +323,7 @@ func (ol *OrderStorage) createOrderByID(ctx context.Context, tx pgx.Tx, userID u
 	start := time.Now()
 
 	_, err = tx.Exec(ctx, SQLCreateOrder, userID, data.AdvertID, paidStatus, data.Phone, data.Name, surnamePlug,
-		patronymicPlug, data.Email, data.DeliveryPrice, data.DeliveryAddress)
+		patronymicPlug, data.Email, data.DeliveryPrice, data.DeliveryAddress, data.DeliveryMethod)
 
 	ol.metrics.AddDuration(funcName, time.Since(start))
 
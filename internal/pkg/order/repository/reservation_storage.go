@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	orderusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/usecases"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// lockAdvert блокирует строку объявления до конца транзакции, чтобы два покупателя не прошли проверки разом,
+// и возвращает её статус вместе с текущей бронью.
+func (ol *OrderStorage) lockAdvert(ctx context.Context, tx pgx.Tx, advertID uint) (*orderusecases.AdvertHold, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLLockAdvert := `SELECT a.advert_status, COALESCE(r.user_id, 0), COALESCE(r.reserved_until > NOW(), false)
+		FROM public.advert a
+		LEFT JOIN public.advert_reservation r ON r.advert_id = a.id
+		WHERE a.id = $1
+		FOR UPDATE OF a;`
+
+	logging.LogInfo(logger, "SELECT FROM advert, advert_reservation FOR UPDATE")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLLockAdvert, advertID)
+
+	ol.metrics.AddDuration(funcName, time.Since(start))
+
+	var hold orderusecases.AdvertHold
+
+	err := line.Scan(&hold.Status, &hold.HolderID, &hold.ReservationActive)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, orderusecases.ErrAdvertNotActive
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while locking advert, err=%w", err))
+		ol.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+func (ol *OrderStorage) reserveAdvert(ctx context.Context, tx pgx.Tx, userID, advertID uint,
+	until time.Time) (*models.Reservation, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	hold, err := ol.lockAdvert(ctx, tx, advertID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = orderusecases.CheckReserve(hold, userID); err != nil {
+		return nil, fmt.Errorf("%w: advert %d", err, advertID)
+	}
+
+	SQLReserveAdvert := `
+	INSERT INTO public.advert_reservation (advert_id, user_id, reserved_until)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (advert_id) DO UPDATE
+	SET user_id = EXCLUDED.user_id, reserved_until = EXCLUDED.reserved_until
+	RETURNING reserved_until;`
+
+	logging.LogInfo(logger, "INSERT INTO advert_reservation")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLReserveAdvert, advertID, userID, until)
+
+	ol.metrics.AddDuration(funcName, time.Since(start))
+
+	reservation := models.Reservation{AdvertID: advertID}
+
+	if err = line.Scan(&reservation.ReservedUntil); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while reserving advert, err=%w", err))
+		ol.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func (ol *OrderStorage) ReserveAdverts(ctx context.Context, userID uint, advertIDs []uint,
+	until time.Time) ([]*models.Reservation, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	// блокируем объявления всегда в одном порядке, иначе встречные брони могут зайти в дедлок
+	sorted := slices.Clone(advertIDs)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	reservations := make([]*models.Reservation, 0, len(sorted))
+
+	err := pgx.BeginFunc(ctx, ol.pool, func(tx pgx.Tx) error {
+		reservations = reservations[:0]
+
+		for _, advertID := range sorted {
+			reservation, err := ol.reserveAdvert(ctx, tx, userID, advertID, until)
+			if err != nil {
+				return err
+			}
+
+			reservations = append(reservations, reservation)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while reserving adverts, err=%w", err))
+
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// takeReservation проверяет, что объявление всё ещё можно купить этому покупателю, и снимает бронь.
+func (ol *OrderStorage) takeReservation(ctx context.Context, tx pgx.Tx, userID, advertID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	hold, err := ol.lockAdvert(ctx, tx, advertID)
+	if err != nil {
+		return err
+	}
+
+	if err = orderusecases.CheckTake(hold, userID); err != nil {
+		return fmt.Errorf("%w: advert %d", err, advertID)
+	}
+
+	if hold.HolderID == 0 {
+		return nil
+	}
+
+	SQLTakeReservation := `DELETE FROM public.advert_reservation WHERE advert_id = $1;`
+
+	logging.LogInfo(logger, "DELETE FROM advert_reservation")
+
+	start := time.Now()
+
+	_, err = tx.Exec(ctx, SQLTakeReservation, advertID)
+
+	ol.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while taking reservation, err=%w", err))
+		ol.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (ol *OrderStorage) CreateOrders(ctx context.Context, userID uint, items []*models.ReceivedOrderItem) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	sorted := slices.Clone(items)
+	slices.SortFunc(sorted, func(a, b *models.ReceivedOrderItem) int {
+		return cmp.Compare(a.AdvertID, b.AdvertID)
+	})
+
+	err := pgx.BeginFunc(ctx, ol.pool, func(tx pgx.Tx) error {
+		for _, item := range sorted {
+			if err := ol.takeReservation(ctx, tx, userID, item.AdvertID); err != nil {
+				return err
+			}
+
+			if err := ol.createOrderByID(ctx, tx, userID, item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while creating orders, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
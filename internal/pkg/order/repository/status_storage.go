@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	orderusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/usecases"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// lockSellerOrder блокирует заказ по объявлению продавца sellerID; чужой заказ не отличается от несуществующего.
+func (ol *OrderStorage) lockSellerOrder(ctx context.Context, tx pgx.Tx, sellerID,
+	orderID uint) (*models.OrderItem, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLLockOrder := `SELECT ord.id, ord.user_id, ord.advert_id, ord.order_status
+		FROM public."order" ord
+		INNER JOIN public.advert a ON a.id = ord.advert_id
+		WHERE ord.id = $1 AND a.user_id = $2
+		FOR UPDATE OF ord;`
+
+	logging.LogInfo(logger, "SELECT FROM order, advert FOR UPDATE")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLLockOrder, orderID, sellerID)
+
+	ol.metrics.AddDuration(funcName, time.Since(start))
+
+	var order models.OrderItem
+
+	err := line.Scan(&order.ID, &order.UserID, &order.AdvertID, &order.Status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, orderusecases.ErrOrderNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while locking order, err=%w", err))
+		ol.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (ol *OrderStorage) setOrderStatus(ctx context.Context, tx pgx.Tx, orderID uint, status string) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetOrderStatus := `UPDATE public."order" SET order_status = $1, updated_time = NOW() WHERE id = $2;`
+
+	logging.LogInfo(logger, "UPDATE order")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLSetOrderStatus, status, orderID)
+
+	ol.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while updating order status, err=%w", err))
+		ol.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (ol *OrderStorage) UpdateOrderStatus(ctx context.Context, sellerID, orderID uint,
+	status string) (*models.OrderItem, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var order *models.OrderItem
+
+	err := pgx.BeginFunc(ctx, ol.pool, func(tx pgx.Tx) error {
+		orderInner, err := ol.lockSellerOrder(ctx, tx, sellerID, orderID)
+		if err != nil {
+			return err
+		}
+
+		if err = orderusecases.CheckStatusTransition(orderInner.Status, status); err != nil {
+			return err
+		}
+
+		if err = ol.setOrderStatus(ctx, tx, orderID, status); err != nil {
+			return err
+		}
+
+		orderInner.Status = status
+		order = orderInner
+
+		return nil
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while changing order status, err=%w", err))
+
+		return nil, err
+	}
+
+	return order, nil
+}
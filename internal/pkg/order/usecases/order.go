@@ -2,12 +2,26 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 )
 
+var (
+	ErrAdvertReserved  = errors.New("advert is reserved by another buyer")
+	ErrAdvertNotActive = errors.New("advert is not for sale")
+	ErrReservationLost = errors.New("reservation is lost: advert is sold or reserved by another buyer")
+)
+
 type OrderStorageInterface interface {
 	CreateOrderByID(ctx context.Context, userID uint, data *models.ReceivedOrderItem) error
 	GetBoughtOrdersByUserID(ctx context.Context, userID uint) ([]*models.ReturningOrder, error)
 	GetSoldOrdersByUserID(ctx context.Context, userID uint) ([]*models.ReturningOrder, error)
+	// ReserveAdverts бронирует объявления за покупателем до until; повторная бронь своим покупателем продлевает её.
+	ReserveAdverts(ctx context.Context, userID uint, advertIDs []uint, until time.Time) ([]*models.Reservation, error)
+	// CreateOrders оформляет заказы одной транзакцией: либо все, либо ни одного.
+	CreateOrders(ctx context.Context, userID uint, items []*models.ReceivedOrderItem) error
+	// UpdateOrderStatus переводит заказ продавца sellerID в status и возвращает его с покупателем и объявлением.
+	UpdateOrderStatus(ctx context.Context, sellerID, orderID uint, status string) (*models.OrderItem, error)
 }
@@ -0,0 +1,39 @@
+package usecases
+
+const activeStatus = "Активно"
+
+// AdvertHold - объявление под блокировкой строки: статус и бронь, если она есть. HolderID равен 0,
+// когда брони нет; ReservationActive - срок брони ещё не вышел.
+type AdvertHold struct {
+	Status            string
+	HolderID          uint
+	ReservationActive bool
+}
+
+func (hold *AdvertHold) heldByOther(userID uint) bool {
+	return hold.HolderID != 0 && hold.HolderID != userID && hold.ReservationActive
+}
+
+// CheckReserve - можно ли забронировать объявление: оно продаётся и на нём нет чужой действующей брони.
+// Своя бронь и истёкшая чужая перезаписываются.
+func CheckReserve(hold *AdvertHold, userID uint) error {
+	if hold.Status != activeStatus {
+		return ErrAdvertNotActive
+	}
+
+	if hold.heldByOther(userID) {
+		return ErrAdvertReserved
+	}
+
+	return nil
+}
+
+// CheckTake - можно ли оформить заказ. Без брони заказ проходит, если объявление свободно:
+// блокировка строки не даст продать его дважды.
+func CheckTake(hold *AdvertHold, userID uint) error {
+	if hold.Status != activeStatus || hold.heldByOther(userID) {
+		return ErrReservationLost
+	}
+
+	return nil
+}
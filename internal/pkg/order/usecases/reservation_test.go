@@ -0,0 +1,81 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/usecases"
+)
+
+const (
+	buyerID = 7
+	otherID = 9
+)
+
+func TestCheckReserve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		hold usecases.AdvertHold
+		want error
+	}{
+		{name: "free advert", hold: usecases.AdvertHold{Status: "Активно"}},
+		{name: "own reservation is extended",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: buyerID, ReservationActive: true}},
+		{name: "expired reservation of another buyer",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: otherID}},
+		{name: "reserved by another buyer",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: otherID, ReservationActive: true},
+			want: usecases.ErrAdvertReserved},
+		{name: "sold", hold: usecases.AdvertHold{Status: "Продано"}, want: usecases.ErrAdvertNotActive},
+		{name: "on moderation", hold: usecases.AdvertHold{Status: "На модерации"}, want: usecases.ErrAdvertNotActive},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.CheckReserve(&tt.hold, buyerID); !errors.Is(err, tt.want) {
+				t.Errorf("CheckReserve() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckTake(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		hold usecases.AdvertHold
+		want error
+	}{
+		{name: "free advert without reservation", hold: usecases.AdvertHold{Status: "Активно"}},
+		{name: "own reservation",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: buyerID, ReservationActive: true}},
+		{name: "own expired reservation, nobody else came",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: buyerID}},
+		{name: "expired reservation of another buyer",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: otherID}},
+		{name: "reserved by another buyer",
+			hold: usecases.AdvertHold{Status: "Активно", HolderID: otherID, ReservationActive: true},
+			want: usecases.ErrReservationLost},
+		{name: "sold while reserved",
+			hold: usecases.AdvertHold{Status: "Продано", HolderID: buyerID, ReservationActive: true},
+			want: usecases.ErrReservationLost},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.CheckTake(&tt.hold, buyerID); !errors.Is(err, tt.want) {
+				t.Errorf("CheckTake() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
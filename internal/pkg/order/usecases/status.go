@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+)
+
+const (
+	OrderPaid     = "Оплачено"
+	OrderShipped  = "Отправлено"
+	OrderReceived = "Получено"
+	OrderCanceled = "Отменено"
+)
+
+var (
+	ErrOrderNotFound    = errors.New("order not found")
+	ErrWrongOrderStatus = errors.New("order can not be moved to this status")
+)
+
+// переходы, которые продавец может сделать со своим заказом; остальные статусы конечные
+//
+//nolint:gochecknoglobals
+var sellerTransitions = map[string][]string{
+	OrderPaid:    {OrderShipped, OrderCanceled},
+	OrderShipped: {OrderReceived},
+}
+
+// CheckStatusTransition проверяет, что заказ можно перевести из from в to.
+func CheckStatusTransition(from, to string) error {
+	if !slices.Contains(sellerTransitions[from], to) {
+		return ErrWrongOrderStatus
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/usecases"
+)
+
+func TestCheckStatusTransition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from string
+		to   string
+		want error
+	}{
+		{from: usecases.OrderPaid, to: usecases.OrderShipped},
+		{from: usecases.OrderPaid, to: usecases.OrderCanceled},
+		{from: usecases.OrderShipped, to: usecases.OrderReceived},
+		{from: usecases.OrderPaid, to: usecases.OrderReceived, want: usecases.ErrWrongOrderStatus},
+		{from: usecases.OrderShipped, to: usecases.OrderPaid, want: usecases.ErrWrongOrderStatus},
+		{from: usecases.OrderReceived, to: usecases.OrderCanceled, want: usecases.ErrWrongOrderStatus},
+		{from: usecases.OrderCanceled, to: usecases.OrderShipped, want: usecases.ErrWrongOrderStatus},
+		{from: usecases.OrderPaid, to: usecases.OrderPaid, want: usecases.ErrWrongOrderStatus},
+		{from: usecases.OrderPaid, to: "Потеряно", want: usecases.ErrWrongOrderStatus},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.from+" -> "+tt.to, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.CheckStatusTransition(tt.from, tt.to); !errors.Is(err, tt.want) {
+				t.Errorf("CheckStatusTransition() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
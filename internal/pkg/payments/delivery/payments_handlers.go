@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 	paymentsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/payments/usecases"
@@ -25,13 +26,15 @@ const (
 type PaymentsHandler struct {
 	storage    paymentsusecases.PaymentsStorageInterface
 	authClient authproto.AuthClient
+	bumpPolicy *paymentsusecases.BumpPolicy
 }
 
 func NewPaymentsHandler(storage paymentsusecases.PaymentsStorageInterface,
-	authClient authproto.AuthClient) *PaymentsHandler {
+	authClient authproto.AuthClient, bumpPolicy *paymentsusecases.BumpPolicy) *PaymentsHandler {
 	return &PaymentsHandler{
 		storage:    storage,
 		authClient: authClient,
+		bumpPolicy: bumpPolicy,
 	}
 }
 
@@ -96,6 +99,29 @@ func (h *PaymentsHandler) GetPaymentForm(writer http.ResponseWriter, request *ht
 		return
 	}
 
+	if priceAndDescription.ServiceType == models.ServiceBump {
+		now := time.Now()
+
+		bumpStats, err := storage.GetBumpStats(ctx, frontendData.AdvertID, h.bumpPolicy.WindowStart(now))
+		if err != nil {
+			log.Println(err, responses.StatusInternalServerError)
+			logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
+			responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
+				responses.ErrInternalServer))
+
+			return
+		}
+
+		if err = h.bumpPolicy.Check(bumpStats, now); err != nil {
+			log.Println(err, responses.StatusTooManyRequests)
+			logging.LogHandlerError(logger, err, responses.StatusTooManyRequests)
+			responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusTooManyRequests,
+				responses.ErrBumpNotAllowed))
+
+			return
+		}
+	}
+
 	paymentData := &models.PaymentInitData{
 		Amount: models.PaymentInitAmount{
 			Value:    priceAndDescription.Price,
@@ -162,7 +188,27 @@ func (h *PaymentsHandler) GetPaymentForm(writer http.ResponseWriter, request *ht
 		return
 	}
 
-	err = storage.CreatePayment(ctx, &payment, idempotencyKey, frontendData.AdvertID, priceAndDescription.Duration)
+	if priceAndDescription.ServiceType == models.ServiceBump {
+		// за время похода в ЮKassa могла появиться другая форма поднятия, поэтому проверка повторяется
+		// в одной транзакции с записью платежа
+		now := time.Now()
+		err = storage.CreateBumpPayment(ctx, &payment, idempotencyKey, frontendData.AdvertID,
+			h.bumpPolicy.WindowStart(now), func(stats *models.BumpStats) error {
+				return h.bumpPolicy.Check(stats, now)
+			})
+	} else {
+		err = storage.CreatePayment(ctx, &payment, idempotencyKey, frontendData.AdvertID,
+			priceAndDescription.Duration, priceAndDescription.ServiceType)
+	}
+
+	if paymentsusecases.IsBumpDenied(err) {
+		log.Println(err, responses.StatusTooManyRequests)
+		logging.LogHandlerError(logger, err, responses.StatusTooManyRequests)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusTooManyRequests,
+			responses.ErrBumpNotAllowed))
+
+		return
+	}
 
 	if err != nil {
 		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
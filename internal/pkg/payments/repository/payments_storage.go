@@ -20,6 +20,10 @@ const (
 	lifting = 1
 	premium = 2
 	maximum = 3
+	bump    = 4
+
+	// статусы ЮKassa, при которых деньги уже списаны
+	paidStatuses = `'waiting_for_capture', 'succeeded'`
 )
 
 type PaymentsStorage struct {
@@ -84,6 +88,7 @@ func (paymentsStorage *PaymentsStorage) getPriceAndDescription(ctx context.Conte
 		1: "35",
 		2: "100",
 		3: "199",
+		4: "19",
 	}
 
 	funcName := logging.GetOnlyFunctionName()
@@ -124,6 +129,8 @@ func (paymentsStorage *PaymentsStorage) getPriceAndDescription(ctx context.Conte
 		priceAndDescription.URLEnding = cityTranslation + "/" + categoryTranslation +
 			"/" + strconv.FormatUint(uint64(advertID), 10)
 
+		priceAndDescription.ServiceType = models.ServicePromotion
+
 		switch key := rateCode; key {
 		case lifting:
 			priceAndDescription.Description = fmt.Sprintf("Платное продвижение товара %s на 1 день", title)
@@ -134,6 +141,9 @@ func (paymentsStorage *PaymentsStorage) getPriceAndDescription(ctx context.Conte
 		case maximum:
 			priceAndDescription.Description = fmt.Sprintf("Платное продвижение товара %s на 7 дней", title)
 			priceAndDescription.Duration = "7 days"
+		case bump:
+			priceAndDescription.Description = fmt.Sprintf("Поднятие товара %s в выдаче", title)
+			priceAndDescription.ServiceType = models.ServiceBump
 		default:
 			log.Println("no such key in the map")
 		}
@@ -218,14 +228,14 @@ func (paymentsStorage *PaymentsStorage) CheckAdvertOwnership(ctx context.Context
 }
 
 func (paymentsStorage *PaymentsStorage) createPayment(ctx context.Context, tx pgx.Tx, payment *models.Payment,
-	idempotencyKey string, advertID uint, duration string) error {
+	idempotencyKey string, advertID uint, duration, serviceType string) error {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	SQLCreateProfile := `INSERT INTO public.payments(
 		advert_id, payment_uuid, payment_value, payment_description, payment_status, payment_form_url, 
-                            created_time, idempotency_key, promotion_duration)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`
+                            created_time, idempotency_key, promotion_duration, service_type)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, '')::interval, $10);`
 
 	logging.LogInfo(logger, "INSERT INTO payments")
 
@@ -245,7 +255,8 @@ func (paymentsStorage *PaymentsStorage) createPayment(ctx context.Context, tx pg
 	start := time.Now()
 
 	_, err = tx.Exec(ctx, SQLCreateProfile, advertID, payment.ID, uintValue, payment.Description,
-		payment.Status, payment.Confirmation.ConfirmationURL, payment.CreatedAt, idempotencyKey, duration)
+		payment.Status, payment.Confirmation.ConfirmationURL, payment.CreatedAt, idempotencyKey, duration,
+		serviceType)
 
 	paymentsStorage.metrics.AddDuration(funcName, time.Since(start))
 
@@ -261,11 +272,12 @@ func (paymentsStorage *PaymentsStorage) createPayment(ctx context.Context, tx pg
 }
 
 func (paymentsStorage *PaymentsStorage) CreatePayment(ctx context.Context, payment *models.Payment,
-	idempotencyKey string, advertID uint, duration string) error {
+	idempotencyKey string, advertID uint, duration, serviceType string) error {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	err := pgx.BeginFunc(ctx, paymentsStorage.pool, func(tx pgx.Tx) error {
-		err := paymentsStorage.createPayment(ctx, tx, payment, idempotencyKey, advertID, duration)
+		err := paymentsStorage.createPayment(ctx, tx, payment, idempotencyKey, advertID, duration,
+			serviceType)
 
 		return err
 	})
@@ -278,3 +290,117 @@ func (paymentsStorage *PaymentsStorage) CreatePayment(ctx context.Context, payme
 
 	return nil
 }
+
+func (paymentsStorage *PaymentsStorage) lockAdvert(ctx context.Context, tx pgx.Tx, advertID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLLockAdvert := `SELECT id FROM public.advert WHERE id = $1 FOR UPDATE;`
+
+	logging.LogInfo(logger, "SELECT FROM advert FOR UPDATE")
+
+	start := time.Now()
+
+	var id uint
+
+	err := tx.QueryRow(ctx, SQLLockAdvert, advertID).Scan(&id)
+
+	paymentsStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("error while locking advert, err=%w", err))
+		paymentsStorage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (paymentsStorage *PaymentsStorage) getBumpStats(ctx context.Context, tx pgx.Tx, advertID uint,
+	since time.Time) (*models.BumpStats, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLBumpStats := `SELECT
+		GREATEST(a.bumped_at, MAX(p.created_time) FILTER (WHERE p.payment_status IN (` + paidStatuses + `))),
+		COUNT(p.id) FILTER (WHERE p.payment_status IN (` + paidStatuses + `) AND p.created_time >= $2),
+		MAX(p.created_time) FILTER (WHERE p.payment_status = 'pending')
+		FROM public.advert a
+		LEFT JOIN public.payments p ON p.advert_id = a.id AND p.service_type = 'bump'
+		WHERE a.id = $1
+		GROUP BY a.id, a.bumped_at;`
+
+	logging.LogInfo(logger, "SELECT FROM advert, payments")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLBumpStats, advertID, since)
+
+	paymentsStorage.metrics.AddDuration(funcName, time.Since(start))
+
+	var stats models.BumpStats
+
+	if err := line.Scan(&stats.LastBump, &stats.BumpsInWindow, &stats.PendingSince); err != nil {
+		logging.LogError(logger, fmt.Errorf("error while scanning bump stats, err=%w", err))
+		paymentsStorage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (paymentsStorage *PaymentsStorage) GetBumpStats(ctx context.Context, advertID uint,
+	since time.Time) (*models.BumpStats, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var stats *models.BumpStats
+
+	err := pgx.BeginFunc(ctx, paymentsStorage.pool, func(tx pgx.Tx) error {
+		statsInner, err := paymentsStorage.getBumpStats(ctx, tx, advertID, since)
+		stats = statsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("error while executing get bump stats, err=%w", err))
+
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// CreateBumpPayment сохраняет платёж за поднятие, если check пропускает текущую статистику. Строка объявления
+// блокируется, поэтому две формы поднятия одного объявления не пройдут проверку одновременно.
+func (paymentsStorage *PaymentsStorage) CreateBumpPayment(ctx context.Context, payment *models.Payment,
+	idempotencyKey string, advertID uint, since time.Time, check func(stats *models.BumpStats) error) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, paymentsStorage.pool, func(tx pgx.Tx) error {
+		if err := paymentsStorage.lockAdvert(ctx, tx, advertID); err != nil {
+			return err
+		}
+
+		stats, err := paymentsStorage.getBumpStats(ctx, tx, advertID, since)
+		if err != nil {
+			return err
+		}
+
+		if err = check(stats); err != nil {
+			return err
+		}
+
+		return paymentsStorage.createPayment(ctx, tx, payment, idempotencyKey, advertID, "", models.ServiceBump)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting bump payment in db, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
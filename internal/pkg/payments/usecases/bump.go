@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	defaultBumpCooldown     = 24 * time.Hour
+	defaultBumpWindow       = 30 * 24 * time.Hour
+	defaultBumpMaxPerWindow = 10
+
+	// столько живёт неоплаченная форма ЮKassa; пока она открыта, вторую не выдаём
+	bumpPendingTimeout = time.Hour
+)
+
+var (
+	ErrBumpCooldown = errors.New("advert was bumped recently")
+	ErrBumpLimit    = errors.New("bump limit for the period is exhausted")
+	ErrBumpPending  = errors.New("advert already has an unpaid bump")
+)
+
+// BumpPolicy решает, можно ли сейчас купить поднятие объявления.
+type BumpPolicy struct {
+	cooldown     time.Duration
+	window       time.Duration
+	maxPerWindow int
+}
+
+func NewBumpPolicy(cfg config.BumpConfig) *BumpPolicy {
+	policy := &BumpPolicy{
+		cooldown:     cfg.Cooldown,
+		window:       cfg.Window,
+		maxPerWindow: cfg.MaxPerWindow,
+	}
+
+	if policy.cooldown <= 0 {
+		policy.cooldown = defaultBumpCooldown
+	}
+
+	if policy.window <= 0 {
+		policy.window = defaultBumpWindow
+	}
+
+	if policy.maxPerWindow <= 0 {
+		policy.maxPerWindow = defaultBumpMaxPerWindow
+	}
+
+	return policy
+}
+
+// WindowStart - с какого момента считать поднятия для Check.
+func (p *BumpPolicy) WindowStart(now time.Time) time.Time {
+	return now.Add(-p.window)
+}
+
+// Check возвращает ошибку, если есть неоплаченная форма поднятия, с последнего поднятия не прошёл cooldown
+// или лимит за окно исчерпан.
+func (p *BumpPolicy) Check(stats *models.BumpStats, now time.Time) error {
+	if stats == nil {
+		return nil
+	}
+
+	if stats.PendingSince != nil && now.Sub(*stats.PendingSince) < bumpPendingTimeout {
+		return ErrBumpPending
+	}
+
+	if stats.LastBump != nil && now.Sub(*stats.LastBump) < p.cooldown {
+		return ErrBumpCooldown
+	}
+
+	if stats.BumpsInWindow >= p.maxPerWindow {
+		return ErrBumpLimit
+	}
+
+	return nil
+}
+
+// IsBumpDenied - ошибка означает отказ политики поднятия, а не сбой.
+func IsBumpDenied(err error) bool {
+	return errors.Is(err, ErrBumpCooldown) || errors.Is(err, ErrBumpLimit) || errors.Is(err, ErrBumpPending)
+}
@@ -0,0 +1,50 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/payments/usecases"
+)
+
+func TestBumpPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	policy := usecases.NewBumpPolicy(config.BumpConfig{Cooldown: 24 * time.Hour, Window: 720 * time.Hour,
+		MaxPerWindow: 3})
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-time.Hour)
+	old := now.Add(-48 * time.Hour)
+	justNow := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		stats *models.BumpStats
+		want  error
+	}{
+		{name: "Never bumped", stats: &models.BumpStats{}, want: nil},
+		{name: "Cooldown passed", stats: &models.BumpStats{LastBump: &old, BumpsInWindow: 2}, want: nil},
+		{name: "Bumped recently", stats: &models.BumpStats{LastBump: &recent, BumpsInWindow: 1},
+			want: usecases.ErrBumpCooldown},
+		{name: "Window limit exhausted", stats: &models.BumpStats{LastBump: &old, BumpsInWindow: 3},
+			want: usecases.ErrBumpLimit},
+		{name: "Unpaid form is open", stats: &models.BumpStats{PendingSince: &justNow}, want: usecases.ErrBumpPending},
+		{name: "Unpaid form expired", stats: &models.BumpStats{LastBump: &old, PendingSince: &old}, want: nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := policy.Check(tt.stats, now); !errors.Is(err, tt.want) {
+				t.Errorf("Check() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
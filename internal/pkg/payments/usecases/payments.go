@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 )
@@ -10,5 +11,8 @@ type PaymentsStorageInterface interface {
 	CheckAdvertOwnership(ctx context.Context, advertID, userID uint) bool
 	GetPriceAndDescription(ctx context.Context, advertID, rateCode uint) (*models.PriceAndDescription, error)
 	CreatePayment(ctx context.Context, payment *models.Payment, idempotencyKey string,
-		advertID uint, duration string) error
+		advertID uint, duration, serviceType string) error
+	GetBumpStats(ctx context.Context, advertID uint, since time.Time) (*models.BumpStats, error)
+	CreateBumpPayment(ctx context.Context, payment *models.Payment, idempotencyKey string, advertID uint,
+		since time.Time, check func(stats *models.BumpStats) error) error
 }
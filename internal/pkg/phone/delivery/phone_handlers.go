@@ -0,0 +1,150 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	phoneusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+type PhoneHandler struct {
+	verifier   *phoneusecases.PhoneVerifier
+	authClient authproto.AuthClient
+}
+
+func NewPhoneHandler(verifier *phoneusecases.PhoneVerifier, authClient authproto.AuthClient) *PhoneHandler {
+	return &PhoneHandler{
+		verifier:   verifier,
+		authClient: authClient,
+	}
+}
+
+// GetStatus отдаёт номер из профиля и то, подтверждён ли он.
+func (h *PhoneHandler) GetStatus(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	status, err := h.verifier.Status(ctx, userID)
+	if err != nil {
+		h.sendPhoneError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(status))
+}
+
+// RequestCode отправляет SMS с кодом на указанный номер.
+func (h *PhoneHandler) RequestCode(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	var data models.ReceivedPhoneNumber
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil {
+		h.sendPhoneError(writer, request, logger, fmt.Errorf("%w: %w", phoneusecases.ErrPhoneNotValid, err))
+
+		return
+	}
+
+	sent, err := h.verifier.RequestCode(ctx, userID, data.Phone)
+	if err != nil {
+		h.sendPhoneError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Phone code sent to user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(sent))
+}
+
+// ConfirmCode проверяет код из SMS и отмечает номер в профиле подтверждённым.
+func (h *PhoneHandler) ConfirmCode(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	var data models.ReceivedPhoneCode
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	status, err := h.verifier.ConfirmCode(ctx, userID, data.Code)
+	if err != nil {
+		h.sendPhoneError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Phone of user %d verified", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(status))
+}
+
+func (h *PhoneHandler) sendPhoneError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger,
+	err error) {
+	code, message := phoneErrorResponse(err)
+
+	if errors.Is(err, phoneusecases.ErrTooManyRequests) {
+		writer.Header().Set("Retry-After", h.verifier.RetryAfter())
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
+
+func phoneErrorResponse(err error) (int, string) {
+	switch {
+	case errors.Is(err, phoneusecases.ErrPhoneNotValid):
+		return responses.StatusBadRequest, responses.ErrPhoneNotValid
+	case errors.Is(err, phoneusecases.ErrPhoneAlreadyVerified):
+		return responses.StatusConflict, responses.ErrPhoneAlreadyVerified
+	case errors.Is(err, phoneusecases.ErrCodeNotFound):
+		return responses.StatusBadRequest, responses.ErrCodeNotRequested
+	case errors.Is(err, phoneusecases.ErrWrongCode):
+		return responses.StatusBadRequest, responses.ErrWrongCode
+	case errors.Is(err, phoneusecases.ErrTooManyAttempts):
+		return responses.StatusTooManyRequests, responses.ErrTooManyAttempts
+	case errors.Is(err, phoneusecases.ErrTooManyRequests):
+		return responses.StatusTooManyRequests, responses.ErrTooManyCodeRequests
+	default:
+		return responses.StatusInternalServerError, responses.ErrInternalServer
+	}
+}
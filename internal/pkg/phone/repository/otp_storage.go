@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	phoneusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	serverrepository "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/repository"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+const (
+	codeKeyFormat = "phone_otp:%d"
+
+	fieldPhone    = "phone"
+	fieldCode     = "code"
+	fieldAttempts = "attempts"
+)
+
+//nolint:gochecknoglobals
+var (
+	// счётчик попыток увеличивается только у живого кода: HINCRBY по истёкшему ключу создал бы вечную запись
+	incrementAttemptsScript = redis.NewScript(1, `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+return redis.call('HINCRBY', KEYS[1], 'attempts', 1)`)
+)
+
+// OTPStorage держит коды подтверждения и счётчики ограничений в Redis.
+type OTPStorage struct {
+	redisPool *redis.Pool
+}
+
+func NewOTPStorage(redisPool *redis.Pool) *OTPStorage {
+	return &OTPStorage{
+		redisPool: redisPool,
+	}
+}
+
+func codeKey(userID uint) string {
+	return fmt.Sprintf(codeKeyFormat, userID)
+}
+
+func (storage *OTPStorage) SaveCode(ctx context.Context, userID uint, code *models.PhoneCode,
+	ttl time.Duration) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := storage.redisPool.Get()
+	defer conn.Close()
+
+	key := codeKey(userID)
+
+	logging.LogInfo(logger, "MULTI DEL HSET PEXPIRE phone_otp")
+
+	if err := conn.Send("MULTI"); err != nil {
+		return err
+	}
+
+	_ = conn.Send("DEL", key)
+	_ = conn.Send("HSET", key, fieldPhone, code.Phone, fieldCode, code.CodeHash, fieldAttempts, 0)
+	_ = conn.Send("PEXPIRE", key, ttl.Milliseconds())
+
+	if _, err := conn.Do("EXEC"); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while saving phone code, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *OTPStorage) GetCode(ctx context.Context, userID uint) (*models.PhoneCode, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := storage.redisPool.Get()
+	defer conn.Close()
+
+	logging.LogInfo(logger, "HGETALL phone_otp")
+
+	values, err := redis.Values(conn.Do("HGETALL", codeKey(userID)))
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting phone code, err=%w", err))
+
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, phoneusecases.ErrCodeNotFound
+	}
+
+	var fields struct {
+		Phone    string `redis:"phone"`
+		Code     string `redis:"code"`
+		Attempts int    `redis:"attempts"`
+	}
+
+	if err := redis.ScanStruct(values, &fields); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning phone code, err=%w", err))
+
+		return nil, err
+	}
+
+	return &models.PhoneCode{Phone: fields.Phone, CodeHash: fields.Code, Attempts: fields.Attempts}, nil
+}
+
+func (storage *OTPStorage) IncrementAttempts(ctx context.Context, userID uint) (int, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := storage.redisPool.Get()
+	defer conn.Close()
+
+	logging.LogInfo(logger, "HINCRBY phone_otp")
+
+	attempts, err := redis.Int(incrementAttemptsScript.Do(conn, codeKey(userID)))
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while counting phone code attempts, err=%w", err))
+
+		return 0, err
+	}
+
+	if attempts < 0 {
+		return 0, phoneusecases.ErrCodeNotFound
+	}
+
+	return attempts, nil
+}
+
+func (storage *OTPStorage) DeleteCode(ctx context.Context, userID uint) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := storage.redisPool.Get()
+	defer conn.Close()
+
+	logging.LogInfo(logger, "DEL phone_otp")
+
+	if _, err := conn.Do("DEL", codeKey(userID)); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting phone code, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *OTPStorage) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := storage.redisPool.Get()
+	defer conn.Close()
+
+	logging.LogInfo(logger, "INCR "+key)
+
+	count, err := serverrepository.IncrWindow(conn, key, window)
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while counting %s, err=%w", key, err))
+
+		return false, err
+	}
+
+	return count <= limit, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+var errProfileNotExists = errors.New("profile does not exist")
+
+type PhoneStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewPhoneStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *PhoneStorage {
+	return &PhoneStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (storage *PhoneStorage) getPhoneStatus(ctx context.Context, tx pgx.Tx, userID uint) (*models.PhoneStatus,
+	error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLPhoneStatus := `SELECT COALESCE(phone, ''), phone_verified FROM public.profile WHERE user_id = $1;`
+
+	logging.LogInfo(logger, "SELECT FROM profile")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLPhoneStatus, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	status := &models.PhoneStatus{}
+
+	err := line.Scan(&status.Phone, &status.Verified)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = errProfileNotExists
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning phone status, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (storage *PhoneStorage) GetPhoneStatus(ctx context.Context, userID uint) (*models.PhoneStatus, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var status *models.PhoneStatus
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		statusInner, err := storage.getPhoneStatus(ctx, tx, userID)
+		status = statusInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting phone status, err=%w", err))
+
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func (storage *PhoneStorage) setVerifiedPhone(ctx context.Context, tx pgx.Tx, userID uint, phone string) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetVerifiedPhone := `UPDATE public.profile SET phone = $2, phone_verified = TRUE WHERE user_id = $1;`
+
+	logging.LogInfo(logger, "UPDATE profile")
+
+	start := time.Now()
+
+	tag, err := tx.Exec(ctx, SQLSetVerifiedPhone, userID, phone)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err == nil && tag.RowsAffected() == 0 {
+		err = errProfileNotExists
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting verified phone, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *PhoneStorage) SetVerifiedPhone(ctx context.Context, userID uint, phone string) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		return storage.setVerifiedPhone(ctx, tx, userID, phone)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while verifying phone, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,292 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/sms"
+)
+
+const (
+	defaultCodeLength      = 6
+	defaultCodeTTL         = 5 * time.Minute
+	defaultMaxAttempts     = 5
+	defaultResendInterval  = time.Minute
+	defaultMaxSendsPerHour = 5
+
+	minPhoneDigits = 11
+	maxPhoneDigits = 15
+
+	codeMessage = "Код подтверждения Vol-4-ok: %s. Никому его не сообщайте."
+)
+
+var (
+	ErrPhoneNotValid        = errors.New("phone number is not valid")
+	ErrPhoneNotVerified     = errors.New("phone number is not verified")
+	ErrPhoneAlreadyVerified = errors.New("phone number is already verified")
+	ErrCodeNotFound         = errors.New("confirmation code is expired or was not requested")
+	ErrWrongCode            = errors.New("wrong confirmation code")
+	ErrTooManyAttempts      = errors.New("too many wrong confirmation codes")
+	ErrTooManyRequests      = errors.New("confirmation code requested too often")
+)
+
+// OTPStorageInterface хранит выданные коды и счётчики ограничений; записи живут не дольше своего окна.
+type OTPStorageInterface interface {
+	// SaveCode заменяет код пользователя новым и обнуляет счётчик попыток.
+	SaveCode(ctx context.Context, userID uint, code *models.PhoneCode, ttl time.Duration) error
+	// GetCode возвращает ErrCodeNotFound, если код не запрашивали или он истёк.
+	GetCode(ctx context.Context, userID uint) (*models.PhoneCode, error)
+	IncrementAttempts(ctx context.Context, userID uint) (int, error)
+	DeleteCode(ctx context.Context, userID uint) error
+	// Allow учитывает ещё одно событие по ключу и сообщает, уложились ли в limit событий за window.
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
+
+type PhoneStorageInterface interface {
+	GetPhoneStatus(ctx context.Context, userID uint) (*models.PhoneStatus, error)
+	// SetVerifiedPhone записывает телефон в профиль и отмечает его подтверждённым.
+	SetVerifiedPhone(ctx context.Context, userID uint, phone string) error
+}
+
+// NormalizePhone приводит номер к виду +79991234567: убирает пробелы, скобки и дефисы, российскую восьмёрку
+// заменяет на +7, десятизначный номер без кода страны считает российским.
+func NormalizePhone(phone string) (string, error) {
+	var digits strings.Builder
+
+	for i, r := range strings.TrimSpace(phone) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0, r == ' ', r == '-', r == '(', r == ')':
+		default:
+			return "", ErrPhoneNotValid
+		}
+	}
+
+	number := digits.String()
+	international := strings.HasPrefix(strings.TrimSpace(phone), "+")
+
+	switch {
+	case !international && len(number) == 10 && number[0] == '9':
+		number = "7" + number
+	case !international && len(number) == 11 && number[0] == '8':
+		number = "7" + number[1:]
+	}
+
+	if len(number) < minPhoneDigits || len(number) > maxPhoneDigits || number[0] == '0' {
+		return "", ErrPhoneNotValid
+	}
+
+	return "+" + number, nil
+}
+
+// PhoneVerifier выдаёт и проверяет одноразовые коды подтверждения телефона.
+type PhoneVerifier struct {
+	codes  OTPStorageInterface
+	phones PhoneStorageInterface
+	sender sms.SMSSender
+
+	codeLength        int
+	codeTTL           time.Duration
+	maxAttempts       int
+	resendInterval    time.Duration
+	maxSendsPerHour   int
+	requireForAdverts bool
+}
+
+func NewPhoneVerifier(codes OTPStorageInterface, phones PhoneStorageInterface, sender sms.SMSSender,
+	cfg config.PhoneVerificationConfig) *PhoneVerifier {
+	verifier := &PhoneVerifier{
+		codes:             codes,
+		phones:            phones,
+		sender:            sender,
+		codeLength:        cfg.CodeLength,
+		codeTTL:           cfg.CodeTTL,
+		maxAttempts:       cfg.MaxAttempts,
+		resendInterval:    cfg.ResendInterval,
+		maxSendsPerHour:   cfg.MaxSendsPerHour,
+		requireForAdverts: cfg.RequireForAdverts,
+	}
+
+	if verifier.codeLength <= 0 {
+		verifier.codeLength = defaultCodeLength
+	}
+
+	if verifier.codeTTL <= 0 {
+		verifier.codeTTL = defaultCodeTTL
+	}
+
+	if verifier.maxAttempts <= 0 {
+		verifier.maxAttempts = defaultMaxAttempts
+	}
+
+	if verifier.resendInterval <= 0 {
+		verifier.resendInterval = defaultResendInterval
+	}
+
+	if verifier.maxSendsPerHour <= 0 {
+		verifier.maxSendsPerHour = defaultMaxSendsPerHour
+	}
+
+	return verifier
+}
+
+func (verifier *PhoneVerifier) RequiredForAdverts() bool {
+	return verifier.requireForAdverts
+}
+
+// RetryAfter - через сколько секунд имеет смысл повторить запрос кода.
+func (verifier *PhoneVerifier) RetryAfter() string {
+	return strconv.Itoa(int(verifier.resendInterval.Seconds()))
+}
+
+// RequestCode отправляет код на номер. Ограничения считаются и по пользователю, и по номеру, чтобы
+// нельзя было ни засыпать SMS чужой телефон, ни перебирать номера с одного аккаунта.
+func (verifier *PhoneVerifier) RequestCode(ctx context.Context, userID uint,
+	phone string) (*models.PhoneCodeSent, error) {
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := verifier.phones.GetPhoneStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Verified && status.Phone == phone {
+		return nil, ErrPhoneAlreadyVerified
+	}
+
+	limits := []struct {
+		key    string
+		limit  int
+		window time.Duration
+	}{
+		{key: fmt.Sprintf("phone_otp_resend:%d", userID), limit: 1, window: verifier.resendInterval},
+		{key: fmt.Sprintf("phone_otp_user:%d", userID), limit: verifier.maxSendsPerHour, window: time.Hour},
+		{key: "phone_otp_phone:" + phone, limit: verifier.maxSendsPerHour, window: time.Hour},
+	}
+
+	for _, limit := range limits {
+		allowed, err := verifier.codes.Allow(ctx, limit.key, limit.limit, limit.window)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			return nil, ErrTooManyRequests
+		}
+	}
+
+	code, err := verifier.generateCode()
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifier.codes.SaveCode(ctx, userID, &models.PhoneCode{Phone: phone, CodeHash: hashCode(phone, code)},
+		verifier.codeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifier.sender.Send(ctx, phone, fmt.Sprintf(codeMessage, code)); err != nil {
+		return nil, err
+	}
+
+	return &models.PhoneCodeSent{
+		Phone:     phone,
+		ExpiresIn: int(verifier.codeTTL.Seconds()),
+		ResendIn:  int(verifier.resendInterval.Seconds()),
+	}, nil
+}
+
+// ConfirmCode проверяет код и при совпадении записывает номер в профиль как подтверждённый.
+// После maxAttempts неудачных попыток код сгорает, и нужно запросить новый.
+func (verifier *PhoneVerifier) ConfirmCode(ctx context.Context, userID uint, code string) (*models.PhoneStatus,
+	error) {
+	issued, err := verifier.codes.GetCode(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := verifier.codes.IncrementAttempts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempts > verifier.maxAttempts {
+		if err := verifier.codes.DeleteCode(ctx, userID); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrTooManyAttempts
+	}
+
+	given := hashCode(issued.Phone, strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(given), []byte(issued.CodeHash)) != 1 {
+		return nil, ErrWrongCode
+	}
+
+	if err := verifier.phones.SetVerifiedPhone(ctx, userID, issued.Phone); err != nil {
+		return nil, err
+	}
+
+	if err := verifier.codes.DeleteCode(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &models.PhoneStatus{Phone: issued.Phone, Verified: true}, nil
+}
+
+func (verifier *PhoneVerifier) Status(ctx context.Context, userID uint) (*models.PhoneStatus, error) {
+	return verifier.phones.GetPhoneStatus(ctx, userID)
+}
+
+// VerifiedPhone возвращает подтверждённый номер пользователя или ErrPhoneNotVerified.
+func (verifier *PhoneVerifier) VerifiedPhone(ctx context.Context, userID uint) (string, error) {
+	status, err := verifier.phones.GetPhoneStatus(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if !status.Verified {
+		return "", ErrPhoneNotVerified
+	}
+
+	return status.Phone, nil
+}
+
+func (verifier *PhoneVerifier) generateCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < verifier.codeLength; i++ {
+		limit.Mul(limit, big.NewInt(10)) //nolint:gomnd
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	code := n.String()
+
+	return strings.Repeat("0", verifier.codeLength-len(code)) + code, nil
+}
+
+// hashCode привязывает код к номеру: код, выданный для одного номера, не подтвердит другой.
+func hashCode(phone, code string) string {
+	sum := sha256.Sum256([]byte(phone + ":" + code))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,273 @@
+//nolint:all
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/sms"
+)
+
+type otpStub struct {
+	mu       sync.Mutex
+	codes    map[uint]*models.PhoneCode
+	counters map[string]int
+}
+
+func (s *otpStub) SaveCode(_ context.Context, userID uint, code *models.PhoneCode, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := *code
+	s.codes[userID] = &saved
+
+	return nil
+}
+
+func (s *otpStub) GetCode(_ context.Context, userID uint) (*models.PhoneCode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code, ok := s.codes[userID]
+	if !ok {
+		return nil, usecases.ErrCodeNotFound
+	}
+
+	saved := *code
+
+	return &saved, nil
+}
+
+func (s *otpStub) IncrementAttempts(_ context.Context, userID uint) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	code, ok := s.codes[userID]
+	if !ok {
+		return 0, usecases.ErrCodeNotFound
+	}
+
+	code.Attempts++
+
+	return code.Attempts, nil
+}
+
+func (s *otpStub) DeleteCode(_ context.Context, userID uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.codes, userID)
+
+	return nil
+}
+
+func (s *otpStub) Allow(_ context.Context, key string, limit int, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.counters[key]++
+
+	return s.counters[key] <= limit, nil
+}
+
+type phonesStub struct {
+	mu       sync.Mutex
+	statuses map[uint]models.PhoneStatus
+}
+
+func (s *phonesStub) GetPhoneStatus(_ context.Context, userID uint) (*models.PhoneStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.statuses[userID]
+
+	return &status, nil
+}
+
+func (s *phonesStub) SetVerifiedPhone(_ context.Context, userID uint, phone string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.statuses[userID] = models.PhoneStatus{Phone: phone, Verified: true}
+
+	return nil
+}
+
+var codeRe = regexp.MustCompile(`\d{6}`)
+
+func newVerifier(t *testing.T) (*usecases.PhoneVerifier, *otpStub, *phonesStub, *sms.FakeSender) {
+	t.Helper()
+
+	codes := &otpStub{codes: map[uint]*models.PhoneCode{}, counters: map[string]int{}}
+	phones := &phonesStub{statuses: map[uint]models.PhoneStatus{}}
+	sender := sms.NewFakeSender()
+
+	verifier := usecases.NewPhoneVerifier(codes, phones, sender, config.PhoneVerificationConfig{
+		CodeLength:      6,
+		CodeTTL:         5 * time.Minute,
+		MaxAttempts:     3,
+		ResendInterval:  time.Minute,
+		MaxSendsPerHour: 5,
+	})
+
+	return verifier, codes, phones, sender
+}
+
+func sentCode(t *testing.T, sender *sms.FakeSender, phone string) string {
+	t.Helper()
+
+	msg, ok := sender.Last(phone)
+	if !ok {
+		t.Fatalf("no sms sent to %s", phone)
+	}
+
+	code := codeRe.FindString(msg.Text)
+	if code == "" {
+		t.Fatalf("no code in sms %q", msg.Text)
+	}
+
+	return code
+}
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		phone   string
+		want    string
+		wantErr bool
+	}{
+		{phone: "8 (916) 123-45-67", want: "+79161234567"},
+		{phone: "9161234567", want: "+79161234567"},
+		{phone: "+7 916 123 45 67", want: "+79161234567"},
+		{phone: "+44 20 7946 0958", want: "+442079460958"},
+		{phone: "123-45", wantErr: true},
+		{phone: "8916123456a", wantErr: true},
+		{phone: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.phone, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := usecases.NormalizePhone(tt.phone)
+			if tt.wantErr {
+				if !errors.Is(err, usecases.ErrPhoneNotValid) {
+					t.Errorf("NormalizePhone(%q) error = %v, want ErrPhoneNotValid", tt.phone, err)
+				}
+
+				return
+			}
+
+			if err != nil || got != tt.want {
+				t.Errorf("NormalizePhone(%q) = %q, %v, want %q", tt.phone, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPhoneVerifierConfirm(t *testing.T) {
+	t.Parallel()
+
+	verifier, _, phones, sender := newVerifier(t)
+	ctx := context.Background()
+
+	sent, err := verifier.RequestCode(ctx, 1, "8 916 123-45-67")
+	if err != nil {
+		t.Fatalf("RequestCode() error = %v", err)
+	}
+
+	if sent.Phone != "+79161234567" || sent.ExpiresIn != 300 || sent.ResendIn != 60 {
+		t.Errorf("RequestCode() = %+v", sent)
+	}
+
+	status, err := verifier.ConfirmCode(ctx, 1, sentCode(t, sender, sent.Phone))
+	if err != nil {
+		t.Fatalf("ConfirmCode() error = %v", err)
+	}
+
+	if !status.Verified || phones.statuses[1].Phone != "+79161234567" {
+		t.Errorf("ConfirmCode() = %+v, stored %+v", status, phones.statuses[1])
+	}
+
+	phone, err := verifier.VerifiedPhone(ctx, 1)
+	if err != nil || phone != "+79161234567" {
+		t.Errorf("VerifiedPhone() = %q, %v", phone, err)
+	}
+
+	if _, err := verifier.ConfirmCode(ctx, 1, "000000"); !errors.Is(err, usecases.ErrCodeNotFound) {
+		t.Errorf("ConfirmCode() after success error = %v, want ErrCodeNotFound", err)
+	}
+}
+
+func TestPhoneVerifierAttempts(t *testing.T) {
+	t.Parallel()
+
+	verifier, codes, _, sender := newVerifier(t)
+	ctx := context.Background()
+
+	sent, err := verifier.RequestCode(ctx, 1, "+79161234567")
+	if err != nil {
+		t.Fatalf("RequestCode() error = %v", err)
+	}
+
+	wrong := "000000"
+	if sentCode(t, sender, sent.Phone) == wrong {
+		wrong = "111111"
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := verifier.ConfirmCode(ctx, 1, wrong); !errors.Is(err, usecases.ErrWrongCode) {
+			t.Fatalf("attempt %d error = %v, want ErrWrongCode", i+1, err)
+		}
+	}
+
+	if _, err := verifier.ConfirmCode(ctx, 1, sentCode(t, sender, sent.Phone)); !errors.Is(err,
+		usecases.ErrTooManyAttempts) {
+		t.Errorf("ConfirmCode() after limit error = %v, want ErrTooManyAttempts", err)
+	}
+
+	if _, ok := codes.codes[1]; ok {
+		t.Error("code is kept after too many attempts")
+	}
+
+	if _, err := verifier.VerifiedPhone(ctx, 1); !errors.Is(err, usecases.ErrPhoneNotVerified) {
+		t.Errorf("VerifiedPhone() error = %v, want ErrPhoneNotVerified", err)
+	}
+}
+
+func TestPhoneVerifierRequestLimits(t *testing.T) {
+	t.Parallel()
+
+	verifier, _, phones, sender := newVerifier(t)
+	ctx := context.Background()
+
+	if _, err := verifier.RequestCode(ctx, 1, "+79161234567"); err != nil {
+		t.Fatalf("RequestCode() error = %v", err)
+	}
+
+	if _, err := verifier.RequestCode(ctx, 1, "+79161234567"); !errors.Is(err, usecases.ErrTooManyRequests) {
+		t.Errorf("RequestCode() before resend interval error = %v, want ErrTooManyRequests", err)
+	}
+
+	if len(sender.Messages()) != 1 {
+		t.Errorf("sent %d messages, want 1", len(sender.Messages()))
+	}
+
+	phones.statuses[2] = models.PhoneStatus{Phone: "+79161234568", Verified: true}
+	if _, err := verifier.RequestCode(ctx, 2, "89161234568"); !errors.Is(err, usecases.ErrPhoneAlreadyVerified) {
+		t.Errorf("RequestCode() for verified phone error = %v, want ErrPhoneAlreadyVerified", err)
+	}
+
+	if _, err := verifier.RequestCode(ctx, 3, "12345"); !errors.Is(err, usecases.ErrPhoneNotValid) {
+		t.Errorf("RequestCode() for invalid phone error = %v, want ErrPhoneNotValid", err)
+	}
+}
@@ -20,19 +20,20 @@ import (
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
 )
 
-const (
-	maxMemory = 2 << 20
-)
-
 type ProfileHandler struct {
 	profileClient profileproto.ProfileClient
 	authClient    authproto.AuthClient
+	images        *utils.ImagePipeline
+	uploads       *utils.UploadValidator
 }
 
-func NewProfileHandler(profileClient profileproto.ProfileClient, authClient authproto.AuthClient) *ProfileHandler {
+func NewProfileHandler(profileClient profileproto.ProfileClient, authClient authproto.AuthClient,
+	images *utils.ImagePipeline, uploads *utils.UploadValidator) *ProfileHandler {
 	return &ProfileHandler{
 		profileClient: profileClient,
 		authClient:    authClient,
+		images:        images,
+		uploads:       uploads,
 	}
 }
 
@@ -151,12 +152,19 @@ func (h *ProfileHandler) EditProfile(writer http.ResponseWriter, request *http.R
 
 	user, _ := authClient.GetCurrentUser(ctx, &authproto.SessionData{SessionID: session.Value})
 
-	err := request.ParseMultipartForm(maxMemory)
+	err := h.uploads.ParseMultipartForm(writer, request)
+	if err == nil {
+		err = h.uploads.ValidateFiles("avatar", request.MultipartForm.File["avatar"])
+	}
+
 	if err != nil {
-		logging.LogHandlerError(logger, err, responses.StatusInternalServerError)
-		log.Println(err, responses.StatusInternalServerError)
-		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusInternalServerError,
-			responses.ErrInternalServer))
+		response := utils.UploadErrResponse(err)
+
+		logging.LogHandlerError(logger, err, response.Code)
+		log.Println(err, response.Code)
+		responses.SendErrResponse(request, writer, response)
+
+		return
 	}
 
 	avatar := request.MultipartForm.File["avatar"]
@@ -168,12 +176,12 @@ func (h *ProfileHandler) EditProfile(writer http.ResponseWriter, request *http.R
 	}
 
 	var (
-		pl       *profileproto.ProfileData
-		fullPath string
+		pl         *profileproto.ProfileData
+		renditions map[string]string
 	)
 
 	if len(avatar) != 0 {
-		fullPath, err = utils.WriteFile(avatar[0], "avatars")
+		renditions, err = h.images.Process(ctx, avatar[0], "avatars", utils.RenditionFull)
 		if err != nil {
 			logging.LogError(logger, fmt.Errorf("something went wrong while writing file of the image, err=%w",
 				err))
@@ -184,7 +192,7 @@ func (h *ProfileHandler) EditProfile(writer http.ResponseWriter, request *http.R
 			return
 		}
 
-		data.Avatar = fullPath
+		data.Avatar = renditions[utils.RenditionFull]
 	}
 
 	pl, err = profileClient.EditProfile(ctx, data)
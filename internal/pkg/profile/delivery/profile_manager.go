@@ -38,6 +38,7 @@ func CleanProfileData(profile *protobuf.ProfileData) *models.Profile {
 		Rating:          profile.Rating,
 		ReactionsCount:  profile.ReactionsCount,
 		Approved:        profile.Approved,
+		PhoneVerified:   profile.PhoneVerified,
 		MerchantsName:   profile.MerchantsName,
 		SubersCount:     int(profile.SubersCount),
 		SubonsCount:     int(profile.SubonsCount),
@@ -46,32 +47,35 @@ func CleanProfileData(profile *protobuf.ProfileData) *models.Profile {
 		SoldAddsCount:   int(profile.SoldAddsCount),
 		CartNum:         uint(profile.CartNum),
 		FavNum:          uint(profile.FavNum),
+		UnreadMessages:  uint(profile.UnreadMessagesNum),
 	}
 }
 
 func newProtobufProfile(profile *models.Profile) *protobuf.ProfileData {
 	return &protobuf.ProfileData{
-		ID:              uint64(profile.ID),
-		UserID:          uint64(profile.UserID),
-		Name:            profile.Name,
-		Surname:         profile.Surname,
-		CityID:          uint64(profile.City.ID),
-		CityName:        profile.City.CityName,
-		Translation:     profile.City.Translation,
-		Phone:           profile.Phone,
-		Avatar:          profile.Avatar,
-		RegisterTime:    timestamppb.New(profile.RegisterTime),
-		Rating:          profile.Rating,
-		ReactionsCount:  profile.ReactionsCount,
-		Approved:        profile.Approved,
-		MerchantsName:   profile.MerchantsName,
-		SubersCount:     int64(profile.SubersCount),
-		SubonsCount:     int64(profile.SubonsCount),
-		AvatarIMG:       profile.AvatarIMG,
-		ActiveAddsCount: int64(profile.ActiveAddsCount),
-		SoldAddsCount:   int64(profile.SoldAddsCount),
-		CartNum:         int64(profile.CartNum),
-		FavNum:          int64(profile.FavNum),
+		ID:                uint64(profile.ID),
+		UserID:            uint64(profile.UserID),
+		Name:              profile.Name,
+		Surname:           profile.Surname,
+		CityID:            uint64(profile.City.ID),
+		CityName:          profile.City.CityName,
+		Translation:       profile.City.Translation,
+		Phone:             profile.Phone,
+		Avatar:            profile.Avatar,
+		RegisterTime:      timestamppb.New(profile.RegisterTime),
+		Rating:            profile.Rating,
+		ReactionsCount:    profile.ReactionsCount,
+		Approved:          profile.Approved,
+		PhoneVerified:     profile.PhoneVerified,
+		MerchantsName:     profile.MerchantsName,
+		SubersCount:       int64(profile.SubersCount),
+		SubonsCount:       int64(profile.SubonsCount),
+		AvatarIMG:         profile.AvatarIMG,
+		ActiveAddsCount:   int64(profile.ActiveAddsCount),
+		SoldAddsCount:     int64(profile.SoldAddsCount),
+		CartNum:           int64(profile.CartNum),
+		FavNum:            int64(profile.FavNum),
+		UnreadMessagesNum: int64(profile.UnreadMessages),
 	}
 }
 
@@ -177,27 +177,29 @@ type ProfileData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID              uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	UserID          uint64                 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	Name            string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
-	Surname         string                 `protobuf:"bytes,4,opt,name=Surname,proto3" json:"Surname,omitempty"`
-	CityID          uint64                 `protobuf:"varint,5,opt,name=CityID,proto3" json:"CityID,omitempty"`
-	CityName        string                 `protobuf:"bytes,6,opt,name=CityName,proto3" json:"CityName,omitempty"`
-	Translation     string                 `protobuf:"bytes,7,opt,name=Translation,proto3" json:"Translation,omitempty"`
-	Phone           string                 `protobuf:"bytes,8,opt,name=Phone,proto3" json:"Phone,omitempty"`
-	Avatar          string                 `protobuf:"bytes,9,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
-	RegisterTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=RegisterTime,proto3" json:"RegisterTime,omitempty"`
-	Rating          float32                `protobuf:"fixed32,11,opt,name=Rating,proto3" json:"Rating,omitempty"`
-	ReactionsCount  float32                `protobuf:"fixed32,12,opt,name=ReactionsCount,proto3" json:"ReactionsCount,omitempty"`
-	Approved        bool                   `protobuf:"varint,13,opt,name=Approved,proto3" json:"Approved,omitempty"`
-	MerchantsName   string                 `protobuf:"bytes,14,opt,name=MerchantsName,proto3" json:"MerchantsName,omitempty"`
-	SubersCount     int64                  `protobuf:"varint,15,opt,name=SubersCount,proto3" json:"SubersCount,omitempty"`
-	SubonsCount     int64                  `protobuf:"varint,16,opt,name=SubonsCount,proto3" json:"SubonsCount,omitempty"`
-	AvatarIMG       string                 `protobuf:"bytes,17,opt,name=AvatarIMG,proto3" json:"AvatarIMG,omitempty"`
-	ActiveAddsCount int64                  `protobuf:"varint,18,opt,name=ActiveAddsCount,proto3" json:"ActiveAddsCount,omitempty"`
-	SoldAddsCount   int64                  `protobuf:"varint,19,opt,name=SoldAddsCount,proto3" json:"SoldAddsCount,omitempty"`
-	CartNum         int64                  `protobuf:"varint,20,opt,name=CartNum,proto3" json:"CartNum,omitempty"`
-	FavNum          int64                  `protobuf:"varint,21,opt,name=FavNum,proto3" json:"FavNum,omitempty"`
+	ID                uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID            uint64                 `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Surname           string                 `protobuf:"bytes,4,opt,name=Surname,proto3" json:"Surname,omitempty"`
+	CityID            uint64                 `protobuf:"varint,5,opt,name=CityID,proto3" json:"CityID,omitempty"`
+	CityName          string                 `protobuf:"bytes,6,opt,name=CityName,proto3" json:"CityName,omitempty"`
+	Translation       string                 `protobuf:"bytes,7,opt,name=Translation,proto3" json:"Translation,omitempty"`
+	Phone             string                 `protobuf:"bytes,8,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Avatar            string                 `protobuf:"bytes,9,opt,name=Avatar,proto3" json:"Avatar,omitempty"`
+	RegisterTime      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=RegisterTime,proto3" json:"RegisterTime,omitempty"`
+	Rating            float32                `protobuf:"fixed32,11,opt,name=Rating,proto3" json:"Rating,omitempty"`
+	ReactionsCount    float32                `protobuf:"fixed32,12,opt,name=ReactionsCount,proto3" json:"ReactionsCount,omitempty"`
+	Approved          bool                   `protobuf:"varint,13,opt,name=Approved,proto3" json:"Approved,omitempty"`
+	MerchantsName     string                 `protobuf:"bytes,14,opt,name=MerchantsName,proto3" json:"MerchantsName,omitempty"`
+	SubersCount       int64                  `protobuf:"varint,15,opt,name=SubersCount,proto3" json:"SubersCount,omitempty"`
+	SubonsCount       int64                  `protobuf:"varint,16,opt,name=SubonsCount,proto3" json:"SubonsCount,omitempty"`
+	AvatarIMG         string                 `protobuf:"bytes,17,opt,name=AvatarIMG,proto3" json:"AvatarIMG,omitempty"`
+	ActiveAddsCount   int64                  `protobuf:"varint,18,opt,name=ActiveAddsCount,proto3" json:"ActiveAddsCount,omitempty"`
+	SoldAddsCount     int64                  `protobuf:"varint,19,opt,name=SoldAddsCount,proto3" json:"SoldAddsCount,omitempty"`
+	CartNum           int64                  `protobuf:"varint,20,opt,name=CartNum,proto3" json:"CartNum,omitempty"`
+	FavNum            int64                  `protobuf:"varint,21,opt,name=FavNum,proto3" json:"FavNum,omitempty"`
+	UnreadMessagesNum int64                  `protobuf:"varint,22,opt,name=UnreadMessagesNum,proto3" json:"UnreadMessagesNum,omitempty"`
+	PhoneVerified     bool                   `protobuf:"varint,23,opt,name=PhoneVerified,proto3" json:"PhoneVerified,omitempty"`
 }
 
 func (x *ProfileData) Reset() {
@@ -379,6 +381,20 @@ func (x *ProfileData) GetFavNum() int64 {
 	return 0
 }
 
+func (x *ProfileData) GetUnreadMessagesNum() int64 {
+	if x != nil {
+		return x.UnreadMessagesNum
+	}
+	return 0
+}
+
+func (x *ProfileData) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
 type SetCityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -592,7 +608,7 @@ var file_profile_proto_rawDesc = []byte{
 	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
 	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x66,
 	0x69, 0x6c, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0x8d, 0x05, 0x0a,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xe1, 0x05, 0x0a,
 	0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
 	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x55, 0x73,
@@ -633,48 +649,53 @@ var file_profile_proto_rawDesc = []byte{
 	0x64, 0x41, 0x64, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x61,
 	0x72, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x43, 0x61, 0x72,
 	0x74, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x76, 0x4e, 0x75, 0x6d, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x46, 0x61, 0x76, 0x4e, 0x75, 0x6d, 0x22, 0x76, 0x0a, 0x0e,
-	0x53, 0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x43, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x43, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x46, 0x61, 0x76, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x11,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4e, 0x75,
+	0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x22, 0x76, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x43, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x22, 0x6a, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x6a, 0x0a,
-	0x12, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xc0, 0x02, 0x0a, 0x07, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x74, 0x79, 0x12, 0x0f, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x2e, 0x53, 0x65, 0x74,
-	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x0b, 0x45, 0x64,
-	0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x45, 0x64, 0x69, 0x74,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
-	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x0e,
-	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x18,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x53, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26, 0x5a, 0x24,
-	0x2e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xc0, 0x02,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x74, 0x79, 0x12, 0x0f, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x10,
+	0x2e, 0x53, 0x65, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30,
+	0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x3e, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x42, 0x79, 0x49,
+	0x44, 0x73, 0x12, 0x18, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x4d, 0x65, 0x72, 0x63, 0x68,
+	0x61, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x26, 0x5a, 0x24, 0x2e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
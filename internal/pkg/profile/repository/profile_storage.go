@@ -7,12 +7,12 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
-	"os"
 	"time"
 
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/repository"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
@@ -29,12 +29,14 @@ var (
 type ProfileStorage struct {
 	pool    *pgxpool.Pool
 	metrics *mymetrics.DatabaseMetrics
+	blobs   blobstore.BlobStore
 }
 
-func NewProfileStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *ProfileStorage {
+func NewProfileStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics, blobs blobstore.BlobStore) *ProfileStorage {
 	return &ProfileStorage{
 		pool:    pool,
 		metrics: metrics,
+		blobs:   blobs,
 	}
 }
 
@@ -113,6 +115,7 @@ func (pl *ProfileStorage) getProfileByUserID(ctx context.Context, tx pgx.Tx, id
 			p.surname, 
 			p.regtime, 
 			p.verified, 
+			p.phone_verified,
 			p.avatar_url,
 			c.name AS city_name,
 			c.translation AS city_translation,
@@ -122,7 +125,8 @@ func (pl *ProfileStorage) getProfileByUserID(ctx context.Context, tx pgx.Tx, id
 			(SELECT COUNT(*) FROM advert WHERE user_id = $1 AND advert_status = 'Активно') AS active_ads_count,
 			(SELECT COUNT(*) FROM advert WHERE user_id = $1 AND advert_status = 'Продано') AS sold_ads_count,
 			p.cart_adverts_number,
-			p.fav_adverts_number
+			p.fav_adverts_number,
+			p.unread_messages_number
 		FROM 
 			public.profile p
 		INNER JOIN 
@@ -143,9 +147,9 @@ func (pl *ProfileStorage) getProfileByUserID(ctx context.Context, tx pgx.Tx, id
 	profilePad := models.ProfilePad{}
 
 	if err := profileLine.Scan(&profile.ID, &profile.UserID, &city.ID, &profilePad.Phone, &profilePad.Name,
-		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profilePad.Avatar, &city.CityName, &city.Translation, &profile.SubersCount,
+		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profile.PhoneVerified, &profilePad.Avatar, &city.CityName, &city.Translation, &profile.SubersCount,
 		&profile.SubonsCount, &profile.ReactionsCount, &profile.ActiveAddsCount, &profile.SoldAddsCount,
-		&profile.CartNum, &profile.FavNum); err != nil {
+		&profile.CartNum, &profile.FavNum, &profile.UnreadMessages); err != nil {
 
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning profile, err=%w", err))
 
@@ -207,12 +211,7 @@ func (pl *ProfileStorage) GetProfileByUserID(ctx context.Context, userID uint) (
 		return nil, errProfileNotExists
 	}
 
-	profile.AvatarIMG, err = utils.DecodeImage(profile.Avatar)
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("error occurred while decoding avatar image, err = %w", err))
-
-		return nil, err
-	}
+	profile.Avatar, profile.AvatarIMG = utils.MediaImage(ctx, pl.blobs, profile.Avatar)
 
 	profile.Sanitize()
 
@@ -227,7 +226,7 @@ func (pl *ProfileStorage) setProfileCity(ctx context.Context, tx pgx.Tx, userID
 	SET city_id = $1
 	FROM public.city c
 	WHERE c.id = $1 AND p.user_id = $2
-	RETURNING p.id, p.user_id, p.city_id, p.phone, p.name, p.surname, p.regtime, p.verified, p.avatar_url, c.name AS city_name, c.translation AS city_translation;`
+	RETURNING p.id, p.user_id, p.city_id, p.phone, p.name, p.surname, p.regtime, p.verified, p.phone_verified, p.avatar_url, c.name AS city_name, c.translation AS city_translation;`
 
 	logging.LogInfo(logger, "UPDATE profile")
 
@@ -240,7 +239,7 @@ func (pl *ProfileStorage) setProfileCity(ctx context.Context, tx pgx.Tx, userID
 	profilePad := models.ProfilePad{}
 
 	if err := profileLine.Scan(&profile.ID, &profile.UserID, &city.ID, &profilePad.Phone, &profilePad.Name,
-		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profilePad.Avatar, &city.CityName, &city.Translation); err != nil {
+		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profile.PhoneVerified, &profilePad.Avatar, &city.CityName, &city.Translation); err != nil {
 
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning profile lines, err=%w", err))
 
@@ -302,12 +301,7 @@ func (pl *ProfileStorage) SetProfileCity(ctx context.Context, userID uint, data
 		return nil, errProfileNotExists
 	}
 
-	profile.AvatarIMG, err = utils.DecodeImage(profile.Avatar)
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("error occurred while decoding avatar image, err = %w", err))
-
-		return nil, err
-	}
+	profile.Avatar, profile.AvatarIMG = utils.MediaImage(ctx, pl.blobs, profile.Avatar)
 
 	profile.Sanitize()
 
@@ -318,11 +312,12 @@ func (pl *ProfileStorage) setProfilePhone(ctx context.Context, tx pgx.Tx, userID
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
+	// подтверждённый по SMS номер перестаёт считаться подтверждённым, как только его меняют вручную
 	SQLUpdateProfilePhone := `UPDATE public.profile p
-	SET phone = $1
+	SET phone = $1, phone_verified = p.phone_verified AND p.phone IS NOT DISTINCT FROM $1
 	FROM public.city c
 	WHERE c.id = p.city_id AND p.user_id = $2
-	RETURNING p.id, p.user_id, p.city_id, p.phone, p.name, p.surname, p.regtime, p.verified, p.avatar_url, c.name AS city_name, c.translation AS city_translation;`
+	RETURNING p.id, p.user_id, p.city_id, p.phone, p.name, p.surname, p.regtime, p.verified, p.phone_verified, p.avatar_url, c.name AS city_name, c.translation AS city_translation;`
 
 	logging.LogInfo(logger, "UPDATE profile")
 
@@ -335,7 +330,7 @@ func (pl *ProfileStorage) setProfilePhone(ctx context.Context, tx pgx.Tx, userID
 	profilePad := models.ProfilePad{}
 
 	if err := profileLine.Scan(&profile.ID, &profile.UserID, &city.ID, &profilePad.Phone, &profilePad.Name,
-		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profilePad.Avatar, &city.CityName, &city.Translation); err != nil {
+		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profile.PhoneVerified, &profilePad.Avatar, &city.CityName, &city.Translation); err != nil {
 
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning profile lines , err=%w", err))
 
@@ -397,12 +392,7 @@ func (pl *ProfileStorage) SetProfilePhone(ctx context.Context, userID uint, data
 		return nil, errProfileNotExists
 	}
 
-	profile.AvatarIMG, err = utils.DecodeImage(profile.Avatar)
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("error occurred while decoding avatar image, err = %w", err))
-
-		return nil, err
-	}
+	profile.Avatar, profile.AvatarIMG = utils.MediaImage(ctx, pl.blobs, profile.Avatar)
 
 	profile.Sanitize()
 
@@ -460,8 +450,12 @@ func (pl *ProfileStorage) deleteAvatar(ctx context.Context, tx pgx.Tx, userID ui
 	}
 
 	if oldUrl != nil {
-		os.Remove(oldUrl.(string))
+		if err := pl.blobs.Delete(ctx, oldUrl.(string)); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while deleting avatar %s, err=%w",
+				oldUrl, err))
+		}
 	}
+
 	return nil
 }
 
@@ -508,7 +502,7 @@ func (pl *ProfileStorage) setProfileInfo(ctx context.Context, tx pgx.Tx, userID
 			surname = $2
 		FROM public.city c
 		WHERE c.id = p.city_id AND p.user_id = $3
-		RETURNING p.id, p.user_id, p.city_id, p.phone, p.name, p.surname, p.regtime, p.verified, p.avatar_url, 
+		RETURNING p.id, p.user_id, p.city_id, p.phone, p.name, p.surname, p.regtime, p.verified, p.phone_verified, p.avatar_url, 
 			c.name AS city_name, c.translation AS city_translation;`
 
 	logging.LogInfo(logger, "UPDATE profile")
@@ -522,7 +516,7 @@ func (pl *ProfileStorage) setProfileInfo(ctx context.Context, tx pgx.Tx, userID
 	profilePad := models.ProfilePad{}
 
 	if err := profileLine.Scan(&profile.ID, &profile.UserID, &city.ID, &profilePad.Phone, &profilePad.Name,
-		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profilePad.Avatar,
+		&profilePad.Surname, &profile.RegisterTime, &profile.Approved, &profile.PhoneVerified, &profilePad.Avatar,
 		&city.CityName, &city.Translation); err != nil {
 
 		logging.LogError(logger, fmt.Errorf("something went wrong while scanning profile lines , err=%w", err))
@@ -599,12 +593,7 @@ func (pl *ProfileStorage) SetProfileInfo(ctx context.Context, userID uint,
 		return nil, errProfileNotExists
 	}
 
-	profile.AvatarIMG, err = utils.DecodeImage(profile.Avatar)
-	if err != nil {
-		logging.LogError(logger, fmt.Errorf("error occurred while decoding avatar image, err = %w", err))
-
-		return nil, err
-	}
+	profile.Avatar, profile.AvatarIMG = utils.MediaImage(ctx, pl.blobs, profile.Avatar)
 
 	profile.Sanitize()
 
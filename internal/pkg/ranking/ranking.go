@@ -0,0 +1,143 @@
+package ranking
+
+import (
+	"sort"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	ListingCity     = "city"
+	ListingCategory = "category"
+	ListingSearch   = "search"
+)
+
+//nolint:gochecknoglobals
+var defaultPromotedSlots = []int{1, 2, 3, 4, 5}
+
+// Policy расставляет продвигаемые объявления по фиксированным позициям страницы, остальные позиции
+// заполняются обычной выдачей. Если продвигаемых не хватает, их места тоже занимает обычная выдача.
+type Policy struct {
+	// номера позиций с нуля, по возрастанию
+	slots          []int
+	rotationPeriod time.Duration
+}
+
+func NewPolicy(cfg config.RankingPolicyConfig) *Policy {
+	policy := &Policy{rotationPeriod: cfg.RotationPeriod}
+
+	slots := cfg.PromotedSlots
+	if len(slots) == 0 {
+		slots = defaultPromotedSlots
+	}
+
+	seen := make(map[int]bool, len(slots))
+
+	for _, slot := range slots {
+		if slot > 0 && !seen[slot] {
+			seen[slot] = true
+			policy.slots = append(policy.slots, slot-1)
+		}
+	}
+
+	sort.Ints(policy.slots)
+
+	return policy
+}
+
+// Window - какие срезы продвигаемой и обычной выдачи попадают на страницу.
+type Window struct {
+	PromotedOffset int
+	PromotedLimit  int
+	OrganicOffset  int
+	OrganicLimit   int
+	// сдвиг циклического порядка продвигаемых объявлений, всегда меньше их числа
+	Rotation int
+}
+
+// SlotsPerPage - сколько позиций под продвижение есть на странице размера pageSize.
+func (p *Policy) SlotsPerPage(pageSize int) int {
+	return sort.SearchInts(p.slots, pageSize)
+}
+
+// Window считает срезы для страницы page (с нуля), если всего подходящих продвигаемых объявлений promotedTotal.
+func (p *Policy) Window(page, pageSize, promotedTotal int, now time.Time) Window {
+	slots := p.SlotsPerPage(pageSize)
+	promotedBefore := min(promotedTotal, page*slots)
+	promotedHere := min(slots, promotedTotal-promotedBefore)
+
+	return Window{
+		PromotedOffset: promotedBefore,
+		PromotedLimit:  promotedHere,
+		OrganicOffset:  page*pageSize - promotedBefore,
+		OrganicLimit:   pageSize - promotedHere,
+		Rotation:       p.rotation(promotedTotal, now),
+	}
+}
+
+// rotation меняется раз в rotationPeriod, поэтому в пределах периода листание страниц согласовано,
+// а со временем каждое продвигаемое объявление побывает в начале выдачи.
+func (p *Policy) rotation(promotedTotal int, now time.Time) int {
+	if p.rotationPeriod <= 0 || promotedTotal <= 1 {
+		return 0
+	}
+
+	return int((now.UnixNano() / int64(p.rotationPeriod)) % int64(promotedTotal))
+}
+
+// Merge собирает страницу: продвигаемые встают на свои позиции, пока не кончатся, остальное - обычная выдача.
+func Merge[T any](p *Policy, pageSize int, promoted, organic []T) []T {
+	page := make([]T, 0, min(pageSize, len(promoted)+len(organic)))
+	slots := p.slots
+
+	for position := 0; position < pageSize && len(promoted)+len(organic) > 0; position++ {
+		isSlot := len(slots) > 0 && slots[0] == position
+		if isSlot {
+			slots = slots[1:]
+		}
+
+		if (isSlot && len(promoted) > 0) || len(organic) == 0 {
+			page = append(page, promoted[0])
+			promoted = promoted[1:]
+
+			continue
+		}
+
+		page = append(page, organic[0])
+		organic = organic[1:]
+	}
+
+	return page
+}
+
+// Ranker хранит политики для разных выдач.
+type Ranker struct {
+	defaultPolicy *Policy
+	policies      map[string]*Policy
+}
+
+func NewRanker(cfg config.RankingConfig) *Ranker {
+	ranker := &Ranker{
+		defaultPolicy: NewPolicy(cfg.Default),
+		policies:      make(map[string]*Policy, len(cfg.Listings)),
+	}
+
+	for listing, policyConfig := range cfg.Listings {
+		if policyConfig.RotationPeriod == 0 {
+			policyConfig.RotationPeriod = cfg.Default.RotationPeriod
+		}
+
+		ranker.policies[listing] = NewPolicy(policyConfig)
+	}
+
+	return ranker
+}
+
+func (r *Ranker) Policy(listing string) *Policy {
+	if policy, ok := r.policies[listing]; ok {
+		return policy
+	}
+
+	return r.defaultPolicy
+}
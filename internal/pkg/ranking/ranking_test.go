@@ -0,0 +1,105 @@
+//nolint:all
+package ranking_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/ranking"
+)
+
+func TestPolicyWindow(t *testing.T) {
+	t.Parallel()
+
+	policy := ranking.NewPolicy(config.RankingPolicyConfig{PromotedSlots: []int{1, 5, 9, 13, 17}})
+	now := time.Unix(0, 0)
+
+	tests := []struct {
+		name     string
+		page     int
+		pageSize int
+		total    int
+		want     ranking.Window
+	}{
+		{name: "Enough promoted", page: 1, pageSize: 20, total: 100,
+			want: ranking.Window{PromotedOffset: 5, PromotedLimit: 5, OrganicOffset: 15, OrganicLimit: 15}},
+		{name: "Promoted run out on page", page: 1, pageSize: 20, total: 7,
+			want: ranking.Window{PromotedOffset: 5, PromotedLimit: 2, OrganicOffset: 15, OrganicLimit: 18}},
+		{name: "Promoted ran out earlier", page: 3, pageSize: 20, total: 7,
+			want: ranking.Window{PromotedOffset: 7, PromotedLimit: 0, OrganicOffset: 53, OrganicLimit: 20}},
+		{name: "Small page uses only its slots", page: 2, pageSize: 8, total: 100,
+			want: ranking.Window{PromotedOffset: 4, PromotedLimit: 2, OrganicOffset: 12, OrganicLimit: 6}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := policy.Window(tt.page, tt.pageSize, tt.total, now); got != tt.want {
+				t.Errorf("Window() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	policy := ranking.NewPolicy(config.RankingPolicyConfig{PromotedSlots: []int{1, 5, 9}})
+
+	tests := []struct {
+		name     string
+		promoted []string
+		organic  []string
+		want     []string
+	}{
+		{name: "Slots filled", promoted: []string{"p1", "p2", "p3"}, organic: []string{"o1", "o2", "o3", "o4", "o5", "o6", "o7"},
+			want: []string{"p1", "o1", "o2", "o3", "p2", "o4", "o5", "o6", "p3", "o7"}},
+		{name: "Organic fills empty slots", promoted: []string{"p1"}, organic: []string{"o1", "o2", "o3", "o4", "o5"},
+			want: []string{"p1", "o1", "o2", "o3", "o4", "o5"}},
+		{name: "Promoted fill the tail", promoted: []string{"p1", "p2", "p3"}, organic: []string{"o1"},
+			want: []string{"p1", "o1", "p2", "p3"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ranking.Merge(policy, 10, tt.promoted, tt.organic); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotationCoversAllPromoted(t *testing.T) {
+	t.Parallel()
+
+	ranker := ranking.NewRanker(config.RankingConfig{
+		Default:  config.RankingPolicyConfig{RotationPeriod: time.Hour},
+		Listings: map[string]config.RankingPolicyConfig{ranking.ListingSearch: {PromotedSlots: []int{1}}},
+	})
+
+	policy := ranker.Policy(ranking.ListingSearch)
+	seen := map[int]bool{}
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	for hour := 0; hour < 24; hour++ {
+		window := policy.Window(0, 20, 4, start.Add(time.Duration(hour)*time.Hour))
+		if window.PromotedLimit != 1 {
+			t.Fatalf("PromotedLimit = %d, want 1", window.PromotedLimit)
+		}
+
+		seen[window.Rotation] = true
+	}
+
+	if len(seen) != 4 {
+		t.Errorf("rotation visited %v, want all 4 offsets", seen)
+	}
+}
@@ -3,12 +3,19 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/mailer"
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	createMediaMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/media"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/sms"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 
 	cartproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/delivery/protobuf"
@@ -24,11 +31,32 @@ import (
 
 	advertrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/repository"
 	cartrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/repository"
+	cartusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/usecases"
+	categoryrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/repository"
 	cityrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/city/repository"
+	cityusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/city/usecases"
+	emailsrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/repository"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
 	favrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/favourites/repository"
+	messagingrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/repository"
+	messagingusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/usecases"
+	moderationrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/repository"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	notificationsrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/repository"
+	notificationsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
 	orderrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/repository"
 	paymentsrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/payments/repository"
+	paymentsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/payments/usecases"
+	phonerepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/repository"
+	phoneusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/ranking"
+	subscriptionsrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/repository"
+	suggestrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/suggestions/repository"
+	suggestusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/suggestions/usecases"
 	surveyrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/survey/repository"
+	uploadgcrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/uploadgc/repository"
+	uploadgcusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/uploadgc/usecases"
+	verificationrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/repository"
 	"github.com/gorilla/handlers"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -39,10 +67,15 @@ const (
 	outputLogPath      = "stdout logs.json"
 	errorOutputLogPath = "stderr err_logs.json"
 	tickerTime         = 10 * time.Minute
+	// сколько после остановки HTTP ждать отправки уже поставленных писем
+	mailDrainTimeout = 10 * time.Second
 )
 
 type Server struct {
-	server *http.Server
+	// Shutdown приходит по сигналу из другой горутины, пока Run ещё может собирать сервер
+	mu        sync.Mutex
+	server    *http.Server
+	mailQueue *mailer.Queue
 }
 
 func (srv *Server) Run() error {
@@ -64,15 +97,40 @@ func (srv *Server) Run() error {
 
 	defer logger.Sync()
 
-	advertStorage := advertrepo.NewAdvertStorage(connPool, postgresMetrics)
-	cartStorage := cartrepo.NewCartStorage(connPool, postgresMetrics)
-	cityStorage := cityrepo.NewCityStorage(connPool, postgresMetrics)
-	orderStorage := orderrepo.NewOrderStorage(connPool, postgresMetrics)
+	cfg := config.ReadConfig()
+
+	blobStore, err := blobstore.New(cfg.BlobStore)
+	if err != nil {
+		log.Println("Error occurred while creating blob store", err)
+
+		return err
+	}
+
+	imagePipeline := utils.NewImagePipeline(cfg.Images, blobStore)
+	uploadValidator := utils.NewUploadValidator(cfg.Uploads)
+
+	advertStorage := advertrepo.NewAdvertStorage(connPool, postgresMetrics, imagePipeline, blobStore,
+		ranking.NewRanker(cfg.Ranking))
+	cartStorage := cartrepo.NewCartStorage(connPool, postgresMetrics, blobStore)
+	cityStorage := cityusecases.NewCityCache(cityrepo.NewCityStorage(connPool, postgresMetrics), cfg.Cities)
+	categoryStorage := categoryrepo.NewCategoryStorage(connPool, postgresMetrics)
+	orderStorage := orderrepo.NewOrderStorage(connPool, postgresMetrics, blobStore)
 	surveyStorage := surveyrepo.NewSurveyStorage(connPool, postgresMetrics)
-	favouritesStorage := favrepo.NewFavouritesStorage(connPool, postgresMetrics)
+	favouritesStorage := favrepo.NewFavouritesStorage(connPool, postgresMetrics, blobStore)
 	paymentsStorage := paymentsrepo.NewPaymentsStorage(connPool, postgresMetrics)
+	moderationStorage := moderationrepo.NewModerationStorage(connPool, postgresMetrics)
+	notificationsStorage := notificationsrepo.NewNotificationsStorage(connPool, postgresMetrics)
+	messagingStorage := messagingrepo.NewMessagingStorage(connPool, postgresMetrics)
+	emailsStorage := emailsrepo.NewEmailsStorage(connPool, postgresMetrics)
+	subscriptionsStorage := subscriptionsrepo.NewSubscriptionsStorage(connPool, postgresMetrics, blobStore)
+	verificationStorage := verificationrepo.NewVerificationStorage(connPool, postgresMetrics, blobStore)
+
+	advertModerator, err := moderationusecases.NewAdvertModerator(cfg.Moderation)
+	if err != nil {
+		log.Println("Error occurred while creating advert moderator", err)
 
-	cfg := config.ReadConfig()
+		return err
+	}
 
 	authAddr := cfg.Server.AuthIP + cfg.Server.AuthServicePort // ДЛЯ ЗАПУСКА В КОНТЕЙНЕРЕ
 	//authAddr := cfg.Server.Host + cfg.Server.AuthServicePort // ДЛЯ ЛОКАЛЬНОГО ЗАПУСКА (НЕ В КОНТЕЙНЕРЕ)
@@ -96,6 +154,7 @@ func (srv *Server) Run() error {
 	grpcConnProfile, err := grpc.Dial(
 		profileAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(createMediaMiddleware.InlineImagesClientInterceptor),
 	)
 
 	if err != nil {
@@ -113,6 +172,7 @@ func (srv *Server) Run() error {
 	grpcConnCart, err := grpc.Dial(
 		cartAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(createMediaMiddleware.InlineImagesClientInterceptor),
 	)
 
 	if err != nil {
@@ -137,35 +197,108 @@ func (srv *Server) Run() error {
 		}
 	}()
 
-	router := myrouter.NewRouter(logger, advertStorage, cartClient, cartStorage, cityStorage, orderStorage,
-		surveyStorage, authClient, profileClient, favouritesStorage, paymentsStorage)
+	uploadCollector := uploadgcusecases.NewCollector(uploadgcrepo.NewUploadGCStorage(connPool, postgresMetrics),
+		blobStore)
+	runScheduledUploadGC(backgroundContext(logger), cfg.UploadGC, uploadCollector)
+
+	suggester := suggestusecases.NewSuggester(suggestrepo.NewSearchLogStorage(connPool, postgresMetrics), cfg.Search)
+	runScheduledSuggestionsRebuild(backgroundContext(logger), cfg.Search, suggester)
+
+	notificationsBroker := notificationsusecases.NewBroker()
+	notifier := notificationsusecases.NewNotifier(notificationsStorage)
+	runNotificationsListener(backgroundContext(logger), notificationsStorage, notificationsBroker)
+
+	mailSender, err := mailer.New(cfg.Mailer)
+	if err != nil {
+		log.Println("Error occurred while creating mailer", err)
+
+		return err
+	}
+
+	mailTemplates, err := mailer.LoadTemplates()
+	if err != nil {
+		log.Println("Error occurred while loading mail templates", err)
+
+		return err
+	}
+
+	mailQueue := mailer.NewQueue(mailSender, cfg.Mailer.Queue)
+	mailQueue.Start(backgroundContext(logger))
+
+	srv.mu.Lock()
+	srv.mailQueue = mailQueue
+	srv.mu.Unlock()
+
+	emailer := emailsusecases.NewEmailer(emailsStorage, srv.mailQueue, mailTemplates, cfg.Mailer.BaseURL)
+
+	smsSender, err := sms.New(cfg.SMS)
+	if err != nil {
+		log.Println("Error occurred while creating sms sender", err)
+
+		return err
+	}
+
+	redisPool := pgxpoolconfig.NewRedisPool(os.Getenv("REDIS_HOST")+":"+os.Getenv("REDIS_PORT_ENV"),
+		os.Getenv("REDIS_PASSWORD"))
+	phoneVerifier := phoneusecases.NewPhoneVerifier(phonerepo.NewOTPStorage(redisPool),
+		phonerepo.NewPhoneStorage(connPool, postgresMetrics), smsSender, cfg.Phone)
+
+	deliveryPricing := cartusecases.NewDeliveryPricing(cfg.Delivery, cityStorage)
+
+	router := myrouter.NewRouter(logger, advertStorage, cartClient, cartStorage, deliveryPricing, cityStorage,
+		categoryStorage, orderStorage, cfg.Reservation.Duration, surveyStorage, authClient, profileClient,
+		favouritesStorage, paymentsStorage, paymentsusecases.NewBumpPolicy(cfg.Bump), moderationStorage,
+		advertModerator, notificationsStorage, notificationsBroker, notifier, messagingStorage,
+		messagingusecases.NewHub(), emailsStorage, emailer, subscriptionsStorage, verificationStorage, imagePipeline,
+		uploadValidator, suggester, blobStore, phoneVerifier)
 
 	credentials := handlers.AllowCredentials()
-	headersOk := handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type"})
+	headersOk := handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type",
+		createMediaMiddleware.InlineImagesHeader})
 	originsOk := handlers.AllowedOrigins([]string{"http://www.vol-4-ok.ru", "http://vol-4-ok.ru",
 		"http://127.0.0.1:8008", "http://127.0.0.1"})
 	methodsOk := handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "PUT", "OPTIONS"})
 
 	muxWithCORS := handlers.CORS(credentials, originsOk, headersOk, methodsOk)(router)
 
-	srv.server = &http.Server{
+	httpServer := &http.Server{
 		Addr:         Address,
 		Handler:      muxWithCORS,
 		ReadTimeout:  Timeout,
 		WriteTimeout: Timeout,
 	}
 
+	srv.mu.Lock()
+	srv.server = httpServer
+	srv.mu.Unlock()
+
 	log.Println("Server is running on port", Address)
 
-	return srv.server.ListenAndServe()
+	return httpServer.ListenAndServe()
 }
 
 func (srv *Server) Shutdown() error {
+	srv.mu.Lock()
+	httpServer, mailQueue := srv.server, srv.mailQueue
+	srv.mu.Unlock()
+
 	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
 	defer cancel()
 
-	if err := srv.server.Shutdown(ctx); err != nil {
-		return err
+	if httpServer != nil {
+		if err := httpServer.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+
+	// запросы завершены, новых писем не будет - дожидаемся отправки уже поставленных
+	if mailQueue != nil {
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), mailDrainTimeout)
+		defer drainCancel()
+
+		if err := mailQueue.Close(drainCtx); err != nil {
+			return fmt.Errorf("mail queue was not drained, err=%w", err)
+		}
 	}
 
 	return nil
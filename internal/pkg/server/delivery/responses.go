@@ -16,6 +16,10 @@ const (
 	StatusForbidden    = 403
 	StatusNotFound     = 404
 	StatusNotAllowed   = 405
+	StatusConflict     = 409
+
+	StatusRequestEntityTooLarge = 413
+	StatusTooManyRequests       = 429
 
 	StatusInternalServerError = 500
 )
@@ -29,6 +33,55 @@ const (
 	ErrNotValidData       = "User data is not valid"
 
 	ErrAdvertNotExist = "Advert does not exist"
+	ErrUploadNotValid = "Uploaded files are not valid"
+	ErrBumpNotAllowed = "Advert can not be bumped now"
+
+	ErrAdvertUnavailable = "Advert is no longer available"
+	ErrDeliveryNotValid  = "Delivery method is not available"
+	ErrAdvertReserved    = "Advert is reserved by another buyer"
+	ErrReservationLost   = "Reservation has expired and the advert is no longer available to you"
+	ErrOrderNotExist     = "Order does not exist"
+	ErrOrderStatus       = "Order can not be moved to this status"
+
+	ErrCategoryNotExist = "Category does not exist"
+	ErrCategoryCycle    = "Category can not be moved into its own subtree"
+	ErrCategoryNotEmpty = "Category has subcategories or adverts"
+
+	ErrCollectionNotExist = "Favourite collection does not exist"
+	ErrCollectionExists   = "Favourite collection with same name already exists"
+	ErrFavouriteNotExist  = "Advert is not in favourites"
+
+	ErrNotificationNotExist = "Notification does not exist"
+
+	ErrConversationNotExist = "Conversation does not exist"
+	ErrOwnAdvertChat        = "Can not start conversation about own advert"
+
+	ErrMerchantNotExist = "Merchant does not exist"
+
+	ErrVerificationNotValid = "Verification request is not valid"
+	ErrVerificationPending  = "Verification request is already under review"
+	ErrVerificationResolved = "Verification request is already resolved"
+	ErrVerificationNotExist = "Verification request does not exist"
+	ErrAlreadyVerified      = "Seller is already verified"
+	ErrDocumentNotExist     = "Document does not exist"
+
+	ErrPhoneNotValid        = "Phone number is not valid"
+	ErrPhoneNotVerified     = "Phone number is not verified"
+	ErrPhoneAlreadyVerified = "Phone number is already verified"
+	ErrCodeNotRequested     = "Confirmation code has expired or was not requested"
+	ErrWrongCode            = "Wrong confirmation code"
+	ErrTooManyAttempts      = "Too many wrong codes, request a new one"
+	ErrTooManyCodeRequests  = "Confirmation code requested too often, try again later"
+
+	ErrEmailTokenInvalid     = "Confirmation link is invalid or expired"
+	ErrEmailAlreadyConfirmed = "Email is already confirmed or the link is outdated"
+	ErrTooManyEmailRequests  = "Confirmation email requested too often, try again later"
+
+	ErrPasswordInvalid   = "Password does not meet requirements or passwords do not match"
+	ErrWrongPassword     = "Wrong current password"
+	ErrResetTokenInvalid = "Password reset link is invalid, expired or already used"
+
+	ErrSessionNotFound = "Session not found or already expired"
 
 	ErrInternalServer = "Server error"
 	ErrBadRequest     = "Bad request"
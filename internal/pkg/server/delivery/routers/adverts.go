@@ -21,8 +21,17 @@ func ServeAdvertsRouter(router *mux.Router, advertsHandler *delivery.AdvertsHand
 	subrouterPromotion.Use(authCheckMiddleware)
 	subrouterPromotion.HandleFunc("/{id:[0-9]+}", advertsHandler.GetPromotionData).Methods("GET")
 
+	subrouterPhotos := subrouter.PathPrefix("/photos/{id:[0-9]+}").Subrouter()
+	subrouterPhotos.Use(authCheckMiddleware, csrfMiddleware)
+	subrouterPhotos.HandleFunc("/add", advertsHandler.AddAdvertImage).Methods("POST")
+	subrouterPhotos.HandleFunc("/delete", advertsHandler.DeleteAdvertImage).Methods("POST")
+	subrouterPhotos.HandleFunc("/reorder", advertsHandler.ReorderAdvertImages).Methods("POST")
+	subrouterPhotos.HandleFunc("/cover", advertsHandler.SetAdvertCover).Methods("POST")
+
+	subrouter.HandleFunc("/photos/{id:[0-9]+}", advertsHandler.GetAdvertImages).Methods("GET")
 	subrouter.HandleFunc("/search", advertsHandler.GetAdsListWithSearch).Methods("GET")
 	subrouter.HandleFunc("/suggestions", advertsHandler.GetSuggestions).Methods("GET")
+	subrouter.HandleFunc("/trending", advertsHandler.GetTrendingSearches).Methods("GET")
 	subrouter.HandleFunc("/price_history/{id:[0-9]+}", advertsHandler.GetAdvertPriceHistoryByID).
 		Methods("GET")
 	subrouter.HandleFunc("/", advertsHandler.GetAdsList).Methods("GET")
@@ -14,6 +14,23 @@ func ServeAuthRouter(router *mux.Router, authHandler *delivery.AuthHandler,
 	subrouter.HandleFunc("/logout", authHandler.Logout).Methods("POST")
 	subrouter.HandleFunc("/signup", authHandler.Signup).Methods("POST")
 	subrouter.HandleFunc("/edit/email", authHandler.EditUserEmail).Methods("POST")
+	subrouter.HandleFunc("/email/confirm", authHandler.ConfirmEmail).Methods("POST")
+	subrouter.HandleFunc("/password/reset/request", authHandler.RequestPasswordReset).Methods("POST")
+	subrouter.HandleFunc("/password/reset", authHandler.ResetPassword).Methods("POST")
+
+	subrouterEmail := subrouter.PathPrefix("/email/resend").Subrouter()
+	subrouterEmail.Use(authCheckMiddleware)
+	subrouterEmail.HandleFunc("", authHandler.ResendEmailConfirmation).Methods("POST")
+
+	subrouterPassword := subrouter.PathPrefix("/password/change").Subrouter()
+	subrouterPassword.Use(authCheckMiddleware)
+	subrouterPassword.HandleFunc("", authHandler.ChangePassword).Methods("POST")
+
+	subrouterSessions := subrouter.PathPrefix("/sessions").Subrouter()
+	subrouterSessions.Use(authCheckMiddleware)
+	subrouterSessions.HandleFunc("", authHandler.ListSessions).Methods("GET")
+	subrouterSessions.HandleFunc("/revoke", authHandler.RevokeSession).Methods("POST")
+	subrouterSessions.HandleFunc("/revoke_others", authHandler.RevokeOtherSessions).Methods("POST")
 
 	subrouterCSRF := subrouter.PathPrefix("/csrf").Subrouter()
 	subrouterCSRF.Use(authCheckMiddleware)
@@ -10,6 +10,8 @@ func ServeCartRouter(router *mux.Router, cartHandler *delivery.CartHandler, auth
 	subrouter.Use(authCheckMiddleware)
 
 	subrouter.HandleFunc("/list", cartHandler.GetCartList).Methods("GET")
+	subrouter.HandleFunc("/summary", cartHandler.GetCartSummary).Methods("GET")
 	subrouter.HandleFunc("/change", cartHandler.ChangeCart).Methods("POST")
 	subrouter.HandleFunc("/delete", cartHandler.DeleteFromCart).Methods("POST")
+	subrouter.HandleFunc("/clean", cartHandler.CleanCart).Methods("POST")
 }
@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/delivery"
+	"github.com/gorilla/mux"
+)
+
+func ServeCategoryRouter(router *mux.Router, categoryHandler *delivery.CategoryHandler,
+	authCheckMiddleware, csrfMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/categories").Subrouter()
+	subrouter.HandleFunc("", categoryHandler.GetCategoryTree).Methods("GET")
+
+	subrouterAdmin := subrouter.PathPrefix("").Subrouter()
+	subrouterAdmin.Use(authCheckMiddleware, csrfMiddleware)
+	subrouterAdmin.HandleFunc("/create", categoryHandler.CreateCategory).Methods("POST")
+	subrouterAdmin.HandleFunc("/{id:[0-9]+}/edit", categoryHandler.EditCategory).Methods("POST")
+	subrouterAdmin.HandleFunc("/{id:[0-9]+}/delete", categoryHandler.DeleteCategory).Methods("POST")
+}
@@ -0,0 +1,15 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/delivery"
+	"github.com/gorilla/mux"
+)
+
+func ServeEmailsRouter(router *mux.Router, emailsHandler *delivery.EmailsHandler,
+	authCheckMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/emails").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouter.HandleFunc("/preferences", emailsHandler.GetPreferences).Methods("GET")
+	subrouter.HandleFunc("/preferences", emailsHandler.SetPreferences).Methods("POST")
+}
@@ -7,6 +7,9 @@ import (
 
 func ServeFavouritesRouter(router *mux.Router, favouritesHandler *delivery.FavouritesHandler,
 	authCheckMiddleware mux.MiddlewareFunc) {
+	// публичная ссылка на подборку открывается без авторизации
+	router.HandleFunc("/favourites/shared/{token}", favouritesHandler.GetSharedCollection)
+
 	subrouter := router.PathPrefix("/favourites").Subrouter()
 
 	subrouter.Use(authCheckMiddleware)
@@ -14,4 +17,13 @@ func ServeFavouritesRouter(router *mux.Router, favouritesHandler *delivery.Favou
 	subrouter.HandleFunc("/list", favouritesHandler.GetFavouritesList)
 	subrouter.HandleFunc("/change", favouritesHandler.ChangeFavourites)
 	subrouter.HandleFunc("/delete", favouritesHandler.DeleteFromFavourites)
+	subrouter.HandleFunc("/move", favouritesHandler.MoveFavourite)
+	subrouter.HandleFunc("/note", favouritesHandler.SetFavouriteNote)
+
+	subrouter.HandleFunc("/collections", favouritesHandler.GetCollections).Methods("GET")
+	subrouter.HandleFunc("/collections/create", favouritesHandler.CreateCollection)
+	subrouter.HandleFunc("/collections/{id:[0-9]+}", favouritesHandler.GetCollection).Methods("GET")
+	subrouter.HandleFunc("/collections/{id:[0-9]+}/edit", favouritesHandler.RenameCollection)
+	subrouter.HandleFunc("/collections/{id:[0-9]+}/delete", favouritesHandler.DeleteCollection)
+	subrouter.HandleFunc("/collections/{id:[0-9]+}/share", favouritesHandler.ShareCollection)
 }
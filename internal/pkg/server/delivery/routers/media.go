@@ -0,0 +1,11 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/media/delivery"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	"github.com/gorilla/mux"
+)
+
+func ServeMediaRouter(router *mux.Router, mediaHandler *delivery.MediaHandler) {
+	router.PathPrefix(utils.MediaURLPrefix).HandlerFunc(mediaHandler.ServeMedia).Methods("GET", "HEAD")
+}
@@ -0,0 +1,19 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/delivery"
+	"github.com/gorilla/mux"
+)
+
+func ServeMessagingRouter(router *mux.Router, messagingHandler *delivery.MessagingHandler,
+	authCheckMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/chat").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouter.HandleFunc("/ws", messagingHandler.Connect).Methods("GET")
+	subrouter.HandleFunc("/start", messagingHandler.StartConversation).Methods("POST")
+	subrouter.HandleFunc("/list", messagingHandler.GetConversations).Methods("GET")
+	subrouter.HandleFunc("/{id:[0-9]+}/messages", messagingHandler.GetMessages).Methods("GET")
+	subrouter.HandleFunc("/{id:[0-9]+}/send", messagingHandler.SendMessage).Methods("POST")
+	subrouter.HandleFunc("/{id:[0-9]+}/read", messagingHandler.MarkRead).Methods("POST")
+}
@@ -0,0 +1,19 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/delivery"
+	"github.com/gorilla/mux"
+)
+
+func ServeModerationRouter(router *mux.Router, moderationHandler *delivery.ModerationHandler,
+	authCheckMiddleware, csrfMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/moderation").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouterResolve := subrouter.PathPrefix("/resolve").Subrouter()
+	subrouterResolve.Use(csrfMiddleware)
+	subrouterResolve.HandleFunc("", moderationHandler.ResolveAdvert).Methods("POST")
+
+	subrouter.HandleFunc("/pending", moderationHandler.GetPendingList).Methods("GET")
+	subrouter.HandleFunc("/my", moderationHandler.GetMyModerationList).Methods("GET")
+}
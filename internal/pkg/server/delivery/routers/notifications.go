@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/delivery"
+	"github.com/gorilla/mux"
+)
+
+func ServeNotificationsRouter(router *mux.Router, notificationsHandler *delivery.NotificationsHandler,
+	authCheckMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/notifications").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouter.HandleFunc("/list", notificationsHandler.GetNotifications).Methods("GET")
+	subrouter.HandleFunc("/unread", notificationsHandler.GetUnreadCount).Methods("GET")
+	subrouter.HandleFunc("/stream", notificationsHandler.Stream).Methods("GET")
+	subrouter.HandleFunc("/read-all", notificationsHandler.MarkAllRead).Methods("POST")
+	subrouter.HandleFunc("/{id:[0-9]+}/read", notificationsHandler.MarkRead).Methods("POST")
+}
@@ -6,7 +6,7 @@ import (
 )
 
 func ServeOrderRouter(router *mux.Router, orderHandler *delivery.OrderHandler,
-	authCheckMiddleware mux.MiddlewareFunc) {
+	authCheckMiddleware, csrfMiddleware mux.MiddlewareFunc) {
 	subrouter := router.PathPrefix("/order").Subrouter()
 	subrouter.Use(authCheckMiddleware)
 
@@ -14,4 +14,9 @@ func ServeOrderRouter(router *mux.Router, orderHandler *delivery.OrderHandler,
 
 	subrouter.HandleFunc("/list", orderHandler.GetOrderList)
 	subrouter.HandleFunc("/create", orderHandler.CreateOrder)
+	subrouter.HandleFunc("/reserve", orderHandler.ReserveAdverts).Methods("POST")
+
+	subrouterStatus := subrouter.PathPrefix("/status").Subrouter()
+	subrouterStatus.Use(csrfMiddleware)
+	subrouterStatus.HandleFunc("", orderHandler.ChangeOrderStatus).Methods("POST")
 }
@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/delivery"
+	"github.com/gorilla/mux"
+)
+
+// ServePhoneRouter - подтверждение телефона кодом из SMS; POST /profile/phone по-прежнему обслуживает
+// ServeProfileRouter.
+func ServePhoneRouter(router *mux.Router, phoneHandler *delivery.PhoneHandler,
+	authCheckMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/profile/phone").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouter.HandleFunc("/status", phoneHandler.GetStatus).Methods("GET")
+	subrouter.HandleFunc("/code", phoneHandler.RequestCode).Methods("POST")
+	subrouter.HandleFunc("/confirm", phoneHandler.ConfirmCode).Methods("POST")
+}
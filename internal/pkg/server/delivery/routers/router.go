@@ -2,34 +2,56 @@ package routers
 
 import (
 	"log"
+	"time"
 
 	advdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/delivery"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
 	cartdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/delivery"
 	cartproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/delivery/protobuf"
+	categorydel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/delivery"
 	citydel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/city/delivery"
+	emailsdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/delivery"
 	favdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/favourites/delivery"
+	mediadel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/media/delivery"
+	messagingdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/delivery"
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 	createAuthCheckMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
 	createCsrfMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/csrf"
 	createLogMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/log"
+	createMediaMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/media"
 	createMetricsMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/metrics"
 	recoveryMiddleware "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/recover"
+	moderationdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/delivery"
+	notificationsdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/delivery"
 	orderdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/delivery"
 	paydel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/payments/delivery"
+	phonedel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/delivery"
 	profdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/profile/delivery"
 	profileproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/profile/delivery/protobuf"
+	subscriptionsdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/delivery"
 	surveydel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/survey/delivery"
 	authdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery"
 	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	verificationdel "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/delivery"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	advusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/adverts/usecases"
 	cartusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/cart/usecases"
+	categoryusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/category/usecases"
 	cityusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/city/usecases"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
 	favusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/favourites/usecases"
+	messagingusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/messaging/usecases"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	notificationsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
 	orderusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/order/usecases"
 	paymentsusescases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/payments/usecases"
+	phoneusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/phone/usecases"
+	subscriptionsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/usecases"
+	suggestusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/suggestions/usecases"
 	surveyusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/survey/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	verificationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/usecases"
 
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
@@ -39,13 +61,33 @@ func NewRouter(logger *zap.SugaredLogger,
 	advertStorage advusecases.AdvertsStorageInterface,
 	cartClient cartproto.CartClient,
 	cartStorage cartusecases.CartStorageInterface,
+	deliveryPricing *cartusecases.DeliveryPricing,
 	cityStorage cityusecases.CityStorageInterface,
+	categoryStorage categoryusecases.CategoryStorageInterface,
 	orderStorage orderusecases.OrderStorageInterface,
+	reservationTTL time.Duration,
 	surveyStorage surveyusecases.SurveyStorageInterface,
 	authClient authproto.AuthClient,
 	profileClient profileproto.ProfileClient,
 	favouritesStorage favusecases.FavouritesStorageInterface,
-	paymentsStorage paymentsusescases.PaymentsStorageInterface) *mux.Router {
+	paymentsStorage paymentsusescases.PaymentsStorageInterface,
+	bumpPolicy *paymentsusescases.BumpPolicy,
+	moderationStorage moderationusecases.ModerationStorageInterface,
+	advertModerator *moderationusecases.AdvertModerator,
+	notificationsStorage notificationsusecases.NotificationsStorageInterface,
+	notificationsBroker *notificationsusecases.Broker,
+	notifier *notificationsusecases.Notifier,
+	messagingStorage messagingusecases.MessagingStorageInterface,
+	chatHub *messagingusecases.Hub,
+	emailsStorage emailsusecases.EmailsStorageInterface,
+	emailer *emailsusecases.Emailer,
+	subscriptionsStorage subscriptionsusecases.SubscriptionsStorageInterface,
+	verificationStorage verificationusecases.VerificationStorageInterface,
+	imagePipeline *utils.ImagePipeline,
+	uploadValidator *utils.UploadValidator,
+	suggester *suggestusecases.Suggester,
+	blobStore blobstore.BlobStore,
+	phoneVerifier *phoneusecases.PhoneVerifier) *mux.Router {
 	router := mux.NewRouter()
 	router.Use(recoveryMiddleware.RecoveryMiddleware)
 
@@ -61,31 +103,55 @@ func NewRouter(logger *zap.SugaredLogger,
 
 	router.Use(metricsMiddleware)
 	router.Use(logMiddleware)
+	router.Use(createMediaMiddleware.CreateInlineImagesMiddleware())
 
 	csrfMiddleware := createCsrfMiddleware.CreateCsrfMiddleware()
 	authCheckMiddleware := createAuthCheckMiddleware.CreateAuthCheckMiddleware(authClient)
 
-	advertsHandler := advdel.NewAdvertsHandler(advertStorage, authClient)
-	cartHandler := cartdel.NewCartHandler(cartClient, authClient)
-	authHandler := authdel.NewAuthHandler(authClient, profileClient)
-	profileHandler := profdel.NewProfileHandler(profileClient, authClient)
-	orderHandler := orderdel.NewOrderHandler(orderStorage, cartStorage, authClient, advertStorage)
+	advertsHandler := advdel.NewAdvertsHandler(advertStorage, advertModerator, authClient,
+		uploadValidator, suggester, emailer, phoneVerifier)
+	cartHandler := cartdel.NewCartHandler(cartClient, authClient, profileClient, deliveryPricing)
+	authHandler := authdel.NewAuthHandler(authClient, profileClient, emailer)
+	profileHandler := profdel.NewProfileHandler(profileClient, authClient, imagePipeline, uploadValidator)
+	orderHandler := orderdel.NewOrderHandler(orderStorage, cartStorage, authClient, profileClient, advertStorage,
+		deliveryPricing, reservationTTL, notifier, emailer)
 	cityHandler := citydel.NewCityHandler(cityStorage)
+	categoryHandler := categorydel.NewCategoryHandler(categoryStorage, moderationStorage, authClient)
 	surveyHandler := surveydel.NewSurveyHandler(authClient, surveyStorage)
 	favouritesHandler := favdel.NewFavouritesHandler(favouritesStorage, advertStorage, authClient)
-	paymentsHandler := paydel.NewPaymentsHandler(paymentsStorage, authClient)
+	paymentsHandler := paydel.NewPaymentsHandler(paymentsStorage, authClient, bumpPolicy)
+	moderationHandler := moderationdel.NewModerationHandler(moderationStorage, authClient)
+	notificationsHandler := notificationsdel.NewNotificationsHandler(notificationsStorage, notificationsBroker,
+		authClient)
+	messagingHandler := messagingdel.NewMessagingHandler(messagingStorage, chatHub, authClient)
+	emailsHandler := emailsdel.NewEmailsHandler(emailsStorage, authClient)
+	subscriptionsHandler := subscriptionsdel.NewSubscriptionsHandler(subscriptionsStorage, authClient)
+	verificationHandler := verificationdel.NewVerificationHandler(verificationStorage, moderationStorage, authClient,
+		uploadValidator, blobStore)
+	phoneHandler := phonedel.NewPhoneHandler(phoneVerifier, authClient)
+	mediaHandler := mediadel.NewMediaHandler(blobStore)
 
 	rootRouter := router.PathPrefix("/api").Subrouter()
 	ServeAuthRouter(rootRouter, authHandler, authCheckMiddleware)
 	ServeAdvertsRouter(rootRouter, advertsHandler, authCheckMiddleware, csrfMiddleware)
 	ServeProfileRouter(rootRouter, profileHandler, authCheckMiddleware, csrfMiddleware)
 	ServeCartRouter(rootRouter, cartHandler, authCheckMiddleware)
-	ServeOrderRouter(rootRouter, orderHandler, authCheckMiddleware)
+	ServeOrderRouter(rootRouter, orderHandler, authCheckMiddleware, csrfMiddleware)
 	ServeSurveyRouter(rootRouter, surveyHandler, authCheckMiddleware)
 	ServeFavouritesRouter(rootRouter, favouritesHandler, authCheckMiddleware)
 	ServePaymentsRouter(rootRouter, paymentsHandler, authCheckMiddleware)
+	ServeModerationRouter(rootRouter, moderationHandler, authCheckMiddleware, csrfMiddleware)
+	ServeCategoryRouter(rootRouter, categoryHandler, authCheckMiddleware, csrfMiddleware)
+	ServeNotificationsRouter(rootRouter, notificationsHandler, authCheckMiddleware)
+	ServeMessagingRouter(rootRouter, messagingHandler, authCheckMiddleware)
+	ServeEmailsRouter(rootRouter, emailsHandler, authCheckMiddleware)
+	ServeSubscriptionsRouter(rootRouter, subscriptionsHandler, authCheckMiddleware)
+	ServeVerificationRouter(rootRouter, verificationHandler, authCheckMiddleware, csrfMiddleware)
+	ServePhoneRouter(rootRouter, phoneHandler, authCheckMiddleware)
 
 	rootRouter.HandleFunc("/city", cityHandler.GetCityList)
+	rootRouter.HandleFunc("/city/suggest", cityHandler.SuggestCities).Methods("GET")
+	ServeMediaRouter(router, mediaHandler)
 	router.PathPrefix("/metrics").Handler(promhttp.Handler())
 
 	return router
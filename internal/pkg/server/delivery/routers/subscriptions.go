@@ -0,0 +1,18 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/delivery"
+	"github.com/gorilla/mux"
+)
+
+// ServeSubscriptionsRouter дополняет /profile: пути не пересекаются с ServeProfileRouter.
+func ServeSubscriptionsRouter(router *mux.Router, subscriptionsHandler *delivery.SubscriptionsHandler,
+	authCheckMiddleware mux.MiddlewareFunc) {
+	router.HandleFunc("/profile/{id:[0-9]+}/subscribers", subscriptionsHandler.GetSubscribers).Methods("GET")
+
+	subrouter := router.PathPrefix("/profile/subscriptions").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouter.HandleFunc("", subscriptionsHandler.GetSubscriptions).Methods("GET")
+	subrouter.HandleFunc("/feed", subscriptionsHandler.GetFeed).Methods("GET")
+}
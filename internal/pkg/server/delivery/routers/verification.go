@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/delivery"
+	"github.com/gorilla/mux"
+)
+
+// ServeVerificationRouter - заявки продавцов на проверку; pending и resolve доступны только модераторам.
+func ServeVerificationRouter(router *mux.Router, verificationHandler *delivery.VerificationHandler,
+	authCheckMiddleware, csrfMiddleware mux.MiddlewareFunc) {
+	subrouter := router.PathPrefix("/verification").Subrouter()
+	subrouter.Use(authCheckMiddleware)
+
+	subrouterSubmit := subrouter.PathPrefix("/submit").Subrouter()
+	subrouterSubmit.Use(csrfMiddleware)
+	subrouterSubmit.HandleFunc("", verificationHandler.SubmitRequest).Methods("POST")
+
+	subrouterResolve := subrouter.PathPrefix("/resolve").Subrouter()
+	subrouterResolve.Use(csrfMiddleware)
+	subrouterResolve.HandleFunc("", verificationHandler.ResolveRequest).Methods("POST")
+
+	subrouter.HandleFunc("/my", verificationHandler.GetMyRequests).Methods("GET")
+	subrouter.HandleFunc("/pending", verificationHandler.GetPendingRequests).Methods("GET")
+	subrouter.HandleFunc("/{id:[0-9]+}/documents/{n:[0-9]+}", verificationHandler.GetDocument).Methods("GET")
+}
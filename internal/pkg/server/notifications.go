@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	notificationsrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/repository"
+	notificationsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/notifications/usecases"
+)
+
+const notificationsListenRetryDelay = 5 * time.Second
+
+// runNotificationsListener пересылает уведомления из канала NOTIFY в открытые SSE-потоки
+// и переподключается, если соединение с базой оборвалось.
+func runNotificationsListener(ctx context.Context, storage *notificationsrepo.NotificationsStorage,
+	broker *notificationsusecases.Broker) {
+	go func() {
+		for {
+			err := storage.Listen(ctx, broker.HasSubscribers, broker.Publish)
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Printf("error while listening for notifications: %v", err)
+
+			time.Sleep(notificationsListenRetryDelay)
+		}
+	}()
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// окно ограничения начинается с первого события и не продлевается следующими
+//
+//nolint:gochecknoglobals
+var incrWindowScript = redis.NewScript(1, `
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n`)
+
+// IncrWindow увеличивает счётчик key и возвращает его значение; счётчик сбрасывается через window
+// после первого увеличения.
+func IncrWindow(conn redis.Conn, key string, window time.Duration) (int, error) {
+	return redis.Int(incrWindowScript.Do(conn, key, window.Milliseconds()))
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	suggestusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/suggestions/usecases"
+)
+
+const defaultSuggestionsRebuildInterval = 15 * time.Minute
+
+// runScheduledSuggestionsRebuild строит индекс подсказок сразу при старте и затем перестраивает его по таймеру.
+func runScheduledSuggestionsRebuild(ctx context.Context, cfg config.SearchSuggestionsConfig,
+	suggester *suggestusecases.Suggester) {
+	interval := cfg.RebuildInterval
+	if interval <= 0 {
+		interval = defaultSuggestionsRebuildInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := suggester.Rebuild(ctx); err != nil {
+				log.Printf("error while rebuilding search suggestions: %v", err)
+			}
+
+			<-ticker.C
+		}
+	}()
+}
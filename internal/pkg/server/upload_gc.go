@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	pgxpoolconfig "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/repository"
+	logger "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/usecases"
+	uploadgcrepo "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/uploadgc/repository"
+	uploadgcusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/uploadgc/usecases"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const UploadGCCommand = "gc-uploads"
+
+var errNoConfig = errors.New("can not read config")
+
+// runScheduledUploadGC периодически удаляет осиротевшие загрузки, пока процесс жив.
+func runScheduledUploadGC(ctx context.Context, cfg config.UploadGCConfig, collector *uploadgcusecases.Collector) {
+	if !cfg.Enabled || cfg.Interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			_, err := collector.Run(ctx, uploadgcusecases.OptionsFromConfig(cfg))
+			if err != nil {
+				log.Printf("error while scheduled upload gc: %v", err)
+			}
+		}
+	}()
+}
+
+// RunUploadGC - подкоманда gc-uploads: один проход сборщика с отчётом в stdout.
+func RunUploadGC(args []string) error {
+	cfg := config.ReadConfig()
+	if cfg == nil {
+		return errNoConfig
+	}
+
+	flags := flag.NewFlagSet(UploadGCCommand, flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", cfg.UploadGC.DryRun, "only report orphans, do not delete them")
+	grace := flags.Duration("grace", cfg.UploadGC.GracePeriod, "keep orphans younger than this period")
+	prefixes := flags.String("prefixes", strings.Join(cfg.UploadGC.Prefixes, ","),
+		"comma separated key prefixes to scan")
+	verbose := flags.Bool("v", false, "print every orphan and dangling reference")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	connPool, err := pgxpool.NewWithConfig(context.Background(), pgxpoolconfig.PGXPoolConfig())
+	if err != nil {
+		return err
+	}
+	defer connPool.Close()
+
+	postgresMetrics, err := mymetrics.CreateDatabaseMetrics("upload_gc", "postgres")
+	if err != nil {
+		return err
+	}
+
+	blobStore, err := blobstore.New(cfg.BlobStore)
+	if err != nil {
+		return err
+	}
+
+	gcLogger, err := logger.NewLogger([]string{"stderr"}, []string{"stderr"})
+	if err != nil {
+		return err
+	}
+	defer gcLogger.Sync()
+
+	ctx := backgroundContext(gcLogger)
+	collector := uploadgcusecases.NewCollector(uploadgcrepo.NewUploadGCStorage(connPool, postgresMetrics), blobStore)
+
+	opts := uploadgcusecases.Options{GracePeriod: *grace, DryRun: *dryRun}
+
+	for _, prefix := range strings.Split(*prefixes, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			opts.Prefixes = append(opts.Prefixes, prefix)
+		}
+	}
+
+	report, err := collector.Run(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	printUploadGCReport(os.Stdout, report, *verbose)
+
+	return nil
+}
+
+func printUploadGCReport(out io.Writer, report *uploadgcusecases.Report, verbose bool) {
+	fmt.Fprintln(out, report.String())
+
+	if !verbose {
+		return
+	}
+
+	for _, orphan := range report.Orphans {
+		fmt.Fprintf(out, "orphan\t%s\t%d\t%s\n", orphan.Key, orphan.Size, orphan.ModTime.Format(time.RFC3339))
+	}
+
+	for _, key := range report.Failed {
+		fmt.Fprintf(out, "failed\t%s\n", key)
+	}
+
+	for _, key := range report.Dangling {
+		fmt.Fprintf(out, "dangling\t%s\n", key)
+	}
+}
+
+// backgroundContext - контекст фоновых задач: без отмены, но с логгером, который ждут хранилища.
+func backgroundContext(taskLogger *zap.SugaredLogger) context.Context {
+	return context.WithValue(context.Background(), config.LoggerContextKey, taskLogger)
+}
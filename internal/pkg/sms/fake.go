@@ -0,0 +1,68 @@
+package sms
+
+import (
+	"context"
+	"sync"
+)
+
+type Message struct {
+	Phone string
+	Text  string
+}
+
+// FakeSender запоминает отправленные сообщения, чтобы тесты могли достать из них код.
+type FakeSender struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+func NewFakeSender() *FakeSender {
+	return &FakeSender{}
+}
+
+// Fail заставляет следующие отправки возвращать err, nil возвращает отправку в норму.
+func (s *FakeSender) Fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
+
+func (s *FakeSender) Send(_ context.Context, phone, text string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if phone == "" {
+		return ErrNoRecipient
+	}
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.messages = append(s.messages, Message{Phone: phone, Text: text})
+
+	return nil
+}
+
+func (s *FakeSender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
+
+// Last возвращает последнее сообщение на номер.
+func (s *FakeSender) Last(phone string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].Phone == phone {
+			return s.messages[i], true
+		}
+	}
+
+	return Message{}, false
+}
@@ -0,0 +1,27 @@
+package sms
+
+import (
+	"context"
+	"log"
+)
+
+// LogSender ничего не отправляет, а пишет сообщение в лог - для локального запуска без SMS-шлюза.
+type LogSender struct {
+	sender string
+}
+
+func NewLogSender(sender string) *LogSender {
+	return &LogSender{
+		sender: sender,
+	}
+}
+
+func (s *LogSender) Send(_ context.Context, phone, text string) error {
+	if phone == "" {
+		return ErrNoRecipient
+	}
+
+	log.Printf("sms from %s to %s: %s", s.sender, phone, text)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const KindLog = "log"
+
+var (
+	ErrNoRecipient = errors.New("sms recipient is empty")
+	errUnknownKind = errors.New("unknown sms gateway kind")
+)
+
+// SMSSender отправляет сообщение на номер в формате +79991234567.
+type SMSSender interface {
+	Send(ctx context.Context, phone, text string) error
+}
+
+// New выбирает шлюз по конфигу. Настоящего шлюза пока нет: в логе видно, какой код ушёл бы пользователю.
+func New(cfg config.SMSConfig) (SMSSender, error) {
+	switch cfg.Kind {
+	case "", KindLog:
+		return NewLogSender(cfg.Sender), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownKind, cfg.Kind)
+	}
+}
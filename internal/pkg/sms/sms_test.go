@@ -0,0 +1,72 @@
+//nolint:all
+package sms_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/sms"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		kind    string
+		wantErr bool
+	}{
+		{name: "default", kind: ""},
+		{name: "log", kind: sms.KindLog},
+		{name: "unknown", kind: "pigeon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sender, err := sms.New(config.SMSConfig{Kind: tt.kind, Sender: "Vol-4-ok"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil {
+				if err := sender.Send(context.Background(), "", "code"); !errors.Is(err, sms.ErrNoRecipient) {
+					t.Errorf("Send() to empty phone error = %v, want %v", err, sms.ErrNoRecipient)
+				}
+			}
+		})
+	}
+}
+
+func TestFakeSender(t *testing.T) {
+	t.Parallel()
+
+	sender := sms.NewFakeSender()
+
+	sender.Send(context.Background(), "+79991234567", "first")
+	sender.Send(context.Background(), "+79990000000", "other")
+	sender.Send(context.Background(), "+79991234567", "second")
+
+	if msg, ok := sender.Last("+79991234567"); !ok || msg.Text != "second" {
+		t.Errorf("Last() = %v, %v, want second", msg, ok)
+	}
+
+	if _, ok := sender.Last("+70000000000"); ok {
+		t.Error("Last() found message for unknown phone")
+	}
+
+	gatewayErr := errors.New("gateway is down")
+	sender.Fail(gatewayErr)
+
+	if err := sender.Send(context.Background(), "+79991234567", "third"); !errors.Is(err, gatewayErr) {
+		t.Errorf("Send() error = %v, want %v", err, gatewayErr)
+	}
+
+	if got := len(sender.Messages()); got != 3 {
+		t.Errorf("len(Messages()) = %d, want 3", got)
+	}
+}
@@ -0,0 +1,115 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	subscriptionsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/usecases"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type SubscriptionsHandler struct {
+	storage    subscriptionsusecases.SubscriptionsStorageInterface
+	authClient authproto.AuthClient
+}
+
+func NewSubscriptionsHandler(storage subscriptionsusecases.SubscriptionsStorageInterface,
+	authClient authproto.AuthClient) *SubscriptionsHandler {
+	return &SubscriptionsHandler{
+		storage:    storage,
+		authClient: authClient,
+	}
+}
+
+// GetFeed отдаёт активные объявления продавцов, на которых подписан пользователь, новые сверху;
+// следующую страницу просят с before=nextBefore.
+func (h *SubscriptionsHandler) GetFeed(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+	before, limit := pageParams(request)
+
+	adverts, err := h.storage.GetFeed(ctx, userID, before, limit+1)
+	if err != nil {
+		sendSubscriptionsError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get subscriptions feed for user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(subscriptionsusecases.NewSubscriptionsFeed(adverts,
+		limit)))
+}
+
+// GetSubscriptions отдаёт продавцов, на которых подписан текущий пользователь, последние подписки сверху.
+func (h *SubscriptionsHandler) GetSubscriptions(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+	before, limit := pageParams(request)
+
+	profiles, err := h.storage.GetSubscriptions(ctx, userID, before, limit+1)
+	if err != nil {
+		sendSubscriptionsError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get subscriptions of user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(subscriptionsusecases.NewSubscriptionsPage(profiles,
+		limit)))
+}
+
+// GetSubscribers отдаёт подписчиков продавца; список открытый, как и счётчик на странице профиля.
+func (h *SubscriptionsHandler) GetSubscribers(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	merchantID, _ := strconv.Atoi(mux.Vars(request)["id"])
+	before, limit := pageParams(request)
+
+	profiles, err := h.storage.GetSubscribers(ctx, uint(merchantID), before, limit+1)
+	if err != nil {
+		sendSubscriptionsError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get subscribers of merchant %d", merchantID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(subscriptionsusecases.NewSubscriptionsPage(profiles,
+		limit)))
+}
+
+func pageParams(request *http.Request) (uint, uint) {
+	before, _ := strconv.Atoi(request.URL.Query().Get("before"))
+	limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+
+	if before < 0 {
+		before = 0
+	}
+
+	return uint(before), subscriptionsusecases.SubscriptionsPageLimit(limit)
+}
+
+func sendSubscriptionsError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger,
+	err error) {
+	code, message := responses.StatusInternalServerError, responses.ErrInternalServer
+
+	if errors.Is(err, subscriptionsusecases.ErrMerchantNotFound) {
+		code, message = responses.StatusNotFound, responses.ErrMerchantNotExist
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
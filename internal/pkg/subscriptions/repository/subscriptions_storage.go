@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	subscriptionsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const activeStatus = "Активно"
+
+type SubscriptionsStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+	blobs   blobstore.BlobStore
+}
+
+func NewSubscriptionsStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics,
+	blobs blobstore.BlobStore) *SubscriptionsStorage {
+	return &SubscriptionsStorage{
+		pool:    pool,
+		metrics: metrics,
+		blobs:   blobs,
+	}
+}
+
+func (storage *SubscriptionsStorage) getFeed(ctx context.Context, tx pgx.Tx, userID, before,
+	limit uint) ([]*models.ReturningAdInList, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetFeed := `SELECT a.id, c.translation, category.translation, a.title, a.price,
+	(SELECT array_agg(url_resized) FROM 
+	                                   (SELECT url_resized 
+	                                    FROM advert_image 
+	                                    WHERE advert_id = a.id 
+	                                    ORDER BY position, id) AS ordered_images) AS image_urls,
+	CAST(CASE WHEN EXISTS (SELECT 1 FROM favourite f WHERE f.user_id = $1 AND f.advert_id = a.id)
+         THEN 1 ELSE 0 END AS bool) AS in_favourites,
+	CAST(CASE WHEN EXISTS (SELECT 1 FROM cart c WHERE c.user_id = $1 AND c.advert_id = a.id)
+		THEN 1 ELSE 0 END AS bool) AS in_cart,
+	a.is_promoted,
+	EXISTS (SELECT 1 FROM profile p WHERE p.user_id = a.user_id AND p.verified) AS seller_verified,
+	EXISTS (SELECT 1 FROM advert_reservation r
+		WHERE r.advert_id = a.id AND r.user_id <> $1 AND r.reserved_until > NOW()) AS reserved
+	FROM public.subscription s
+	INNER JOIN public.advert a ON a.user_id = s.user_id_merchant
+	INNER JOIN city c ON a.city_id = c.id
+	INNER JOIN category ON a.category_id = category.id
+	WHERE s.user_id_subscriber = $1 AND a.advert_status = $2 AND ($3 = 0 OR a.id < $3)
+	ORDER BY a.id DESC
+	LIMIT $4;`
+
+	logging.LogInfo(logger, "SELECT FROM subscription, advert, city, category, advert_image, favourite, cart")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetFeed, userID, activeStatus, before, limit)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting subscriptions feed, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	adverts := []*models.ReturningAdInList{}
+
+	for rows.Next() {
+		advert := models.ReturningAdInList{IsActive: true}
+		photoPad := models.PhotoPad{}
+
+		if err := rows.Scan(&advert.ID, &advert.City, &advert.Category, &advert.Title, &advert.Price,
+			&photoPad.Photo, &advert.InFavourites, &advert.InCart, &advert.IsPromoted,
+			&advert.SellerVerified, &advert.Reserved); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning feed adverts, err=%w", err))
+			storage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		for _, ptr := range photoPad.Photo {
+			advert.Photos = append(advert.Photos, *ptr)
+		}
+
+		advert.Photos, advert.PhotosIMG = utils.MediaImages(ctx, storage.blobs, advert.Photos)
+
+		adverts = append(adverts, &advert)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning feed adverts, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return adverts, nil
+}
+
+func (storage *SubscriptionsStorage) GetFeed(ctx context.Context, userID, before,
+	limit uint) ([]*models.ReturningAdInList, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var adverts []*models.ReturningAdInList
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		advertsInner, err := storage.getFeed(ctx, tx, userID, before, limit)
+		adverts = advertsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting subscriptions feed, err=%w", err))
+
+		return nil, err
+	}
+
+	return adverts, nil
+}
+
+// getProfiles отдаёт одну сторону подписок: column - кого ищем по userID, other - чьи профили показываем.
+func (storage *SubscriptionsStorage) getProfiles(ctx context.Context, tx pgx.Tx, column, other string, userID,
+	before, limit uint) ([]*models.SubscriptionProfile, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetProfiles := `
+	SELECT s.id, s.` + other + `, COALESCE(p.name, ''), COALESCE(p.surname, ''), COALESCE(p.avatar_url, ''),
+		COALESCE(p.verified, FALSE), s.created_time
+	FROM public.subscription s
+	LEFT JOIN public.profile p ON p.user_id = s.` + other + `
+	WHERE s.` + column + ` = $1 AND ($2 = 0 OR s.id < $2)
+	ORDER BY s.id DESC
+	LIMIT $3;`
+
+	logging.LogInfo(logger, "SELECT FROM subscription, profile")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLGetProfiles, userID, before, limit)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting subscriptions, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	profiles := []*models.SubscriptionProfile{}
+
+	for rows.Next() {
+		profile := models.SubscriptionProfile{}
+
+		if err := rows.Scan(&profile.SubscriptionID, &profile.UserID, &profile.Name, &profile.Surname,
+			&profile.Avatar, &profile.Approved, &profile.Since); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning subscriptions, err=%w", err))
+			storage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		profile.Avatar, profile.AvatarIMG = utils.MediaImage(ctx, storage.blobs, profile.Avatar)
+
+		profiles = append(profiles, &profile)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning subscriptions, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func (storage *SubscriptionsStorage) GetSubscriptions(ctx context.Context, userID, before,
+	limit uint) ([]*models.SubscriptionProfile, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var profiles []*models.SubscriptionProfile
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		profilesInner, err := storage.getProfiles(ctx, tx, "user_id_subscriber", "user_id_merchant", userID,
+			before, limit)
+		profiles = profilesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting subscriptions, err=%w", err))
+
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func (storage *SubscriptionsStorage) userExists(ctx context.Context, tx pgx.Tx, userID uint) (bool, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLUserExists := `SELECT EXISTS(SELECT 1 FROM public."user" WHERE id = $1);`
+
+	logging.LogInfo(logger, "SELECT FROM user")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLUserExists, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	var exists bool
+
+	if err := line.Scan(&exists); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while checking user, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (storage *SubscriptionsStorage) GetSubscribers(ctx context.Context, merchantID, before,
+	limit uint) ([]*models.SubscriptionProfile, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var profiles []*models.SubscriptionProfile
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		exists, err := storage.userExists(ctx, tx, merchantID)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return subscriptionsusecases.ErrMerchantNotFound
+		}
+
+		profilesInner, err := storage.getProfiles(ctx, tx, "user_id_merchant", "user_id_subscriber", merchantID,
+			before, limit)
+		profiles = profilesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting subscribers, err=%w", err))
+
+		return nil, err
+	}
+
+	return profiles, nil
+}
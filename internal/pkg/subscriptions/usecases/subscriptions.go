@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+const (
+	DefaultSubscriptionsPage = 30
+	MaxSubscriptionsPage     = 100
+)
+
+var ErrMerchantNotFound = errors.New("merchant not found")
+
+type SubscriptionsStorageInterface interface {
+	// GetFeed отдаёт limit активных объявлений продавцов, на которых подписан userID, с id меньше before
+	// (0 - с самого нового), новые сверху.
+	GetFeed(ctx context.Context, userID, before, limit uint) ([]*models.ReturningAdInList, error)
+	// GetSubscriptions и GetSubscribers листаются по номеру подписки так же, как лента по id объявления.
+	GetSubscriptions(ctx context.Context, userID, before, limit uint) ([]*models.SubscriptionProfile, error)
+	GetSubscribers(ctx context.Context, merchantID, before, limit uint) ([]*models.SubscriptionProfile, error)
+}
+
+// SubscriptionsPageLimit приводит размер страницы к допустимому.
+func SubscriptionsPageLimit(limit int) uint {
+	return utils.PageLimit(limit, DefaultSubscriptionsPage, MaxSubscriptionsPage)
+}
+
+// NewSubscriptionsFeed собирает страницу ленты; запрошено на одно объявление больше, чтобы понять, есть ли ещё.
+func NewSubscriptionsFeed(adverts []*models.ReturningAdInList, limit uint) *models.SubscriptionsFeed {
+	feed := &models.SubscriptionsFeed{Adverts: adverts}
+
+	if uint(len(adverts)) > limit {
+		feed.Adverts = adverts[:limit]
+		nextBefore := feed.Adverts[limit-1].ID
+		feed.NextBefore = &nextBefore
+	}
+
+	if feed.Adverts == nil {
+		feed.Adverts = []*models.ReturningAdInList{}
+	}
+
+	return feed
+}
+
+func NewSubscriptionsPage(profiles []*models.SubscriptionProfile, limit uint) *models.SubscriptionsPage {
+	page := &models.SubscriptionsPage{Profiles: profiles}
+
+	if uint(len(profiles)) > limit {
+		page.Profiles = profiles[:limit]
+		nextBefore := page.Profiles[limit-1].SubscriptionID
+		page.NextBefore = &nextBefore
+	}
+
+	if page.Profiles == nil {
+		page.Profiles = []*models.SubscriptionProfile{}
+	}
+
+	return page
+}
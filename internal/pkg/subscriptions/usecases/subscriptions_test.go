@@ -0,0 +1,72 @@
+//nolint:all
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/subscriptions/usecases"
+)
+
+func TestSubscriptionsPageLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		limit int
+		want  uint
+	}{
+		{name: "default", limit: 0, want: usecases.DefaultSubscriptionsPage},
+		{name: "negative", limit: -5, want: usecases.DefaultSubscriptionsPage},
+		{name: "in range", limit: 10, want: 10},
+		{name: "too large", limit: 1000, want: usecases.MaxSubscriptionsPage},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := usecases.SubscriptionsPageLimit(tt.limit); got != tt.want {
+				t.Errorf("SubscriptionsPageLimit(%d) = %d, want %d", tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSubscriptionsFeed(t *testing.T) {
+	t.Parallel()
+
+	adverts := []*models.ReturningAdInList{{ID: 30}, {ID: 21}, {ID: 5}}
+
+	feed := usecases.NewSubscriptionsFeed(adverts, 2)
+	if len(feed.Adverts) != 2 || feed.NextBefore == nil || *feed.NextBefore != 21 {
+		t.Errorf("NewSubscriptionsFeed() with more adverts = %+v", feed)
+	}
+
+	feed = usecases.NewSubscriptionsFeed(adverts, 3)
+	if len(feed.Adverts) != 3 || feed.NextBefore != nil {
+		t.Errorf("NewSubscriptionsFeed() on last page = %+v", feed)
+	}
+
+	feed = usecases.NewSubscriptionsFeed(nil, 3)
+	if feed.Adverts == nil || len(feed.Adverts) != 0 || feed.NextBefore != nil {
+		t.Errorf("NewSubscriptionsFeed() on empty feed = %+v", feed)
+	}
+}
+
+func TestNewSubscriptionsPage(t *testing.T) {
+	t.Parallel()
+
+	profiles := []*models.SubscriptionProfile{{SubscriptionID: 12, UserID: 3}, {SubscriptionID: 9, UserID: 4}}
+
+	page := usecases.NewSubscriptionsPage(profiles, 1)
+	if len(page.Profiles) != 1 || page.NextBefore == nil || *page.NextBefore != 12 {
+		t.Errorf("NewSubscriptionsPage() with more profiles = %+v", page)
+	}
+
+	page = usecases.NewSubscriptionsPage(nil, 1)
+	if page.Profiles == nil || page.NextBefore != nil {
+		t.Errorf("NewSubscriptionsPage() on empty list = %+v", page)
+	}
+}
@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type SearchLogStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewSearchLogStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *SearchLogStorage {
+	return &SearchLogStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (sls *SearchLogStorage) logQuery(ctx context.Context, tx pgx.Tx, query, city string) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLLogQuery := `
+	INSERT INTO public.search_query_log (query, city_id)
+	VALUES ($1, (SELECT id FROM public.city WHERE translation = $2));`
+
+	logging.LogInfo(logger, "INSERT INTO search_query_log")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLLogQuery, query, city)
+
+	sls.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting search query, err=%w", err))
+		sls.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (sls *SearchLogStorage) LogQuery(ctx context.Context, query, city string) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, sls.pool, func(tx pgx.Tx) error {
+		return sls.logQuery(ctx, tx, query, city)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while logging search query, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+// getTopQueries считает для самых частых запросов, сколько активных объявлений они находят тем же
+// полнотекстовым условием, что и поиск.
+func (sls *SearchLogStorage) getTopQueries(ctx context.Context, tx pgx.Tx, since time.Time, minFrequency,
+	limit int) ([]*models.SearchQueryStats, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLTopQueries := `
+	WITH top AS (
+		SELECT query, COUNT(*) AS frequency
+		FROM public.search_query_log
+		WHERE created_time >= $1
+		GROUP BY query
+		HAVING COUNT(*) >= $2
+		ORDER BY frequency DESC, query
+		LIMIT $3
+	)
+	SELECT top.query, top.frequency,
+		(SELECT COUNT(*) FROM public.advert a
+		WHERE a.advert_status = 'Активно'
+			AND to_tsvector(a.title) @@ to_tsquery(replace(top.query || ':*', ' ', ' | '))) AS results_number
+	FROM top;`
+
+	logging.LogInfo(logger, "SELECT FROM search_query_log, advert")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLTopQueries, since, minFrequency, limit)
+
+	sls.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting top queries, err=%w", err))
+		sls.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var queries []*models.SearchQueryStats
+
+	for rows.Next() {
+		stats := models.SearchQueryStats{}
+
+		if err := rows.Scan(&stats.Query, &stats.Frequency, &stats.Results); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning top queries, err=%w", err))
+			sls.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		queries = append(queries, &stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning top queries, err=%w", err))
+		sls.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+func (sls *SearchLogStorage) GetTopQueries(ctx context.Context, since time.Time, minFrequency,
+	limit int) ([]*models.SearchQueryStats, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var queries []*models.SearchQueryStats
+
+	err := pgx.BeginFunc(ctx, sls.pool, func(tx pgx.Tx) error {
+		queriesInner, err := sls.getTopQueries(ctx, tx, since, minFrequency, limit)
+		queries = queriesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting top queries, err=%w", err))
+
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+// getTrendingQueries возвращает до perCity самых частых запросов в каждом городе и по всем городам сразу
+// (у таких строк пустой город).
+func (sls *SearchLogStorage) getTrendingQueries(ctx context.Context, tx pgx.Tx, since time.Time, minFrequency,
+	perCity int) ([]*models.TrendingQuery, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLTrendingQueries := `
+	SELECT COALESCE(city, ''), query, frequency
+	FROM (
+		SELECT city, query, frequency, is_total,
+			row_number() OVER (PARTITION BY is_total, city ORDER BY frequency DESC, query) AS city_rank
+		FROM (
+			SELECT c.translation AS city, l.query, COUNT(*) AS frequency, GROUPING(c.translation) AS is_total
+			FROM public.search_query_log l
+			LEFT JOIN public.city c ON l.city_id = c.id
+			WHERE l.created_time >= $1
+			GROUP BY GROUPING SETS ((c.translation, l.query), (l.query))
+			HAVING COUNT(*) >= $2
+		) grouped
+	) ranked
+	WHERE city_rank <= $3 AND (is_total = 1 OR city IS NOT NULL)
+	ORDER BY city, city_rank;`
+
+	logging.LogInfo(logger, "SELECT FROM search_query_log, city")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLTrendingQueries, since, minFrequency, perCity)
+
+	sls.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting trending queries, err=%w", err))
+		sls.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var queries []*models.TrendingQuery
+
+	for rows.Next() {
+		trending := models.TrendingQuery{}
+
+		if err := rows.Scan(&trending.City, &trending.Query, &trending.Frequency); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning trending queries, err=%w",
+				err))
+			sls.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		queries = append(queries, &trending)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning trending queries, err=%w", err))
+		sls.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+func (sls *SearchLogStorage) GetTrendingQueries(ctx context.Context, since time.Time, minFrequency,
+	perCity int) ([]*models.TrendingQuery, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var queries []*models.TrendingQuery
+
+	err := pgx.BeginFunc(ctx, sls.pool, func(tx pgx.Tx) error {
+		queriesInner, err := sls.getTrendingQueries(ctx, tx, since, minFrequency, perCity)
+		queries = queriesInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting trending queries, err=%w", err))
+
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+func (sls *SearchLogStorage) deleteOldQueries(ctx context.Context, tx pgx.Tx, before time.Time) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLDeleteOldQueries := `DELETE FROM public.search_query_log WHERE created_time < $1;`
+
+	logging.LogInfo(logger, "DELETE FROM search_query_log")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLDeleteOldQueries, before)
+
+	sls.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting old search queries, err=%w", err))
+		sls.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (sls *SearchLogStorage) DeleteOldQueries(ctx context.Context, before time.Time) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, sls.pool, func(tx pgx.Tx) error {
+		return sls.deleteOldQueries(ctx, tx, before)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while cleaning search query log, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
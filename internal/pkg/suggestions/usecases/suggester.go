@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSuggestionsWindow   = 30 * 24 * time.Hour
+	defaultTrendingWindow      = 24 * time.Hour
+	defaultMinFrequency        = 3
+	defaultSuggestionIndexSize = 5000
+	defaultTrendingSize        = 10
+)
+
+type snapshot struct {
+	index *Index
+	// город (пустая строка - все города) -> запросы от самых частых
+	trending map[string][]string
+}
+
+// Suggester отвечает на подсказки из памяти. Индекс целиком перестраивается в Rebuild и подменяется атомарно,
+// поэтому чтение не берёт блокировок.
+type Suggester struct {
+	storage SearchLogStorageInterface
+	cfg     config.SearchSuggestionsConfig
+	current atomic.Pointer[snapshot]
+}
+
+func NewSuggester(storage SearchLogStorageInterface, cfg config.SearchSuggestionsConfig) *Suggester {
+	if cfg.Window <= 0 {
+		cfg.Window = defaultSuggestionsWindow
+	}
+
+	if cfg.TrendingWindow <= 0 {
+		cfg.TrendingWindow = defaultTrendingWindow
+	}
+
+	if cfg.MinFrequency <= 0 {
+		cfg.MinFrequency = defaultMinFrequency
+	}
+
+	if cfg.IndexSize <= 0 {
+		cfg.IndexSize = defaultSuggestionIndexSize
+	}
+
+	if cfg.TrendingSize <= 0 {
+		cfg.TrendingSize = defaultTrendingSize
+	}
+
+	return &Suggester{storage: storage, cfg: cfg}
+}
+
+// LogSearch сохраняет запрос без данных о пользователе. Неподходящие запросы молча пропускаются.
+func (s *Suggester) LogSearch(ctx context.Context, query, city string) {
+	normalized, ok := NormalizeQuery(query)
+	if !ok {
+		return
+	}
+
+	if err := s.storage.LogQuery(ctx, normalized, city); err != nil {
+		logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+		logging.LogError(logger, fmt.Errorf("search query is not logged, err=%w", err))
+	}
+}
+
+// Rebuild перечитывает журнал, строит новый индекс и заодно удаляет записи старше Retention.
+func (s *Suggester) Rebuild(ctx context.Context) error {
+	now := time.Now()
+
+	if s.cfg.Retention > 0 {
+		// без очистки журнал просто растёт, индекс всё равно стоит перестроить
+		if err := s.storage.DeleteOldQueries(ctx, now.Add(-s.cfg.Retention)); err != nil {
+			logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+			logging.LogError(logger, fmt.Errorf("old search queries are not deleted, err=%w", err))
+		}
+	}
+
+	queries, err := s.storage.GetTopQueries(ctx, now.Add(-s.cfg.Window), s.cfg.MinFrequency, s.cfg.IndexSize)
+	if err != nil {
+		return err
+	}
+
+	trendingQueries, err := s.storage.GetTrendingQueries(ctx, now.Add(-s.cfg.TrendingWindow), s.cfg.MinFrequency,
+		s.cfg.TrendingSize)
+	if err != nil {
+		return err
+	}
+
+	trending := make(map[string][]string)
+
+	for _, trendingQuery := range trendingQueries {
+		trending[trendingQuery.City] = append(trending[trendingQuery.City], trendingQuery.Query)
+	}
+
+	s.current.Store(&snapshot{index: NewIndex(queries), trending: trending})
+
+	return nil
+}
+
+// Suggest ищет подсказки в последнем построенном индексе; false - индекс ещё не построен.
+func (s *Suggester) Suggest(prefix string, limit int) ([]string, bool) {
+	current := s.current.Load()
+	if current == nil {
+		return nil, false
+	}
+
+	return current.index.Lookup(prefix, limit), true
+}
+
+// Trending возвращает частые запросы в городе за TrendingWindow; пустой city - по всем городам.
+func (s *Suggester) Trending(city string, limit int) []string {
+	current := s.current.Load()
+	if current == nil {
+		return nil
+	}
+
+	queries := current.trending[city]
+	if limit <= 0 || limit > len(queries) {
+		limit = len(queries)
+	}
+
+	return queries[:limit]
+}
@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+)
+
+const (
+	maxQueryLen = 64
+	minQueryLen = 2
+	// столько цифр подряд похоже на телефон или номер карты - такие запросы не сохраняем
+	maxDigitsInRow = 5
+)
+
+type SearchLogStorageInterface interface {
+	LogQuery(ctx context.Context, query, city string) error
+	GetTopQueries(ctx context.Context, since time.Time, minFrequency, limit int) ([]*models.SearchQueryStats, error)
+	GetTrendingQueries(ctx context.Context, since time.Time, minFrequency,
+		perCity int) ([]*models.TrendingQuery, error)
+	DeleteOldQueries(ctx context.Context, before time.Time) error
+}
+
+// normalizeText приводит текст к виду, в котором хранятся запросы: нижний регистр, только буквы и цифры,
+// одиночные пробелы.
+func normalizeText(text string) string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(fields, " ")
+}
+
+// NormalizeQuery готовит запрос к журналу. Второй результат false, если запрос не стоит сохранять:
+// слишком короткий или похож на персональные данные (почта, телефон).
+func NormalizeQuery(query string) (string, bool) {
+	if strings.Contains(query, "@") {
+		return "", false
+	}
+
+	normalized := normalizeText(query)
+
+	digits := 0
+
+	for _, r := range normalized {
+		if unicode.IsDigit(r) {
+			digits++
+		} else if r != ' ' {
+			digits = 0
+		}
+
+		if digits >= maxDigitsInRow {
+			return "", false
+		}
+	}
+
+	if runes := []rune(normalized); len(runes) > maxQueryLen {
+		normalized = strings.TrimSpace(string(runes[:maxQueryLen]))
+	}
+
+	if len([]rune(normalized)) < minQueryLen {
+		return "", false
+	}
+
+	return normalized, true
+}
+
+type indexEntry struct {
+	query string
+	score float64
+}
+
+// Index - отсортированный по тексту список запросов: подсказки по префиксу ищутся двоичным поиском.
+type Index struct {
+	entries []indexEntry
+}
+
+// suggestionScore растёт с частотой запроса и, медленнее, с числом найденных объявлений.
+func suggestionScore(stats *models.SearchQueryStats) float64 {
+	return float64(stats.Frequency) * math.Log2(2+float64(stats.Results))
+}
+
+// NewIndex строит индекс; запросы, которые сейчас ничего не находят, в подсказки не попадают.
+func NewIndex(queries []*models.SearchQueryStats) *Index {
+	index := &Index{entries: make([]indexEntry, 0, len(queries))}
+
+	for _, stats := range queries {
+		if stats.Results == 0 {
+			continue
+		}
+
+		index.entries = append(index.entries, indexEntry{query: stats.Query, score: suggestionScore(stats)})
+	}
+
+	sort.Slice(index.entries, func(i, j int) bool {
+		return index.entries[i].query < index.entries[j].query
+	})
+
+	return index
+}
+
+func (idx *Index) Len() int {
+	return len(idx.entries)
+}
+
+// Lookup возвращает до limit запросов, начинающихся с prefix, от самых весомых.
+func (idx *Index) Lookup(prefix string, limit int) []string {
+	prefix = normalizeText(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	first := sort.Search(len(idx.entries), func(i int) bool {
+		return idx.entries[i].query >= prefix
+	})
+
+	var matched []indexEntry
+
+	for _, entry := range idx.entries[first:] {
+		if !strings.HasPrefix(entry.query, prefix) {
+			break
+		}
+
+		matched = append(matched, entry)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].score > matched[j].score
+	})
+
+	suggestions := make([]string, 0, min(limit, len(matched)))
+
+	for _, entry := range matched[:min(limit, len(matched))] {
+		suggestions = append(suggestions, entry.query)
+	}
+
+	return suggestions
+}
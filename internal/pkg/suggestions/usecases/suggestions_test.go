@@ -0,0 +1,129 @@
+//nolint:all
+package usecases_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/suggestions/usecases"
+	"go.uber.org/zap"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		query  string
+		want   string
+		wantOk bool
+	}{
+		{name: "Case and punctuation", query: "  iPhone,  13  Pro!", want: "iphone 13 pro", wantOk: true},
+		{name: "Yo", query: "Ёлка", want: "елка", wantOk: true},
+		{name: "Email", query: "ivan@mail.ru", wantOk: false},
+		{name: "Phone", query: "+7 (916) 123-45-67", wantOk: false},
+		{name: "Too short", query: "?a", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := usecases.NormalizeQuery(tt.query)
+			if ok != tt.wantOk || (ok && got != tt.want) {
+				t.Errorf("NormalizeQuery() = %q, %t, want %q, %t", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestIndexLookup(t *testing.T) {
+	t.Parallel()
+
+	index := usecases.NewIndex([]*models.SearchQueryStats{
+		{Query: "iphone 13", Frequency: 10, Results: 40},
+		{Query: "iphone 15", Frequency: 30, Results: 5},
+		{Query: "iphone чехол", Frequency: 12, Results: 0},
+		{Query: "ipad", Frequency: 50, Results: 10},
+		{Query: "велосипед", Frequency: 7, Results: 3},
+	})
+
+	if index.Len() != 4 {
+		t.Errorf("Len() = %d, want 4 (queries without results are skipped)", index.Len())
+	}
+
+	if got := index.Lookup("IPh", 5); !reflect.DeepEqual(got, []string{"iphone 15", "iphone 13"}) {
+		t.Errorf("Lookup() = %v", got)
+	}
+
+	if got := index.Lookup("i", 1); !reflect.DeepEqual(got, []string{"ipad"}) {
+		t.Errorf("Lookup() = %v", got)
+	}
+}
+
+type fakeSearchLog struct {
+	logged []string
+}
+
+func (s *fakeSearchLog) LogQuery(_ context.Context, query, city string) error {
+	s.logged = append(s.logged, city+":"+query)
+
+	return nil
+}
+
+func (s *fakeSearchLog) GetTopQueries(_ context.Context, _ time.Time, _, _ int) ([]*models.SearchQueryStats, error) {
+	return []*models.SearchQueryStats{{Query: "диван", Frequency: 5, Results: 3}}, nil
+}
+
+func (s *fakeSearchLog) GetTrendingQueries(_ context.Context, _ time.Time, _, _ int) ([]*models.TrendingQuery, error) {
+	return []*models.TrendingQuery{
+		{City: "", Query: "диван", Frequency: 5},
+		{City: "Moscow", Query: "диван", Frequency: 4},
+		{City: "Moscow", Query: "стол", Frequency: 3},
+	}, nil
+}
+
+func (s *fakeSearchLog) DeleteOldQueries(_ context.Context, _ time.Time) error {
+	return nil
+}
+
+func TestSuggester(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), config.LoggerContextKey, zap.NewNop().Sugar())
+	storage := &fakeSearchLog{}
+	suggester := usecases.NewSuggester(storage, config.SearchSuggestionsConfig{Retention: time.Hour})
+
+	if _, ready := suggester.Suggest("ди", 5); ready {
+		t.Errorf("Suggest() is ready before the first rebuild")
+	}
+
+	suggester.LogSearch(ctx, "Диван!", "Moscow")
+	suggester.LogSearch(ctx, "89161234567", "Moscow")
+
+	if !reflect.DeepEqual(storage.logged, []string{"Moscow:диван"}) {
+		t.Errorf("logged = %v", storage.logged)
+	}
+
+	if err := suggester.Rebuild(ctx); err != nil {
+		t.Fatalf("Rebuild() error = %v", err)
+	}
+
+	if got, ready := suggester.Suggest("ди", 5); !ready || !reflect.DeepEqual(got, []string{"диван"}) {
+		t.Errorf("Suggest() = %v, %t", got, ready)
+	}
+
+	if got := suggester.Trending("Moscow", 1); !reflect.DeepEqual(got, []string{"диван"}) {
+		t.Errorf("Trending() = %v", got)
+	}
+
+	if got := suggester.Trending("", 0); !reflect.DeepEqual(got, []string{"диван"}) {
+		t.Errorf("Trending() for all cities = %v", got)
+	}
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type UploadGCStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+}
+
+func NewUploadGCStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics) *UploadGCStorage {
+	return &UploadGCStorage{
+		pool:    pool,
+		metrics: metrics,
+	}
+}
+
+func (gs *UploadGCStorage) getReferencedKeys(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLReferencedKeys := `
+	SELECT ai.url FROM public.advert_image ai WHERE COALESCE(ai.url, '') <> ''
+	UNION
+	SELECT ai.url_resized FROM public.advert_image ai WHERE COALESCE(ai.url_resized, '') <> ''
+	UNION
+	SELECT r.value FROM public.advert_image ai, jsonb_each_text(COALESCE(ai.renditions, '{}'::jsonb)) AS r
+	WHERE r.value <> ''
+	UNION
+	SELECT p.avatar_url FROM public.profile p WHERE COALESCE(p.avatar_url, '') <> ''
+	UNION
+	SELECT d.key FROM public.verification_request vr, unnest(vr.documents) AS d(key) WHERE d.key <> '';`
+
+	logging.LogInfo(logger, "SELECT FROM advert_image, profile, verification_request")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLReferencedKeys)
+
+	gs.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while executing select referenced keys query, err=%w",
+			err))
+		gs.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+
+	for rows.Next() {
+		var key string
+
+		if err := rows.Scan(&key); err != nil {
+			logging.LogError(logger, fmt.Errorf("error while scanning referenced key, err=%w", err))
+			gs.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("error while reading referenced keys, err=%w", err))
+		gs.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// ReferencedKeys возвращает ключи всех файлов, на которые ссылаются объявления и профили.
+func (gs *UploadGCStorage) ReferencedKeys(ctx context.Context) ([]string, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var keys []string
+
+	err := pgx.BeginFunc(ctx, gs.pool, func(tx pgx.Tx) error {
+		keysInner, err := gs.getReferencedKeys(ctx, tx)
+		keys = keysInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("error while executing referenced keys query, err=%w", err))
+
+		return nil, err
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+)
+
+const defaultGracePeriod = 24 * time.Hour
+
+var errListNotSupported = errors.New("blob store can not list objects")
+
+type UploadGCStorageInterface interface {
+	ReferencedKeys(ctx context.Context) ([]string, error)
+}
+
+// Options задают один проход сборщика.
+type Options struct {
+	GracePeriod time.Duration
+	DryRun      bool
+	Prefixes    []string
+}
+
+func OptionsFromConfig(cfg config.UploadGCConfig) Options {
+	return Options{
+		GracePeriod: cfg.GracePeriod,
+		DryRun:      cfg.DryRun,
+		Prefixes:    cfg.Prefixes,
+	}
+}
+
+// Report - итог прохода: осиротевшие файлы, висячие ссылки из базы и что было удалено.
+type Report struct {
+	Scanned  int
+	Orphans  []blobstore.ObjectInfo
+	Deleted  []string
+	Failed   []string
+	Kept     int
+	Dangling []string
+	DryRun   bool
+}
+
+func (r *Report) String() string {
+	return fmt.Sprintf("scanned=%d orphans=%d deleted=%d failed=%d kept_by_grace=%d dangling=%d dry_run=%t",
+		r.Scanned, len(r.Orphans), len(r.Deleted), len(r.Failed), r.Kept, len(r.Dangling), r.DryRun)
+}
+
+// Collector сверяет содержимое хранилища загрузок с базой. Файлы, на которые никто не ссылается,
+// удаляются, только если они старше GracePeriod: иначе можно задеть загрузку, транзакция которой
+// ещё не закоммичена.
+type Collector struct {
+	storage UploadGCStorageInterface
+	store   blobstore.BlobStore
+	now     func() time.Time
+}
+
+func NewCollector(storage UploadGCStorageInterface, store blobstore.BlobStore) *Collector {
+	return &Collector{
+		storage: storage,
+		store:   store,
+		now:     time.Now,
+	}
+}
+
+func (c *Collector) Run(ctx context.Context, opts Options) (*Report, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	lister, ok := c.store.(blobstore.Lister)
+	if !ok {
+		return nil, errListNotSupported
+	}
+
+	if opts.GracePeriod <= 0 {
+		opts.GracePeriod = defaultGracePeriod
+	}
+
+	prefixes := opts.Prefixes
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+
+	// ключи читаем до обхода хранилища: файл, загруженный после этого, окажется моложе GracePeriod
+	keys, err := c.storage.ReferencedKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]bool, len(keys))
+
+	for _, key := range keys {
+		if normalized, ok := storageKey(key); ok && inPrefixes(normalized, prefixes) {
+			referenced[normalized] = false
+		}
+	}
+
+	report := &Report{DryRun: opts.DryRun}
+	deadline := c.now().Add(-opts.GracePeriod)
+
+	for _, prefix := range prefixes {
+		err := lister.List(ctx, prefix, func(object blobstore.ObjectInfo) error {
+			report.Scanned++
+
+			if _, ok := referenced[object.Key]; ok {
+				referenced[object.Key] = true
+
+				return nil
+			}
+
+			report.Orphans = append(report.Orphans, object)
+
+			if object.ModTime.After(deadline) {
+				report.Kept++
+
+				return nil
+			}
+
+			if opts.DryRun {
+				return nil
+			}
+
+			if err := c.store.Delete(ctx, object.Key); err != nil {
+				logging.LogError(logger, fmt.Errorf("error while deleting orphaned upload %s, err=%w", object.Key, err))
+				report.Failed = append(report.Failed, object.Key)
+
+				return nil
+			}
+
+			report.Deleted = append(report.Deleted, object.Key)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for key, found := range referenced {
+		if !found {
+			report.Dangling = append(report.Dangling, key)
+		}
+	}
+
+	logging.LogInfo(logger, "upload gc finished: "+report.String())
+
+	return report, nil
+}
+
+// storageKey отбрасывает внешние адреса и статику: они не лежат в хранилище загрузок.
+func storageKey(key string) (string, bool) {
+	if strings.HasPrefix(key, "/") || strings.Contains(key, "://") {
+		return "", false
+	}
+
+	normalized, err := blobstore.NormalizeKey(key)
+	if err != nil {
+		return "", false
+	}
+
+	return normalized, true
+}
+
+func inPrefixes(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,122 @@
+//nolint:all
+package usecases_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/uploadgc/usecases"
+	"go.uber.org/zap"
+)
+
+type fakeStorage []string
+
+func (s fakeStorage) ReferencedKeys(_ context.Context) ([]string, error) {
+	return s, nil
+}
+
+func putFile(t *testing.T, root, key string, age time.Duration) {
+	t.Helper()
+
+	fullpath := filepath.Join(root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(fullpath), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(fullpath, []byte("image"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	modTime := time.Now().Add(-age)
+	if err := os.Chtimes(fullpath, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollectorRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		dryRun       bool
+		wantDeleted  []string
+		wantExisting []string
+	}{
+		{
+			name:         "Deletes old orphans",
+			wantDeleted:  []string{"advert_images/card/2024-05-01/orphan.jpg"},
+			wantExisting: []string{"advert_images/card/2024-05-01/used.jpg", "avatars/full/2024-05-01/fresh.jpg", "static/logo.png"},
+		},
+		{
+			name:         "Dry run keeps everything",
+			dryRun:       true,
+			wantExisting: []string{"advert_images/card/2024-05-01/orphan.jpg", "advert_images/card/2024-05-01/used.jpg", "avatars/full/2024-05-01/fresh.jpg"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			putFile(t, root, "advert_images/card/2024-05-01/used.jpg", 48*time.Hour)
+			putFile(t, root, "advert_images/card/2024-05-01/orphan.jpg", 48*time.Hour)
+			putFile(t, root, "avatars/full/2024-05-01/fresh.jpg", time.Minute)
+			putFile(t, root, "static/logo.png", 48*time.Hour)
+
+			storage := fakeStorage{
+				"./uploads/advert_images/card/2024-05-01/used.jpg",
+				"avatars/full/2024-05-01/missing.jpg",
+				"https://example.com/avatar.jpg",
+			}
+
+			store := blobstore.NewLocalStore(config.LocalBlobStoreConfig{Root: root})
+			collector := usecases.NewCollector(storage, store)
+
+			ctx := context.WithValue(context.Background(), config.LoggerContextKey, zap.NewNop().Sugar())
+
+			report, err := collector.Run(ctx, usecases.Options{
+				GracePeriod: 24 * time.Hour,
+				DryRun:      tt.dryRun,
+				Prefixes:    []string{"advert_images/", "avatars/"},
+			})
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if report.Scanned != 3 || len(report.Orphans) != 2 || report.Kept != 1 {
+				t.Errorf("Run() report = %s", report)
+			}
+
+			if strings.Join(report.Deleted, ",") != strings.Join(tt.wantDeleted, ",") {
+				t.Errorf("Run() deleted = %v, want %v", report.Deleted, tt.wantDeleted)
+			}
+
+			sort.Strings(report.Dangling)
+			if strings.Join(report.Dangling, ",") != "avatars/full/2024-05-01/missing.jpg" {
+				t.Errorf("Run() dangling = %v", report.Dangling)
+			}
+
+			for _, key := range tt.wantExisting {
+				if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(key))); err != nil {
+					t.Errorf("file %s was removed: %v", key, err)
+				}
+			}
+
+			for _, key := range tt.wantDeleted {
+				if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(key))); !os.IsNotExist(err) {
+					t.Errorf("file %s was not removed", key)
+				}
+			}
+		})
+	}
+}
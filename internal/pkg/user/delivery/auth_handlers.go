@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -14,14 +15,18 @@ import (
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
 	csrf "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/csrf"
 	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
 	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	userusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	sessionTime   = 24 * time.Hour
 	tokenDuration = 24 * time.Hour
 	sameSite      = 4
 )
@@ -29,23 +34,15 @@ const (
 type AuthHandler struct {
 	authClient    authproto.AuthClient
 	profileClient profileproto.ProfileClient
+	emailer       *emailsusecases.Emailer
 }
 
 func NewAuthHandler(authClient authproto.AuthClient,
-	profileClient profileproto.ProfileClient) *AuthHandler {
+	profileClient profileproto.ProfileClient, emailer *emailsusecases.Emailer) *AuthHandler {
 	return &AuthHandler{
 		authClient:    authClient,
 		profileClient: profileClient,
-	}
-}
-
-func createSession(sessionID string) *http.Cookie {
-	return &http.Cookie{
-		Name:     "session_id",
-		Value:    sessionID,
-		Path:     "/",
-		Expires:  time.Now().Add(sessionTime),
-		HttpOnly: true,
+		emailer:       emailer,
 	}
 }
 
@@ -84,6 +81,7 @@ func (authHandler *AuthHandler) Login(writer http.ResponseWriter, request *http.
 	authUser, err := client.Login(ctx, &authproto.ExistedUserData{
 		Email:    user.Email,
 		Password: user.Password,
+		Client:   authcheck.ClientInfo(request),
 	})
 	if err != nil {
 		logging.LogHandlerError(logger, err, responses.StatusUnauthorized)
@@ -94,7 +92,7 @@ func (authHandler *AuthHandler) Login(writer http.ResponseWriter, request *http.
 		return
 	}
 
-	cookie := createSession(authUser.SessionID)
+	cookie := authcheck.NewSessionCookie(authUser.SessionID, authUser.ExpiresAt)
 
 	http.SetCookie(writer, cookie)
 
@@ -190,6 +188,7 @@ func (authHandler *AuthHandler) Signup(writer http.ResponseWriter, request *http
 		Email:          newUser.Email,
 		Password:       newUser.Password,
 		PasswordRepeat: newUser.PasswordRepeat,
+		Client:         authcheck.ClientInfo(request),
 	})
 	if err != nil {
 		logging.LogHandlerError(logger, responses.ErrWrongCredentials, responses.StatusBadRequest)
@@ -200,12 +199,25 @@ func (authHandler *AuthHandler) Signup(writer http.ResponseWriter, request *http
 		return
 	}
 
-	cookie := createSession(user.SessionID)
+	cookie := authcheck.NewSessionCookie(user.SessionID, user.ExpiresAt)
 
 	http.SetCookie(writer, cookie)
 
 	_, _ = profileClient.CreateProfile(ctx, &profileproto.ProfileIDRequest{ID: user.ID})
 
+	confirmToken := ""
+
+	confirmation, err := client.RequestEmailConfirmation(ctx, &authproto.SessionData{SessionID: user.SessionID})
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while issuing email confirmation, err=%w", err))
+	} else {
+		confirmToken = confirmation.Token
+	}
+
+	if err := authHandler.emailer.Welcome(user.Email, confirmToken); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while sending welcome email, err=%w", err))
+	}
+
 	userData := models.AuthResponse{
 		User: models.User{
 			ID:    uint(user.ID),
@@ -245,13 +257,21 @@ func (authHandler *AuthHandler) CheckAuth(writer http.ResponseWriter, request *h
 		return
 	}
 
-	user, _ := client.GetCurrentUser(ctx, &authproto.SessionData{
+	user, err := client.GetCurrentUser(ctx, &authproto.SessionData{
 		SessionID: session.Value,
+		Client:    authcheck.ClientInfo(request),
 	})
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while checking session, err=%w", err))
+
+		user = &authproto.AuthUser{}
+	}
 
 	var responseData models.AdditionalUserData
 
 	if user.IsAuth {
+		http.SetCookie(writer, authcheck.NewSessionCookie(session.Value, user.ExpiresAt))
+
 		profile, _ := profileClient.GetProfile(ctx, &profileproto.ProfileIDRequest{ID: user.ID})
 
 		logging.LogHandlerInfo(logger, fmt.Sprintf("User %s is authorized", user.Email), responses.StatusOk)
@@ -260,13 +280,16 @@ func (authHandler *AuthHandler) CheckAuth(writer http.ResponseWriter, request *h
 		responseData.PhoneNumber = profile.Phone
 		responseData.FavNum = uint(profile.FavNum)
 		responseData.CartNum = uint(profile.CartNum)
+		responseData.UnreadNum = uint(profile.UnreadMessagesNum)
 	} else {
 		logging.LogHandlerInfo(logger, "User not authorized", responses.StatusOk)
 	}
 
 	responseData.User = models.User{
-		ID:    uint(user.ID),
-		Email: user.Email,
+		ID:             uint(user.ID),
+		Email:          user.Email,
+		EmailConfirmed: user.EmailConfirmed,
+		PendingEmail:   user.PendingEmail,
 	}
 	responseData.IsAuth = user.IsAuth
 
@@ -314,19 +337,387 @@ func (authHandler *AuthHandler) EditUserEmail(writer http.ResponseWriter, reques
 		return
 	}
 
+	if user.PendingEmail != "" {
+		if _, err := authHandler.sendEmailConfirmation(ctx, session.Value); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while sending email confirmation, err=%w",
+				err))
+		}
+	}
+
 	logging.LogHandlerInfo(logger, fmt.Sprintf("User %s successfully changed his authorization data",
 		user.Email), responses.StatusOk)
 
 	userData := models.AuthResponse{
 		User: models.User{
-			ID:    uint(user.ID),
-			Email: user.Email,
+			ID:             uint(user.ID),
+			Email:          user.Email,
+			EmailConfirmed: user.EmailConfirmed,
+			PendingEmail:   user.PendingEmail,
 		},
 		IsAuth: true,
 	}
 	responses.SendOkResponse(writer, responses.NewOkResponse(userData))
 }
 
+// ConfirmEmail подтверждает адрес по токену из письма. Сессия не нужна: ссылку могут открыть
+// на другом устройстве.
+func (authHandler *AuthHandler) ConfirmEmail(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var data models.ReceivedEmailToken
+
+	body, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(body); err != nil || data.Token == "" {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	user, err := authHandler.authClient.ConfirmEmail(ctx, &authproto.ConfirmEmailRequest{Token: data.Token})
+	if err != nil {
+		sendAuthError(writer, request, logger, err, emailErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("User %d confirmed email %s", user.ID, user.Email),
+		responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.User{
+		ID:             uint(user.ID),
+		Email:          user.Email,
+		EmailConfirmed: user.EmailConfirmed,
+		PendingEmail:   user.PendingEmail,
+	}))
+}
+
+// ResendEmailConfirmation повторно отправляет письмо со ссылкой на адрес, который ждёт подтверждения.
+func (authHandler *AuthHandler) ResendEmailConfirmation(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	session, _ := request.Cookie("session_id")
+
+	confirmation, err := authHandler.sendEmailConfirmation(ctx, session.Value)
+	if err != nil {
+		sendAuthError(writer, request, logger, err, emailErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Email confirmation sent to %s", confirmation.Email),
+		responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.EmailConfirmationSent{
+		Email:    confirmation.Email,
+		ResendIn: int(confirmation.ResendIn),
+	}))
+}
+
+func (authHandler *AuthHandler) sendEmailConfirmation(ctx context.Context,
+	sessionID string) (*authproto.EmailConfirmation, error) {
+	confirmation, err := authHandler.authClient.RequestEmailConfirmation(ctx,
+		&authproto.SessionData{SessionID: sessionID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authHandler.emailer.ConfirmEmail(confirmation.Email, confirmation.Token); err != nil {
+		return nil, err
+	}
+
+	return confirmation, nil
+}
+
+// RequestPasswordReset отправляет ссылку сброса пароля. Ответ всегда успешный, чтобы по нему нельзя было
+// проверить, зарегистрирован ли адрес.
+func (authHandler *AuthHandler) RequestPasswordReset(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var data models.ReceivedPasswordResetRequest
+
+	body, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(body); err != nil || data.Email == "" {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	reset, err := authHandler.authClient.RequestPasswordReset(ctx,
+		&authproto.PasswordResetRequest{Email: data.Email})
+	if err != nil {
+		sendAuthError(writer, request, logger, err, passwordErrorResponses)
+
+		return
+	}
+
+	if reset.Token != "" {
+		if err := authHandler.emailer.PasswordReset(reset.Email, reset.Token); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while sending password reset email, err=%w",
+				err))
+		}
+	}
+
+	logging.LogHandlerInfo(logger, "Password reset requested", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.ReceivedPasswordResetRequest{
+		Email: data.Email,
+	}))
+}
+
+// ResetPassword задаёт новый пароль по ссылке из письма; все сессии пользователя при этом завершаются.
+func (authHandler *AuthHandler) ResetPassword(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var data models.ReceivedPasswordReset
+
+	body, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(body); err != nil || data.Token == "" {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	user, err := authHandler.authClient.ResetPassword(ctx, &authproto.ResetPasswordRequest{
+		Token:          data.Token,
+		Password:       data.Password,
+		PasswordRepeat: data.PasswordRepeat,
+	})
+	if err != nil {
+		sendAuthError(writer, request, logger, err, passwordErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("User %d reset password", user.ID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.AuthResponse{
+		User: models.User{
+			ID:             uint(user.ID),
+			Email:          user.Email,
+			EmailConfirmed: user.EmailConfirmed,
+			PendingEmail:   user.PendingEmail,
+		},
+	}))
+}
+
+// ChangePassword меняет пароль по текущему; текущая сессия остаётся, остальные завершаются.
+func (authHandler *AuthHandler) ChangePassword(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	session, _ := request.Cookie("session_id")
+
+	var data models.ReceivedPasswordChange
+
+	body, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(body); err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	user, err := authHandler.authClient.ChangePassword(ctx, &authproto.ChangePasswordRequest{
+		SessionID:      session.Value,
+		OldPassword:    data.OldPassword,
+		Password:       data.Password,
+		PasswordRepeat: data.PasswordRepeat,
+	})
+	if err != nil {
+		sendAuthError(writer, request, logger, err, passwordErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("User %d changed password", user.ID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(models.AuthResponse{
+		User: models.User{
+			ID:             uint(user.ID),
+			Email:          user.Email,
+			EmailConfirmed: user.EmailConfirmed,
+			PendingEmail:   user.PendingEmail,
+		},
+		IsAuth: true,
+	}))
+}
+
+// ListSessions возвращает активные сессии пользователя, последние по времени обращения - первыми.
+func (authHandler *AuthHandler) ListSessions(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	session, _ := request.Cookie("session_id")
+
+	sessions, err := authHandler.activeSessions(ctx, session.Value)
+	if err != nil {
+		sendAuthError(writer, request, logger, err, sessionErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Listed %d sessions", len(sessions)), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(sessions))
+}
+
+// RevokeSession завершает сессию по ID из списка сессий и возвращает оставшиеся. Если завершили текущую,
+// кука удаляется, как при выходе.
+func (authHandler *AuthHandler) RevokeSession(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	session, _ := request.Cookie("session_id")
+
+	var data models.ReceivedSessionRevoke
+
+	body, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(body); err != nil || data.ID == "" {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	_, err := authHandler.authClient.RevokeSession(ctx, &authproto.RevokeSessionRequest{
+		SessionID: session.Value,
+		ID:        data.ID,
+	})
+	if err != nil {
+		sendAuthError(writer, request, logger, err, sessionErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Session %s revoked", data.ID), responses.StatusOk)
+
+	if data.ID == userusecases.SessionHandle(session.Value) {
+		session.Expires = time.Now().AddDate(0, 0, -1)
+		http.SetCookie(writer, session)
+		responses.SendOkResponse(writer, responses.NewOkResponse([]models.ActiveSession{}))
+
+		return
+	}
+
+	authHandler.sendActiveSessions(writer, request, logger, session.Value)
+}
+
+// RevokeOtherSessions завершает все сессии пользователя, кроме текущей, и возвращает оставшиеся.
+func (authHandler *AuthHandler) RevokeOtherSessions(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	session, _ := request.Cookie("session_id")
+
+	_, err := authHandler.authClient.RevokeOtherSessions(ctx, &authproto.SessionData{SessionID: session.Value})
+	if err != nil {
+		sendAuthError(writer, request, logger, err, sessionErrorResponses)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, "Other sessions revoked", responses.StatusOk)
+
+	authHandler.sendActiveSessions(writer, request, logger, session.Value)
+}
+
+func (authHandler *AuthHandler) sendActiveSessions(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger, sessionID string) {
+	sessions, err := authHandler.activeSessions(request.Context(), sessionID)
+	if err != nil {
+		sendAuthError(writer, request, logger, err, sessionErrorResponses)
+
+		return
+	}
+
+	responses.SendOkResponse(writer, responses.NewOkResponse(sessions))
+}
+
+func (authHandler *AuthHandler) activeSessions(ctx context.Context, sessionID string) ([]models.ActiveSession,
+	error) {
+	list, err := authHandler.authClient.ListSessions(ctx, &authproto.SessionData{SessionID: sessionID})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]models.ActiveSession, 0, len(list.Sessions))
+
+	for _, session := range list.Sessions {
+		sessions = append(sessions, models.ActiveSession{
+			ID:        session.ID,
+			Device:    session.Device,
+			IP:        session.IP,
+			UserAgent: session.UserAgent,
+			CreatedAt: time.Unix(session.CreatedAt, 0),
+			LastSeen:  time.Unix(session.LastSeen, 0),
+			ExpiresAt: time.Unix(session.ExpiresAt, 0),
+			Current:   session.Current,
+		})
+	}
+
+	return sessions, nil
+}
+
+// authErrorResponse - код и текст ответа API на ошибку сервиса авторизации.
+type authErrorResponse struct {
+	code    int
+	message string
+}
+
+// Коды gRPC у сценариев значат разное, поэтому у почты и пароля свои таблицы ответов.
+//
+//nolint:gochecknoglobals
+var (
+	emailErrorResponses = map[codes.Code]authErrorResponse{
+		codes.InvalidArgument:    {responses.StatusBadRequest, responses.ErrEmailTokenInvalid},
+		codes.FailedPrecondition: {responses.StatusConflict, responses.ErrEmailAlreadyConfirmed},
+		codes.AlreadyExists:      {responses.StatusConflict, responses.ErrUserAlreadyExists},
+		codes.ResourceExhausted:  {responses.StatusTooManyRequests, responses.ErrTooManyEmailRequests},
+		codes.Unauthenticated:    {responses.StatusUnauthorized, responses.ErrUnauthorized},
+	}
+
+	passwordErrorResponses = map[codes.Code]authErrorResponse{
+		codes.InvalidArgument:    {responses.StatusBadRequest, responses.ErrPasswordInvalid},
+		codes.FailedPrecondition: {responses.StatusBadRequest, responses.ErrResetTokenInvalid},
+		codes.PermissionDenied:   {responses.StatusForbidden, responses.ErrWrongPassword},
+		codes.Unauthenticated:    {responses.StatusUnauthorized, responses.ErrUnauthorized},
+	}
+
+	sessionErrorResponses = map[codes.Code]authErrorResponse{
+		codes.NotFound:        {responses.StatusNotFound, responses.ErrSessionNotFound},
+		codes.Unauthenticated: {responses.StatusUnauthorized, responses.ErrUnauthorized},
+	}
+)
+
+// sendAuthError переводит коды gRPC от сервиса авторизации в ответы API по таблице сценария.
+func sendAuthError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger, err error,
+	known map[codes.Code]authErrorResponse) {
+	response, ok := known[status.Code(err)]
+	if !ok {
+		response = authErrorResponse{responses.StatusInternalServerError, responses.ErrInternalServer}
+	}
+
+	logging.LogHandlerError(logger, err, response.code)
+	log.Println(err, response.code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(response.code, response.message))
+}
+
 func (authHandler *AuthHandler) GetCSRFToken(writer http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
@@ -5,31 +5,64 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	models "github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	emailsusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/emails/usecases"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/mailer"
 	profileproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/profile/delivery/protobuf"
 	mock_user_profile "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/profile/delivery/protobuf/mocks"
 	delivery "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery"
 	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
 	mock_user_client "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf/mocks"
+	userusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
 	utils "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 )
 
+type recordingQueue struct {
+	messages []*mailer.Message
+}
+
+func (queue *recordingQueue) Enqueue(msg *mailer.Message) error {
+	queue.messages = append(queue.messages, msg)
+
+	return nil
+}
+
+func newTestEmailer(t *testing.T) (*emailsusecases.Emailer, *recordingQueue) {
+	templates, err := mailer.LoadTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	queue := &recordingQueue{}
+
+	return emailsusecases.NewEmailer(nil, queue, templates, "http://vol-4-ok.ru"), queue
+}
+
+// withResponseCode кладёт в контекст ячейку для кода ответа, как это делает middleware логирования.
+func withResponseCode(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), "code", new(int)))
+}
+
 func TestLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	authClient := mock_user_client.NewMockAuthClient(ctrl)
 	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
 	defer ctrl.Finish()
 
-	authHandler := delivery.NewAuthHandler(authClient, profileClient)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, nil)
 	mockResponseWriter := httptest.NewRecorder()
 
 	testUser := models.UnauthorizedUser{
@@ -39,10 +72,16 @@ func TestLogin(t *testing.T) {
 	testUserProto := authproto.ExistedUserData{
 		Email:    "test@example.com",
 		Password: "password",
+		Client:   &authproto.ClientInfo{IP: "203.0.113.7", UserAgent: "Mozilla/5.0 Firefox/125.0"},
 	}
 	testUserJSON, _ := json.Marshal(testUser)
 	request, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(testUserJSON))
 	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Mozilla/5.0 Firefox/125.0")
+	request.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.2")
+	request.RemoteAddr = "10.0.0.2:41234"
+
+	expiresAt := time.Now().Add(72 * time.Hour).Unix()
 
 	authClient.EXPECT().Login(gomock.Any(), &testUserProto).Return(&authproto.LoggedUser{
 		ID:           1,
@@ -50,10 +89,16 @@ func TestLogin(t *testing.T) {
 		PasswordHash: utils.HashPassword("password"),
 		SessionID:    "123456",
 		IsAuth:       true,
+		ExpiresAt:    expiresAt,
 	}, nil)
 
 	authHandler.Login(mockResponseWriter, request)
 	assert.Equal(t, http.StatusOK, mockResponseWriter.Code)
+
+	cookies := mockResponseWriter.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, "123456", cookies[0].Value)
+	assert.Equal(t, expiresAt, cookies[0].Expires.Unix())
 }
 
 func TestLogout(t *testing.T) {
@@ -62,7 +107,7 @@ func TestLogout(t *testing.T) {
 	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
 	defer ctrl.Finish()
 
-	authHandler := delivery.NewAuthHandler(authClient, profileClient)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, nil)
 	mockResponseWriter := httptest.NewRecorder()
 
 	testUser := models.UnauthorizedUser{
@@ -88,7 +133,8 @@ func TestSignup(t *testing.T) {
 	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
 	defer ctrl.Finish()
 
-	authHandler := delivery.NewAuthHandler(authClient, profileClient)
+	emailer, mailQueue := newTestEmailer(t)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, emailer)
 	mockResponseWriter := httptest.NewRecorder()
 
 	testUser := models.UnauthorizedUser{
@@ -100,6 +146,7 @@ func TestSignup(t *testing.T) {
 		Email:          "test@example.com",
 		Password:       "password",
 		PasswordRepeat: "password",
+		Client:         &authproto.ClientInfo{},
 	}
 	testUserJSON, _ := json.Marshal(testUser)
 	request, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(testUserJSON))
@@ -117,8 +164,13 @@ func TestSignup(t *testing.T) {
 
 	authClient.EXPECT().Signup(gomock.Any(), &testNewUserDataProto).Return(&loggedUser, nil)
 	profileClient.EXPECT().CreateProfile(gomock.Any(), &testNewProfileProto)
+	authClient.EXPECT().RequestEmailConfirmation(gomock.Any(), &authproto.SessionData{SessionID: "123456"}).
+		Return(&authproto.EmailConfirmation{Email: "test@example.com", Token: "signed.token", ResendIn: 60}, nil)
 	authHandler.Signup(mockResponseWriter, request)
 	assert.Equal(t, http.StatusOK, mockResponseWriter.Code)
+	assert.Len(t, mailQueue.messages, 1)
+	assert.Equal(t, "test@example.com", mailQueue.messages[0].To)
+	assert.Contains(t, mailQueue.messages[0].Text, "http://vol-4-ok.ru/confirm-email?token=signed.token")
 }
 
 func TestCheckAuth(t *testing.T) {
@@ -127,7 +179,7 @@ func TestCheckAuth(t *testing.T) {
 	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
 	defer ctrl.Finish()
 
-	authHandler := delivery.NewAuthHandler(authClient, profileClient)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, nil)
 	mockResponseWriter := httptest.NewRecorder()
 
 	testUser := models.UnauthorizedUser{
@@ -146,7 +198,7 @@ func TestCheckAuth(t *testing.T) {
 	testUserJSON, _ := json.Marshal(testUser)
 	request, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(testUserJSON))
 	request.Header.Set("Content-Type", "application/json")
-	testSessionProto := authproto.SessionData{SessionID: "123456"}
+	testSessionProto := authproto.SessionData{SessionID: "123456", Client: &authproto.ClientInfo{}}
 	request.AddCookie(&http.Cookie{Name: "session_id", Value: "123456"})
 
 	testNewProfileProto := profileproto.ProfileIDRequest{ID: 1}
@@ -185,7 +237,7 @@ func TestEditUserEmail(t *testing.T) {
 	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
 	defer ctrl.Finish()
 
-	authHandler := delivery.NewAuthHandler(authClient, profileClient)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, nil)
 	mockResponseWriter := httptest.NewRecorder()
 
 	testUser := models.UnauthorizedUser{
@@ -216,13 +268,264 @@ func TestEditUserEmail(t *testing.T) {
 	assert.Equal(t, http.StatusOK, mockResponseWriter.Code)
 }
 
+func TestEditUserEmailSendsConfirmation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authClient := mock_user_client.NewMockAuthClient(ctrl)
+	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
+	defer ctrl.Finish()
+
+	emailer, mailQueue := newTestEmailer(t)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, emailer)
+	mockResponseWriter := httptest.NewRecorder()
+
+	testUserJSON, _ := json.Marshal(models.UnauthorizedUser{Email: "new@example.com"})
+	request, _ := http.NewRequest("POST", "/edit/email", bytes.NewBuffer(testUserJSON))
+	request.AddCookie(&http.Cookie{Name: "session_id", Value: "123456"})
+
+	authClient.EXPECT().EditEmail(gomock.Any(), &authproto.EditEmailRequest{Email: "new@example.com",
+		SessionID: "123456"}).Return(&authproto.User{ID: 1, Email: "old@example.com", EmailConfirmed: true,
+		PendingEmail: "new@example.com"}, nil)
+	authClient.EXPECT().RequestEmailConfirmation(gomock.Any(), &authproto.SessionData{SessionID: "123456"}).
+		Return(&authproto.EmailConfirmation{Email: "new@example.com", Token: "signed.token", ResendIn: 60}, nil)
+
+	authHandler.EditUserEmail(mockResponseWriter, request)
+	assert.Equal(t, http.StatusOK, mockResponseWriter.Code)
+	assert.Contains(t, mockResponseWriter.Body.String(), `"email":"old@example.com"`)
+	assert.Contains(t, mockResponseWriter.Body.String(), `"pendingEmail":"new@example.com"`)
+	assert.Len(t, mailQueue.messages, 1)
+	assert.Equal(t, "new@example.com", mailQueue.messages[0].To)
+}
+
+func TestConfirmEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		wantCode int
+	}{
+		{name: "confirmed", body: `{"token":"signed.token"}`, wantCode: http.StatusOK},
+		{name: "no token", body: `{}`, wantCode: http.StatusBadRequest},
+		{name: "expired", body: `{"token":"signed.token"}`,
+			err: status.Error(codes.InvalidArgument, "token is expired"), wantCode: http.StatusBadRequest},
+		{name: "already used", body: `{"token":"signed.token"}`,
+			err: status.Error(codes.FailedPrecondition, "outdated"), wantCode: http.StatusConflict},
+		{name: "email taken", body: `{"token":"signed.token"}`,
+			err: status.Error(codes.AlreadyExists, "taken"), wantCode: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authClient := mock_user_client.NewMockAuthClient(ctrl)
+			defer ctrl.Finish()
+
+			authHandler := delivery.NewAuthHandler(authClient, nil, nil)
+			mockResponseWriter := httptest.NewRecorder()
+			request, _ := http.NewRequest("POST", "/email/confirm", bytes.NewBufferString(tt.body))
+			request = withResponseCode(request)
+
+			if tt.body != `{}` {
+				user := &authproto.User{ID: 1, Email: "test@example.com", EmailConfirmed: true}
+				if tt.err != nil {
+					user = nil
+				}
+
+				authClient.EXPECT().ConfirmEmail(gomock.Any(), &authproto.ConfirmEmailRequest{Token: "signed.token"}).
+					Return(user, tt.err)
+			}
+
+			authHandler.ConfirmEmail(mockResponseWriter, request)
+
+			var response struct {
+				Code int `json:"code"`
+			}
+
+			_ = json.Unmarshal(mockResponseWriter.Body.Bytes(), &response)
+			assert.Equal(t, tt.wantCode, response.Code)
+		})
+	}
+}
+
+func TestResendEmailConfirmationThrottled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authClient := mock_user_client.NewMockAuthClient(ctrl)
+	defer ctrl.Finish()
+
+	emailer, mailQueue := newTestEmailer(t)
+	authHandler := delivery.NewAuthHandler(authClient, nil, emailer)
+	mockResponseWriter := httptest.NewRecorder()
+
+	request, _ := http.NewRequest("POST", "/email/resend", nil)
+	request.AddCookie(&http.Cookie{Name: "session_id", Value: "123456"})
+	request = withResponseCode(request)
+
+	authClient.EXPECT().RequestEmailConfirmation(gomock.Any(), &authproto.SessionData{SessionID: "123456"}).
+		Return(nil, status.Error(codes.ResourceExhausted, "too many requests"))
+
+	authHandler.ResendEmailConfirmation(mockResponseWriter, request)
+	assert.Contains(t, mockResponseWriter.Body.String(), `"code":429`)
+	assert.Empty(t, mailQueue.messages)
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	tests := []struct {
+		name      string
+		reset     *authproto.PasswordReset
+		wantMails int
+	}{
+		{name: "known email", reset: &authproto.PasswordReset{Email: "user@example.com", Token: "signed.token"},
+			wantMails: 1},
+		{name: "unknown email", reset: &authproto.PasswordReset{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authClient := mock_user_client.NewMockAuthClient(ctrl)
+			defer ctrl.Finish()
+
+			emailer, mailQueue := newTestEmailer(t)
+			authHandler := delivery.NewAuthHandler(authClient, nil, emailer)
+			mockResponseWriter := httptest.NewRecorder()
+
+			request, _ := http.NewRequest("POST", "/password/reset/request",
+				bytes.NewBufferString(`{"email":"user@example.com"}`))
+
+			authClient.EXPECT().RequestPasswordReset(gomock.Any(),
+				&authproto.PasswordResetRequest{Email: "user@example.com"}).Return(tt.reset, nil)
+
+			authHandler.RequestPasswordReset(mockResponseWriter, request)
+			assert.Contains(t, mockResponseWriter.Body.String(), `"code":200`)
+			assert.Len(t, mailQueue.messages, tt.wantMails)
+
+			if tt.wantMails != 0 {
+				assert.Contains(t, mailQueue.messages[0].Text, "http://vol-4-ok.ru/reset-password?token=signed.token")
+			}
+		})
+	}
+}
+
+func TestPasswordErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(handler *delivery.AuthHandler, client *mock_user_client.MockAuthClient) *httptest.ResponseRecorder
+		wantCode int
+	}{
+		{name: "reset with used link", call: func(handler *delivery.AuthHandler,
+			client *mock_user_client.MockAuthClient) *httptest.ResponseRecorder {
+			client.EXPECT().ResetPassword(gomock.Any(), gomock.Any()).
+				Return(nil, status.Error(codes.FailedPrecondition, "used"))
+
+			request, _ := http.NewRequest("POST", "/password/reset",
+				bytes.NewBufferString(`{"token":"signed.token","password":"NewPassword1","passwordRepeat":"NewPassword1"}`))
+			writer := httptest.NewRecorder()
+			handler.ResetPassword(writer, withResponseCode(request))
+
+			return writer
+		}, wantCode: http.StatusBadRequest},
+		{name: "change with wrong password", call: func(handler *delivery.AuthHandler,
+			client *mock_user_client.MockAuthClient) *httptest.ResponseRecorder {
+			client.EXPECT().ChangePassword(gomock.Any(), &authproto.ChangePasswordRequest{SessionID: "123456",
+				OldPassword: "Wrong1234", Password: "NewPassword1", PasswordRepeat: "NewPassword1"}).
+				Return(nil, status.Error(codes.PermissionDenied, "wrong"))
+
+			request, _ := http.NewRequest("POST", "/password/change",
+				bytes.NewBufferString(`{"oldPassword":"Wrong1234","password":"NewPassword1","passwordRepeat":"NewPassword1"}`))
+			request.AddCookie(&http.Cookie{Name: "session_id", Value: "123456"})
+			writer := httptest.NewRecorder()
+			handler.ChangePassword(writer, withResponseCode(request))
+
+			return writer
+		}, wantCode: http.StatusForbidden},
+		{name: "change to weak password", call: func(handler *delivery.AuthHandler,
+			client *mock_user_client.MockAuthClient) *httptest.ResponseRecorder {
+			client.EXPECT().ChangePassword(gomock.Any(), gomock.Any()).
+				Return(nil, status.Error(codes.InvalidArgument, "weak"))
+
+			request, _ := http.NewRequest("POST", "/password/change",
+				bytes.NewBufferString(`{"oldPassword":"OldPassword1","password":"weak","passwordRepeat":"weak"}`))
+			request.AddCookie(&http.Cookie{Name: "session_id", Value: "123456"})
+			writer := httptest.NewRecorder()
+			handler.ChangePassword(writer, withResponseCode(request))
+
+			return writer
+		}, wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authClient := mock_user_client.NewMockAuthClient(ctrl)
+			defer ctrl.Finish()
+
+			writer := tt.call(delivery.NewAuthHandler(authClient, nil, nil), authClient)
+			assert.Contains(t, writer.Body.String(), fmt.Sprintf(`"code":%d`, tt.wantCode))
+		})
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	currentID := userusecases.SessionHandle("123456")
+
+	tests := []struct {
+		name        string
+		id          string
+		revokeErr   error
+		wantCode    int
+		wantCleared bool
+	}{
+		{name: "other session", id: "0123456789abcdef", wantCode: http.StatusOK},
+		{name: "current session", id: currentID, wantCode: http.StatusOK, wantCleared: true},
+		{name: "unknown session", id: "0123456789abcdef", revokeErr: status.Error(codes.NotFound, "not found"),
+			wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authClient := mock_user_client.NewMockAuthClient(ctrl)
+			defer ctrl.Finish()
+
+			authClient.EXPECT().RevokeSession(gomock.Any(), &authproto.RevokeSessionRequest{SessionID: "123456",
+				ID: tt.id}).Return(&emptypb.Empty{}, tt.revokeErr)
+
+			if tt.revokeErr == nil && !tt.wantCleared {
+				authClient.EXPECT().ListSessions(gomock.Any(), &authproto.SessionData{SessionID: "123456"}).
+					Return(&authproto.SessionsList{Sessions: []*authproto.ActiveSession{
+						{ID: currentID, Device: "Firefox on Linux", Current: true},
+					}}, nil)
+			}
+
+			request, _ := http.NewRequest("POST", "/auth/sessions/revoke",
+				bytes.NewBufferString(fmt.Sprintf(`{"id":%q}`, tt.id)))
+			request.AddCookie(&http.Cookie{Name: "session_id", Value: "123456"})
+			writer := httptest.NewRecorder()
+
+			delivery.NewAuthHandler(authClient, nil, nil).RevokeSession(writer, withResponseCode(request))
+			assert.Contains(t, writer.Body.String(), fmt.Sprintf(`"code":%d`, tt.wantCode))
+
+			cookies := writer.Result().Cookies()
+			if tt.wantCleared {
+				assert.Len(t, cookies, 1)
+				assert.True(t, cookies[0].Expires.Before(time.Now()))
+			} else {
+				assert.Empty(t, cookies)
+			}
+		})
+	}
+}
+
 func TestGetCSRFToken(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	authClient := mock_user_client.NewMockAuthClient(ctrl)
 	profileClient := mock_user_profile.NewMockProfileClient(ctrl)
 	defer ctrl.Finish()
 
-	authHandler := delivery.NewAuthHandler(authClient, profileClient)
+	authHandler := delivery.NewAuthHandler(authClient, profileClient, nil)
 	mockResponseWriter := httptest.NewRecorder()
 
 	testUser := models.UnauthorizedUser{
@@ -2,27 +2,90 @@ package delivery
 
 import (
 	"context"
+	"crypto/hmac"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
 	protobuf "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
 	userusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	defaultConfirmTokenTTL     = 24 * time.Hour
+	defaultEmailResendInterval = time.Minute
+	defaultEmailSendsPerHour   = 5
+	defaultResetTokenTTL       = time.Hour
+	defaultSessionIdleTTL      = 72 * time.Hour
+	defaultSessionAbsoluteTTL  = 30 * 24 * time.Hour
+)
+
 var (
 	errWrongPassword = errors.New("passwords do not match")
+	errNotAuthorized = errors.New("user is not authorized")
+	errHashPassword  = errors.New("failed to hash password")
 )
 
 type AuthManager struct {
 	protobuf.UnimplementedAuthServer
 
 	userStorage userusecases.UsersStorageInterface
+	tokens      *userusecases.TokenSigner
+	confirmCfg  config.EmailConfirmationConfig
+	resetCfg    config.PasswordResetConfig
+	sessionsCfg config.SessionsConfig
 }
 
-func NewAuthManager(storage userusecases.UsersStorageInterface) *AuthManager {
+func NewAuthManager(storage userusecases.UsersStorageInterface, tokens *userusecases.TokenSigner,
+	confirmCfg config.EmailConfirmationConfig, resetCfg config.PasswordResetConfig,
+	sessionsCfg config.SessionsConfig) *AuthManager {
+	if confirmCfg.TokenTTL <= 0 {
+		confirmCfg.TokenTTL = defaultConfirmTokenTTL
+	}
+
+	if confirmCfg.ResendInterval <= 0 {
+		confirmCfg.ResendInterval = defaultEmailResendInterval
+	}
+
+	if confirmCfg.MaxSendsPerHour <= 0 {
+		confirmCfg.MaxSendsPerHour = defaultEmailSendsPerHour
+	}
+
+	if resetCfg.TokenTTL <= 0 {
+		resetCfg.TokenTTL = defaultResetTokenTTL
+	}
+
+	if resetCfg.ResendInterval <= 0 {
+		resetCfg.ResendInterval = defaultEmailResendInterval
+	}
+
+	if resetCfg.MaxSendsPerHour <= 0 {
+		resetCfg.MaxSendsPerHour = defaultEmailSendsPerHour
+	}
+
+	if sessionsCfg.IdleTTL <= 0 {
+		sessionsCfg.IdleTTL = defaultSessionIdleTTL
+	}
+
+	if sessionsCfg.AbsoluteTTL <= 0 {
+		sessionsCfg.AbsoluteTTL = defaultSessionAbsoluteTTL
+	}
+
 	return &AuthManager{
 		userStorage: storage,
+		tokens:      tokens,
+		confirmCfg:  confirmCfg,
+		resetCfg:    resetCfg,
+		sessionsCfg: sessionsCfg,
 	}
 }
 
@@ -32,10 +95,47 @@ func newProtobufUser(user *models.User) *protobuf.User {
 	}
 
 	return &protobuf.User{
-		ID:           uint64(user.ID),
-		Email:        user.Email,
-		PasswordHash: user.PasswordHash,
+		ID:             uint64(user.ID),
+		Email:          user.Email,
+		PasswordHash:   user.PasswordHash,
+		EmailConfirmed: user.EmailConfirmed,
+		PendingEmail:   user.PendingEmail,
+	}
+}
+
+func newSessionClient(client *protobuf.ClientInfo) models.SessionClient {
+	return models.SessionClient{
+		IP:        client.GetIP(),
+		UserAgent: client.GetUserAgent(),
+	}
+}
+
+// grpcError передаёт ошибки сценариев кодами gRPC, чтобы обработчики основного сервиса могли их различить.
+func grpcError(err error) error {
+	var code codes.Code
+
+	switch {
+	case errors.Is(err, userusecases.ErrTokenInvalid), errors.Is(err, userusecases.ErrTokenExpired),
+		errors.Is(err, userusecases.ErrPasswordInvalid):
+		code = codes.InvalidArgument
+	case errors.Is(err, userusecases.ErrEmailAlreadyConfirmed),
+		errors.Is(err, userusecases.ErrConfirmationOutdated), errors.Is(err, userusecases.ErrResetTokenInvalid):
+		code = codes.FailedPrecondition
+	case errors.Is(err, userusecases.ErrWrongPassword):
+		code = codes.PermissionDenied
+	case errors.Is(err, userusecases.ErrEmailTaken):
+		code = codes.AlreadyExists
+	case errors.Is(err, userusecases.ErrTooManyRequests):
+		code = codes.ResourceExhausted
+	case errors.Is(err, userusecases.ErrSessionNotFound):
+		code = codes.NotFound
+	case errors.Is(err, errNotAuthorized):
+		code = codes.Unauthenticated
+	default:
+		return err
 	}
+
+	return status.Error(code, err.Error())
 }
 
 func (manager *AuthManager) Login(ctx context.Context, in *protobuf.ExistedUserData) (*protobuf.LoggedUser, error) {
@@ -52,7 +152,7 @@ func (manager *AuthManager) Login(ctx context.Context, in *protobuf.ExistedUserD
 		return nil, errWrongPassword
 	}
 
-	sessionID := storage.AddSession(ctx, user.ID)
+	sessionID, expiresAt := manager.addSession(ctx, user.ID, in.GetClient())
 
 	return &protobuf.LoggedUser{
 		ID:           uint64(user.ID),
@@ -60,6 +160,7 @@ func (manager *AuthManager) Login(ctx context.Context, in *protobuf.ExistedUserD
 		PasswordHash: user.PasswordHash,
 		IsAuth:       true,
 		SessionID:    sessionID,
+		ExpiresAt:    expiresAt,
 	}, nil
 }
 
@@ -87,7 +188,7 @@ func (manager *AuthManager) Signup(ctx context.Context,
 		return nil, err
 	}
 
-	sessionID := storage.AddSession(ctx, user.ID)
+	sessionID, expiresAt := manager.addSession(ctx, user.ID, in.GetClient())
 
 	return &protobuf.LoggedUser{
 		ID:           uint64(user.ID),
@@ -95,38 +196,311 @@ func (manager *AuthManager) Signup(ctx context.Context,
 		PasswordHash: user.PasswordHash,
 		SessionID:    sessionID,
 		IsAuth:       true,
+		ExpiresAt:    expiresAt,
 	}, nil
 }
 
+// addSession возвращает идентификатор новой сессии и время, когда она истечёт без обращений, в секундах.
+func (manager *AuthManager) addSession(ctx context.Context, userID uint, client *protobuf.ClientInfo) (string,
+	int64) {
+	idleTTL := manager.sessionsCfg.IdleTTL
+	absoluteTTL := manager.sessionsCfg.AbsoluteTTL
+
+	sessionID := manager.userStorage.AddSession(ctx, userID, newSessionClient(client), idleTTL, absoluteTTL)
+
+	return sessionID, time.Now().Add(min(idleTTL, absoluteTTL)).Unix()
+}
+
+// GetCurrentUser продлевает сессию при каждом обращении. ExpiresAt в ответе - новый срок сессии,
+// до него нужно продлить и куку.
 func (manager *AuthManager) GetCurrentUser(ctx context.Context, in *protobuf.SessionData) (*protobuf.AuthUser, error) {
-	sessionID := in.GetSessionID()
 	storage := manager.userStorage
 
-	if !storage.SessionExists(sessionID) {
+	userID, expiresAt, err := storage.TouchSession(ctx, in.GetSessionID(), newSessionClient(in.GetClient()),
+		manager.sessionsCfg.IdleTTL, manager.sessionsCfg.AbsoluteTTL)
+	if errors.Is(err, userusecases.ErrSessionNotFound) {
 		return &protobuf.AuthUser{}, nil
 	}
 
-	user, _ := storage.GetUserBySession(ctx, sessionID)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	user, err := storage.GetUserByID(ctx, userID)
+	// сессия пережила удалённого пользователя
+	if errors.Is(err, userusecases.ErrUserNotFound) {
+		return &protobuf.AuthUser{}, nil
+	}
+
+	if err != nil {
+		return nil, grpcError(err)
+	}
 
 	return &protobuf.AuthUser{
-		ID:           uint64(user.ID),
-		Email:        user.Email,
-		PasswordHash: user.PasswordHash,
-		IsAuth:       true,
+		ID:             uint64(user.ID),
+		Email:          user.Email,
+		PasswordHash:   user.PasswordHash,
+		IsAuth:         true,
+		EmailConfirmed: user.EmailConfirmed,
+		PendingEmail:   user.PendingEmail,
+		ExpiresAt:      expiresAt.Unix(),
 	}, nil
 }
 
+// ListSessions возвращает активные сессии пользователя, текущая отмечена Current.
+func (manager *AuthManager) ListSessions(ctx context.Context, in *protobuf.SessionData) (*protobuf.SessionsList,
+	error) {
+	storage := manager.userStorage
+
+	user, err := storage.GetUserBySession(ctx, in.GetSessionID())
+	if err != nil || user == nil {
+		return nil, grpcError(errNotAuthorized)
+	}
+
+	sessions, err := storage.ListSessions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := userusecases.SessionHandle(in.GetSessionID())
+	list := &protobuf.SessionsList{Sessions: make([]*protobuf.ActiveSession, 0, len(sessions))}
+
+	for _, session := range sessions {
+		list.Sessions = append(list.Sessions, &protobuf.ActiveSession{
+			ID:        session.ID,
+			Device:    session.Device,
+			IP:        session.IP,
+			UserAgent: session.UserAgent,
+			CreatedAt: session.CreatedAt.Unix(),
+			LastSeen:  session.LastSeen.Unix(),
+			ExpiresAt: session.ExpiresAt.Unix(),
+			Current:   session.ID == current,
+		})
+	}
+
+	return list, nil
+}
+
+// RevokeSession завершает одну из сессий пользователя по её ID из списка сессий.
+func (manager *AuthManager) RevokeSession(ctx context.Context, in *protobuf.RevokeSessionRequest) (*emptypb.Empty,
+	error) {
+	storage := manager.userStorage
+
+	user, err := storage.GetUserBySession(ctx, in.GetSessionID())
+	if err != nil || user == nil {
+		return nil, grpcError(errNotAuthorized)
+	}
+
+	if err := storage.RemoveUserSession(ctx, user.ID, in.GetID()); err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeOtherSessions завершает все сессии пользователя, кроме текущей.
+func (manager *AuthManager) RevokeOtherSessions(ctx context.Context, in *protobuf.SessionData) (*emptypb.Empty,
+	error) {
+	storage := manager.userStorage
+
+	user, err := storage.GetUserBySession(ctx, in.GetSessionID())
+	if err != nil || user == nil {
+		return nil, grpcError(errNotAuthorized)
+	}
+
+	if err := storage.RemoveUserSessions(ctx, user.ID, in.GetSessionID()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// EditEmail откладывает новый адрес до подтверждения: пока по ссылке не перешли, вход работает по старому.
 func (manager *AuthManager) EditEmail(ctx context.Context, in *protobuf.EditEmailRequest) (*protobuf.User, error) {
 	email := in.GetEmail()
 	sessionID := in.GetSessionID()
 	storage := manager.userStorage
 
-	user, _ := storage.GetUserBySession(ctx, sessionID)
+	user, err := storage.GetUserBySession(ctx, sessionID)
+	if err != nil || user == nil {
+		return nil, grpcError(errNotAuthorized)
+	}
 
-	user, err := storage.EditUserEmail(ctx, user.ID, email)
+	user, err = storage.EditUserEmail(ctx, user.ID, email)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return newProtobufUser(user), nil
+}
+
+// RequestEmailConfirmation выдаёт токен для письма со ссылкой: на ожидающий новый адрес, а если его нет -
+// на текущий, пока тот не подтверждён. Письма на адрес ограничены по частоте.
+func (manager *AuthManager) RequestEmailConfirmation(ctx context.Context,
+	in *protobuf.SessionData) (*protobuf.EmailConfirmation, error) {
+	storage := manager.userStorage
+
+	user, err := storage.GetUserBySession(ctx, in.GetSessionID())
+	if err != nil || user == nil {
+		return nil, grpcError(errNotAuthorized)
+	}
+
+	email := user.PendingEmail
+	if email == "" {
+		if user.EmailConfirmed {
+			return nil, grpcError(userusecases.ErrEmailAlreadyConfirmed)
+		}
+
+		email = user.Email
+	}
+
+	allowed, err := storage.AllowEmail(ctx, userusecases.PurposeEmailConfirm, user.ID, email,
+		manager.confirmCfg.ResendInterval, manager.confirmCfg.MaxSendsPerHour)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return nil, grpcError(userusecases.ErrTooManyRequests)
+	}
+
+	return &protobuf.EmailConfirmation{
+		Email: email,
+		Token: manager.tokens.Sign(userusecases.PurposeEmailConfirm, user.ID, email,
+			manager.confirmCfg.TokenTTL),
+		ResendIn: int64(manager.confirmCfg.ResendInterval.Seconds()),
+	}, nil
+}
+
+// ConfirmEmail подтверждает адрес из токена. Токен одноразовый сам по себе: после подтверждения адрес
+// больше не ждёт подтверждения, и повторный переход по ссылке получает FailedPrecondition.
+func (manager *AuthManager) ConfirmEmail(ctx context.Context, in *protobuf.ConfirmEmailRequest) (*protobuf.User,
+	error) {
+	signed, err := manager.tokens.Verify(userusecases.PurposeEmailConfirm, in.GetToken())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	user, err := manager.userStorage.ConfirmEmail(ctx, signed.UserID, signed.Subject)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return newProtobufUser(user), nil
+}
+
+// RequestPasswordReset выдаёт токен для письма со ссылкой сброса. Для неизвестного адреса и при превышении
+// частоты возвращается пустой ответ без ошибки, чтобы по ответу нельзя было узнать, зарегистрирован ли адрес.
+func (manager *AuthManager) RequestPasswordReset(ctx context.Context,
+	in *protobuf.PasswordResetRequest) (*protobuf.PasswordReset, error) {
+	storage := manager.userStorage
+
+	user, err := storage.GetUserByEmail(ctx, in.GetEmail())
+	if err != nil {
+		return &protobuf.PasswordReset{}, nil //nolint:nilerr
+	}
+
+	allowed, err := storage.AllowEmail(ctx, userusecases.PurposePasswordReset, user.ID, user.Email,
+		manager.resetCfg.ResendInterval, manager.resetCfg.MaxSendsPerHour)
 	if err != nil {
 		return nil, err
 	}
 
+	if !allowed {
+		return &protobuf.PasswordReset{}, nil
+	}
+
+	return &protobuf.PasswordReset{
+		Email: user.Email,
+		Token: manager.tokens.Sign(userusecases.PurposePasswordReset, user.ID,
+			userusecases.PasswordFingerprint(user.PasswordHash), manager.resetCfg.TokenTTL),
+	}, nil
+}
+
+// ResetPassword задаёт новый пароль по токену из письма и завершает все сессии пользователя.
+// Токен привязан к старому хешу пароля, поэтому срабатывает один раз.
+func (manager *AuthManager) ResetPassword(ctx context.Context,
+	in *protobuf.ResetPasswordRequest) (*protobuf.User, error) {
+	storage := manager.userStorage
+
+	signed, err := manager.tokens.Verify(userusecases.PurposePasswordReset, in.GetToken())
+	if err != nil {
+		return nil, grpcError(userusecases.ErrResetTokenInvalid)
+	}
+
+	user, err := storage.GetUserByID(ctx, signed.UserID)
+	if err != nil {
+		return nil, grpcError(userusecases.ErrResetTokenInvalid)
+	}
+
+	if !hmac.Equal([]byte(signed.Subject), []byte(userusecases.PasswordFingerprint(user.PasswordHash))) {
+		return nil, grpcError(userusecases.ErrResetTokenInvalid)
+	}
+
+	err = manager.setPassword(ctx, user, in.GetPassword(), in.GetPasswordRepeat(), "")
+	if errors.Is(err, userusecases.ErrPasswordChanged) {
+		err = userusecases.ErrResetTokenInvalid
+	}
+
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return newProtobufUser(user), nil
+}
+
+// ChangePassword меняет пароль по текущему и завершает все сессии, кроме той, из которой его сменили.
+func (manager *AuthManager) ChangePassword(ctx context.Context,
+	in *protobuf.ChangePasswordRequest) (*protobuf.User, error) {
+	user, err := manager.userStorage.GetUserBySession(ctx, in.GetSessionID())
+	if err != nil || user == nil {
+		return nil, grpcError(errNotAuthorized)
+	}
+
+	if !utils.CheckPassword(in.GetOldPassword(), user.PasswordHash) {
+		return nil, grpcError(userusecases.ErrWrongPassword)
+	}
+
+	err = manager.setPassword(ctx, user, in.GetPassword(), in.GetPasswordRepeat(), in.GetSessionID())
+	if errors.Is(err, userusecases.ErrPasswordChanged) {
+		err = userusecases.ErrWrongPassword
+	}
+
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
 	return newProtobufUser(user), nil
 }
+
+func (manager *AuthManager) setPassword(ctx context.Context, user *models.User, password, passwordRepeat,
+	keepSessionID string) error {
+	if errs := utils.ValidatePassword(password, passwordRepeat); len(errs) != 0 {
+		return fmt.Errorf("%w: %s", userusecases.ErrPasswordInvalid, strings.Join(errs, ", "))
+	}
+
+	passwordHash := utils.HashPassword(password)
+	if passwordHash == "" {
+		return errHashPassword
+	}
+
+	if err := manager.userStorage.SetPassword(ctx, user.ID, user.PasswordHash, passwordHash); err != nil {
+		return err
+	}
+
+	user.PasswordHash = passwordHash
+
+	// пароль уже сменён, и ответ с ошибкой лишь собьёт пользователя: оставшиеся сессии он завершит сам
+	if err := manager.userStorage.RemoveUserSessions(ctx, user.ID, keepSessionID); err != nil {
+		logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+		logging.LogError(logger, fmt.Errorf("password is changed, but sessions are not removed, err=%w", err))
+	}
+
+	return nil
+}
+
+// BackfillSessions описывает сессии, открытые до появления сроков и индекса: иначе они живут вечно,
+// а смена пароля их не завершит.
+func (manager *AuthManager) BackfillSessions(ctx context.Context) (int, error) {
+	return manager.userStorage.BackfillSessions(ctx, manager.sessionsCfg.IdleTTL, manager.sessionsCfg.AbsoluteTTL)
+}
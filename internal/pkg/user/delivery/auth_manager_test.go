@@ -0,0 +1,225 @@
+//nolint:all
+package delivery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	models "github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	delivery "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	mock_usecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/mocks"
+	userusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
+	utils "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+func newTestManager(t *testing.T) (*delivery.AuthManager, *mock_usecases.MockUsersStorageInterface) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	storage := mock_usecases.NewMockUsersStorageInterface(ctrl)
+	manager := delivery.NewAuthManager(storage, userusecases.NewTokenSigner("secret"),
+		config.EmailConfirmationConfig{}, config.PasswordResetConfig{}, config.SessionsConfig{})
+
+	return manager, storage
+}
+
+func TestRequestPasswordResetUnknownEmail(t *testing.T) {
+	manager, storage := newTestManager(t)
+
+	storage.EXPECT().GetUserByEmail(gomock.Any(), "nobody@example.com").Return(nil, assert.AnError)
+
+	reset, err := manager.RequestPasswordReset(context.Background(),
+		&authproto.PasswordResetRequest{Email: "nobody@example.com"})
+	assert.NoError(t, err)
+	assert.Empty(t, reset.Token)
+}
+
+func TestResetPasswordIsSingleUse(t *testing.T) {
+	manager, storage := newTestManager(t)
+	ctx := context.Background()
+
+	user := &models.User{ID: 7, Email: "user@example.com", PasswordHash: utils.HashPassword("OldPassword1")}
+
+	storage.EXPECT().GetUserByEmail(gomock.Any(), "user@example.com").Return(user, nil)
+	storage.EXPECT().AllowEmail(gomock.Any(), userusecases.PurposePasswordReset, uint(7), "user@example.com",
+		gomock.Any(), gomock.Any()).Return(true, nil)
+
+	reset, err := manager.RequestPasswordReset(ctx, &authproto.PasswordResetRequest{Email: "user@example.com"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, reset.Token)
+
+	storedHash := user.PasswordHash
+
+	storage.EXPECT().GetUserByID(gomock.Any(), uint(7)).DoAndReturn(func(context.Context, uint) (*models.User,
+		error) {
+		return &models.User{ID: 7, Email: "user@example.com", PasswordHash: storedHash}, nil
+	}).Times(3)
+
+	_, err = manager.ResetPassword(ctx, &authproto.ResetPasswordRequest{Token: reset.Token, Password: "weak",
+		PasswordRepeat: "weak"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	storage.EXPECT().SetPassword(gomock.Any(), uint(7), storedHash, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uint, _, hash string) error {
+			storedHash = hash
+
+			return nil
+		})
+	storage.EXPECT().RemoveUserSessions(gomock.Any(), uint(7), "").Return(nil)
+
+	_, err = manager.ResetPassword(ctx, &authproto.ResetPasswordRequest{Token: reset.Token,
+		Password: "NewPassword1", PasswordRepeat: "NewPassword1"})
+	assert.NoError(t, err)
+	assert.True(t, utils.CheckPassword("NewPassword1", storedHash))
+
+	_, err = manager.ResetPassword(ctx, &authproto.ResetPasswordRequest{Token: reset.Token,
+		Password: "OtherPassword1", PasswordRepeat: "OtherPassword1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestChangePassword(t *testing.T) {
+	manager, storage := newTestManager(t)
+	ctx := context.Background()
+
+	user := &models.User{ID: 7, Email: "user@example.com", PasswordHash: utils.HashPassword("OldPassword1")}
+
+	storage.EXPECT().GetUserBySession(gomock.Any(), "current").Return(user, nil).Times(2)
+
+	_, err := manager.ChangePassword(ctx, &authproto.ChangePasswordRequest{SessionID: "current",
+		OldPassword: "WrongPassword1", Password: "NewPassword1", PasswordRepeat: "NewPassword1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	storage.EXPECT().SetPassword(gomock.Any(), uint(7), user.PasswordHash, gomock.Any()).Return(nil)
+	storage.EXPECT().RemoveUserSessions(gomock.Any(), uint(7), "current").Return(nil)
+
+	_, err = manager.ChangePassword(ctx, &authproto.ChangePasswordRequest{SessionID: "current",
+		OldPassword: "OldPassword1", Password: "NewPassword1", PasswordRepeat: "NewPassword1"})
+	assert.NoError(t, err)
+}
+
+func TestResetPasswordRace(t *testing.T) {
+	manager, storage := newTestManager(t)
+	ctx := context.Background()
+
+	user := &models.User{ID: 7, Email: "user@example.com", PasswordHash: utils.HashPassword("OldPassword1")}
+
+	storage.EXPECT().GetUserByEmail(gomock.Any(), "user@example.com").Return(user, nil)
+	storage.EXPECT().AllowEmail(gomock.Any(), userusecases.PurposePasswordReset, uint(7), "user@example.com",
+		gomock.Any(), gomock.Any()).Return(true, nil)
+
+	reset, err := manager.RequestPasswordReset(ctx, &authproto.PasswordResetRequest{Email: "user@example.com"})
+	assert.NoError(t, err)
+
+	// второй запрос прочитал старый хеш, но первый успел сменить пароль раньше
+	storage.EXPECT().GetUserByID(gomock.Any(), uint(7)).Return(&models.User{ID: 7, Email: "user@example.com",
+		PasswordHash: user.PasswordHash}, nil)
+	storage.EXPECT().SetPassword(gomock.Any(), uint(7), user.PasswordHash, gomock.Any()).
+		Return(userusecases.ErrPasswordChanged)
+
+	_, err = manager.ResetPassword(ctx, &authproto.ResetPasswordRequest{Token: reset.Token,
+		Password: "NewPassword1", PasswordRepeat: "NewPassword1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestChangePasswordKeepsResultWhenSessionsNotRemoved(t *testing.T) {
+	manager, storage := newTestManager(t)
+	ctx := context.Background()
+
+	user := &models.User{ID: 7, Email: "user@example.com", PasswordHash: utils.HashPassword("OldPassword1")}
+
+	storage.EXPECT().GetUserBySession(gomock.Any(), "current").Return(user, nil)
+	storage.EXPECT().SetPassword(gomock.Any(), uint(7), user.PasswordHash, gomock.Any()).Return(nil)
+	storage.EXPECT().RemoveUserSessions(gomock.Any(), uint(7), "current").Return(assert.AnError)
+
+	_, err := manager.ChangePassword(ctx, &authproto.ChangePasswordRequest{SessionID: "current",
+		OldPassword: "OldPassword1", Password: "NewPassword1", PasswordRepeat: "NewPassword1"})
+	assert.NoError(t, err)
+	assert.True(t, utils.CheckPassword("NewPassword1", user.PasswordHash))
+}
+
+func TestEditEmailExpiredSession(t *testing.T) {
+	manager, storage := newTestManager(t)
+
+	storage.EXPECT().GetUserBySession(gomock.Any(), "expired").Return(nil, nil)
+
+	_, err := manager.EditEmail(context.Background(), &authproto.EditEmailRequest{SessionID: "expired",
+		Email: "new@example.com"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGetCurrentUserSlidesSession(t *testing.T) {
+	manager, storage := newTestManager(t)
+	ctx := context.Background()
+
+	client := &authproto.ClientInfo{IP: "203.0.113.7", UserAgent: "Mozilla/5.0 Firefox/125.0"}
+	expiresAt := time.Now().Add(72 * time.Hour)
+
+	storage.EXPECT().TouchSession(gomock.Any(), "current", models.SessionClient{IP: "203.0.113.7",
+		UserAgent: "Mozilla/5.0 Firefox/125.0"}, 72*time.Hour, 30*24*time.Hour).Return(uint(7), expiresAt, nil)
+	storage.EXPECT().GetUserByID(gomock.Any(), uint(7)).Return(&models.User{ID: 7, Email: "user@example.com"}, nil)
+
+	user, err := manager.GetCurrentUser(ctx, &authproto.SessionData{SessionID: "current", Client: client})
+	assert.NoError(t, err)
+	assert.True(t, user.IsAuth)
+	assert.Equal(t, expiresAt.Unix(), user.ExpiresAt)
+
+	storage.EXPECT().TouchSession(gomock.Any(), "expired", gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint(0), time.Time{}, userusecases.ErrSessionNotFound)
+
+	user, err = manager.GetCurrentUser(ctx, &authproto.SessionData{SessionID: "expired"})
+	assert.NoError(t, err)
+	assert.False(t, user.IsAuth)
+
+	storage.EXPECT().TouchSession(gomock.Any(), "orphan", gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint(8), expiresAt, nil)
+	storage.EXPECT().GetUserByID(gomock.Any(), uint(8)).Return(nil, userusecases.ErrUserNotFound)
+
+	user, err = manager.GetCurrentUser(ctx, &authproto.SessionData{SessionID: "orphan"})
+	assert.NoError(t, err)
+	assert.False(t, user.IsAuth)
+
+	// сбой хранилища - не повод разлогинить пользователя
+	storage.EXPECT().TouchSession(gomock.Any(), "current", gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint(0), time.Time{}, assert.AnError)
+
+	_, err = manager.GetCurrentUser(ctx, &authproto.SessionData{SessionID: "current"})
+	assert.Error(t, err)
+}
+
+func TestListAndRevokeSessions(t *testing.T) {
+	manager, storage := newTestManager(t)
+	ctx := context.Background()
+
+	user := &models.User{ID: 7, Email: "user@example.com"}
+	current := userusecases.SessionHandle("current")
+
+	storage.EXPECT().GetUserBySession(gomock.Any(), "current").Return(user, nil).Times(3)
+	storage.EXPECT().ListSessions(gomock.Any(), uint(7)).Return([]*models.ActiveSession{
+		{ID: current, Device: "Firefox on Linux"},
+		{ID: userusecases.SessionHandle("other"), Device: "Safari on iOS"},
+	}, nil)
+
+	list, err := manager.ListSessions(ctx, &authproto.SessionData{SessionID: "current"})
+	assert.NoError(t, err)
+	assert.Len(t, list.Sessions, 2)
+	assert.True(t, list.Sessions[0].Current)
+	assert.False(t, list.Sessions[1].Current)
+
+	storage.EXPECT().RemoveUserSession(gomock.Any(), uint(7), "unknown").Return(userusecases.ErrSessionNotFound)
+
+	_, err = manager.RevokeSession(ctx, &authproto.RevokeSessionRequest{SessionID: "current", ID: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	storage.EXPECT().RemoveUserSessions(gomock.Any(), uint(7), "current").Return(nil)
+
+	_, err = manager.RevokeOtherSessions(ctx, &authproto.SessionData{SessionID: "current"})
+	assert.NoError(t, err)
+}
@@ -37,6 +37,46 @@ func (m *MockAuthClient) EXPECT() *MockAuthClientMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthClient) ChangePassword(ctx context.Context, in *grpc.ChangePasswordRequest, opts ...grpc0.CallOption) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthClientMockRecorder) ChangePassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthClient)(nil).ChangePassword), varargs...)
+}
+
+// ConfirmEmail mocks base method.
+func (m *MockAuthClient) ConfirmEmail(ctx context.Context, in *grpc.ConfirmEmailRequest, opts ...grpc0.CallOption) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmEmail", varargs...)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmail indicates an expected call of ConfirmEmail.
+func (mr *MockAuthClientMockRecorder) ConfirmEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmail", reflect.TypeOf((*MockAuthClient)(nil).ConfirmEmail), varargs...)
+}
+
 // EditEmail mocks base method.
 func (m *MockAuthClient) EditEmail(ctx context.Context, in *grpc.EditEmailRequest, opts ...grpc0.CallOption) (*grpc.User, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +117,26 @@ func (mr *MockAuthClientMockRecorder) GetCurrentUser(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockAuthClient)(nil).GetCurrentUser), varargs...)
 }
 
+// ListSessions mocks base method.
+func (m *MockAuthClient) ListSessions(ctx context.Context, in *grpc.SessionData, opts ...grpc0.CallOption) (*grpc.SessionsList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*grpc.SessionsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthClientMockRecorder) ListSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthClient)(nil).ListSessions), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockAuthClient) Login(ctx context.Context, in *grpc.ExistedUserData, opts ...grpc0.CallOption) (*grpc.LoggedUser, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +177,106 @@ func (mr *MockAuthClientMockRecorder) Logout(ctx, in interface{}, opts ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthClient)(nil).Logout), varargs...)
 }
 
+// RequestEmailConfirmation mocks base method.
+func (m *MockAuthClient) RequestEmailConfirmation(ctx context.Context, in *grpc.SessionData, opts ...grpc0.CallOption) (*grpc.EmailConfirmation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestEmailConfirmation", varargs...)
+	ret0, _ := ret[0].(*grpc.EmailConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestEmailConfirmation indicates an expected call of RequestEmailConfirmation.
+func (mr *MockAuthClientMockRecorder) RequestEmailConfirmation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailConfirmation", reflect.TypeOf((*MockAuthClient)(nil).RequestEmailConfirmation), varargs...)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockAuthClient) RequestPasswordReset(ctx context.Context, in *grpc.PasswordResetRequest, opts ...grpc0.CallOption) (*grpc.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPasswordReset", varargs...)
+	ret0, _ := ret[0].(*grpc.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthClientMockRecorder) RequestPasswordReset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthClient)(nil).RequestPasswordReset), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthClient) ResetPassword(ctx context.Context, in *grpc.ResetPasswordRequest, opts ...grpc0.CallOption) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthClientMockRecorder) ResetPassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthClient)(nil).ResetPassword), varargs...)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockAuthClient) RevokeOtherSessions(ctx context.Context, in *grpc.SessionData, opts ...grpc0.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockAuthClientMockRecorder) RevokeOtherSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockAuthClient)(nil).RevokeOtherSessions), varargs...)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthClient) RevokeSession(ctx context.Context, in *grpc.RevokeSessionRequest, opts ...grpc0.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthClientMockRecorder) RevokeSession(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthClient)(nil).RevokeSession), varargs...)
+}
+
 // Signup mocks base method.
 func (m *MockAuthClient) Signup(ctx context.Context, in *grpc.NewUserData, opts ...grpc0.CallOption) (*grpc.LoggedUser, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +320,36 @@ func (m *MockAuthServer) EXPECT() *MockAuthServerMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServer) ChangePassword(arg0 context.Context, arg1 *grpc.ChangePasswordRequest) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServerMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServer)(nil).ChangePassword), arg0, arg1)
+}
+
+// ConfirmEmail mocks base method.
+func (m *MockAuthServer) ConfirmEmail(arg0 context.Context, arg1 *grpc.ConfirmEmailRequest) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmail", arg0, arg1)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmail indicates an expected call of ConfirmEmail.
+func (mr *MockAuthServerMockRecorder) ConfirmEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmail", reflect.TypeOf((*MockAuthServer)(nil).ConfirmEmail), arg0, arg1)
+}
+
 // EditEmail mocks base method.
 func (m *MockAuthServer) EditEmail(arg0 context.Context, arg1 *grpc.EditEmailRequest) (*grpc.User, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +380,21 @@ func (mr *MockAuthServerMockRecorder) GetCurrentUser(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockAuthServer)(nil).GetCurrentUser), arg0, arg1)
 }
 
+// ListSessions mocks base method.
+func (m *MockAuthServer) ListSessions(arg0 context.Context, arg1 *grpc.SessionData) (*grpc.SessionsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].(*grpc.SessionsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServerMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthServer)(nil).ListSessions), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockAuthServer) Login(arg0 context.Context, arg1 *grpc.ExistedUserData) (*grpc.LoggedUser, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +425,81 @@ func (mr *MockAuthServerMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServer)(nil).Logout), arg0, arg1)
 }
 
+// RequestEmailConfirmation mocks base method.
+func (m *MockAuthServer) RequestEmailConfirmation(arg0 context.Context, arg1 *grpc.SessionData) (*grpc.EmailConfirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailConfirmation", arg0, arg1)
+	ret0, _ := ret[0].(*grpc.EmailConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestEmailConfirmation indicates an expected call of RequestEmailConfirmation.
+func (mr *MockAuthServerMockRecorder) RequestEmailConfirmation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailConfirmation", reflect.TypeOf((*MockAuthServer)(nil).RequestEmailConfirmation), arg0, arg1)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServer) RequestPasswordReset(arg0 context.Context, arg1 *grpc.PasswordResetRequest) (*grpc.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(*grpc.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServerMockRecorder) RequestPasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServer)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthServer) ResetPassword(arg0 context.Context, arg1 *grpc.ResetPasswordRequest) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServerMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthServer)(nil).ResetPassword), arg0, arg1)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockAuthServer) RevokeOtherSessions(arg0 context.Context, arg1 *grpc.SessionData) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockAuthServerMockRecorder) RevokeOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockAuthServer)(nil).RevokeOtherSessions), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthServer) RevokeSession(arg0 context.Context, arg1 *grpc.RevokeSessionRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServerMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthServer)(nil).RevokeSession), arg0, arg1)
+}
+
 // Signup mocks base method.
 func (m *MockAuthServer) Signup(arg0 context.Context, arg1 *grpc.NewUserData) (*grpc.LoggedUser, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mock_usecases
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -36,17 +37,62 @@ func (m *MockUsersStorageInterface) EXPECT() *MockUsersStorageInterfaceMockRecor
 }
 
 // AddSession mocks base method.
-func (m *MockUsersStorageInterface) AddSession(ctx context.Context, id uint) string {
+func (m *MockUsersStorageInterface) AddSession(ctx context.Context, id uint, client models.SessionClient, idleTTL, absoluteTTL time.Duration) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSession", ctx, id)
+	ret := m.ctrl.Call(m, "AddSession", ctx, id, client, idleTTL, absoluteTTL)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // AddSession indicates an expected call of AddSession.
-func (mr *MockUsersStorageInterfaceMockRecorder) AddSession(ctx, id interface{}) *gomock.Call {
+func (mr *MockUsersStorageInterfaceMockRecorder) AddSession(ctx, id, client, idleTTL, absoluteTTL interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSession", reflect.TypeOf((*MockUsersStorageInterface)(nil).AddSession), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSession", reflect.TypeOf((*MockUsersStorageInterface)(nil).AddSession), ctx, id, client, idleTTL, absoluteTTL)
+}
+
+// AllowEmail mocks base method.
+func (m *MockUsersStorageInterface) AllowEmail(ctx context.Context, purpose string, id uint, email string, interval time.Duration, perHour int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowEmail", ctx, purpose, id, email, interval, perHour)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowEmail indicates an expected call of AllowEmail.
+func (mr *MockUsersStorageInterfaceMockRecorder) AllowEmail(ctx, purpose, id, email, interval, perHour interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowEmail", reflect.TypeOf((*MockUsersStorageInterface)(nil).AllowEmail), ctx, purpose, id, email, interval, perHour)
+}
+
+// BackfillSessions mocks base method.
+func (m *MockUsersStorageInterface) BackfillSessions(ctx context.Context, idleTTL, absoluteTTL time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillSessions", ctx, idleTTL, absoluteTTL)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillSessions indicates an expected call of BackfillSessions.
+func (mr *MockUsersStorageInterfaceMockRecorder) BackfillSessions(ctx, idleTTL, absoluteTTL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillSessions", reflect.TypeOf((*MockUsersStorageInterface)(nil).BackfillSessions), ctx, idleTTL, absoluteTTL)
+}
+
+// ConfirmEmail mocks base method.
+func (m *MockUsersStorageInterface) ConfirmEmail(ctx context.Context, id uint, email string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmail", ctx, id, email)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmail indicates an expected call of ConfirmEmail.
+func (mr *MockUsersStorageInterfaceMockRecorder) ConfirmEmail(ctx, id, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmail", reflect.TypeOf((*MockUsersStorageInterface)(nil).ConfirmEmail), ctx, id, email)
 }
 
 // CreateUser mocks base method.
@@ -108,6 +154,21 @@ func (mr *MockUsersStorageInterfaceMockRecorder) GetUserByEmail(ctx, email inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUsersStorageInterface)(nil).GetUserByEmail), ctx, email)
 }
 
+// GetUserByID mocks base method.
+func (m *MockUsersStorageInterface) GetUserByID(ctx context.Context, userID uint) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUsersStorageInterfaceMockRecorder) GetUserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUsersStorageInterface)(nil).GetUserByID), ctx, userID)
+}
+
 // GetUserBySession mocks base method.
 func (m *MockUsersStorageInterface) GetUserBySession(ctx context.Context, sessionID string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +184,21 @@ func (mr *MockUsersStorageInterfaceMockRecorder) GetUserBySession(ctx, sessionID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBySession", reflect.TypeOf((*MockUsersStorageInterface)(nil).GetUserBySession), ctx, sessionID)
 }
 
+// ListSessions mocks base method.
+func (m *MockUsersStorageInterface) ListSessions(ctx context.Context, id uint) ([]*models.ActiveSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, id)
+	ret0, _ := ret[0].([]*models.ActiveSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockUsersStorageInterfaceMockRecorder) ListSessions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockUsersStorageInterface)(nil).ListSessions), ctx, id)
+}
+
 // RemoveSession mocks base method.
 func (m *MockUsersStorageInterface) RemoveSession(ctx context.Context, sessionID string) error {
 	m.ctrl.T.Helper()
@@ -137,6 +213,34 @@ func (mr *MockUsersStorageInterfaceMockRecorder) RemoveSession(ctx, sessionID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSession", reflect.TypeOf((*MockUsersStorageInterface)(nil).RemoveSession), ctx, sessionID)
 }
 
+// RemoveUserSession mocks base method.
+func (m *MockUsersStorageInterface) RemoveUserSession(ctx context.Context, id uint, handle string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserSession", ctx, id, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserSession indicates an expected call of RemoveUserSession.
+func (mr *MockUsersStorageInterfaceMockRecorder) RemoveUserSession(ctx, id, handle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserSession", reflect.TypeOf((*MockUsersStorageInterface)(nil).RemoveUserSession), ctx, id, handle)
+}
+
+// RemoveUserSessions mocks base method.
+func (m *MockUsersStorageInterface) RemoveUserSessions(ctx context.Context, id uint, except string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserSessions", ctx, id, except)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserSessions indicates an expected call of RemoveUserSessions.
+func (mr *MockUsersStorageInterfaceMockRecorder) RemoveUserSessions(ctx, id, except interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserSessions", reflect.TypeOf((*MockUsersStorageInterface)(nil).RemoveUserSessions), ctx, id, except)
+}
+
 // SessionExists mocks base method.
 func (m *MockUsersStorageInterface) SessionExists(sessionID string) bool {
 	m.ctrl.T.Helper()
@@ -151,6 +255,36 @@ func (mr *MockUsersStorageInterfaceMockRecorder) SessionExists(sessionID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionExists", reflect.TypeOf((*MockUsersStorageInterface)(nil).SessionExists), sessionID)
 }
 
+// SetPassword mocks base method.
+func (m *MockUsersStorageInterface) SetPassword(ctx context.Context, id uint, oldHash, newHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", ctx, id, oldHash, newHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword.
+func (mr *MockUsersStorageInterfaceMockRecorder) SetPassword(ctx, id, oldHash, newHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockUsersStorageInterface)(nil).SetPassword), ctx, id, oldHash, newHash)
+}
+
+// TouchSession mocks base method.
+func (m *MockUsersStorageInterface) TouchSession(ctx context.Context, sessionID string, client models.SessionClient, idleTTL, absoluteTTL time.Duration) (uint, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, sessionID, client, idleTTL, absoluteTTL)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TouchSession indicates an expected call of TouchSession.
+func (mr *MockUsersStorageInterfaceMockRecorder) TouchSession(ctx, sessionID, client, idleTTL, absoluteTTL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockUsersStorageInterface)(nil).TouchSession), ctx, sessionID, client, idleTTL, absoluteTTL)
+}
+
 // UserExists mocks base method.
 func (m *MockUsersStorageInterface) UserExists(ctx context.Context, email string) bool {
 	m.ctrl.T.Helper()
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,10 +13,12 @@ import (
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/repository"
+	userusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
 	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
 	"github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -27,6 +31,92 @@ var (
 	NameSeqUser         = pgx.Identifier{"public", "user_id_seq"} //nolint:gochecknoglobals
 )
 
+// Скользящее продление сессии: срок сдвигается на idle, но не дальше deadline - абсолютного срока от входа.
+// Сессии, открытые до появления сроков, получают описание и deadline при первом обращении.
+// KEYS: сессия, её описание; ARGV: сейчас, idle, absolute (мс), ip, user agent.
+//
+//nolint:gochecknoglobals
+var touchScript = redis.NewScript(2, `
+local userID = redis.call('GET', KEYS[1])
+if not userID then
+	return false
+end
+local now = tonumber(ARGV[1])
+local indexKey = 'user_sessions:' .. userID
+local deadline = tonumber(redis.call('HGET', KEYS[2], 'deadline'))
+if not deadline then
+	deadline = now + tonumber(ARGV[3])
+	redis.call('HSET', KEYS[2], 'user_id', userID, 'user_agent', ARGV[5], 'created_at', now, 'deadline', deadline)
+	redis.call('SADD', indexKey, KEYS[1])
+	redis.call('PEXPIRE', indexKey, ARGV[3])
+end
+local ttl = math.min(tonumber(ARGV[2]), deadline - now)
+if ttl <= 0 then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	redis.call('SREM', indexKey, KEYS[1])
+	return false
+end
+redis.call('HSET', KEYS[2], 'last_seen', now)
+if ARGV[4] ~= '' then
+	redis.call('HSET', KEYS[2], 'ip', ARGV[4])
+end
+redis.call('PEXPIRE', KEYS[1], ttl)
+redis.call('PEXPIRE', KEYS[2], ttl)
+return {userID, now + ttl}`)
+
+// Описывает сессию, открытую до появления сроков и индекса user_sessions: заводит описание с deadline от запуска,
+// как и touchScript при первом обращении, вносит в индекс и ставит срок жизни, если его нет.
+// Уже описанные сессии со сроком не трогает.
+// KEYS: сессия, её описание; ARGV: сейчас, idle, absolute (мс).
+//
+//nolint:gochecknoglobals
+var backfillScript = redis.NewScript(2, `
+local userID = redis.call('GET', KEYS[1])
+if not userID or not tonumber(userID) then
+	return 0
+end
+local now = tonumber(ARGV[1])
+local absolute = tonumber(ARGV[3])
+local indexKey = 'user_sessions:' .. userID
+local deadline = tonumber(redis.call('HGET', KEYS[2], 'deadline'))
+local ttl = redis.call('PTTL', KEYS[1])
+if deadline and ttl > 0 then
+	return 0
+end
+if not deadline then
+	deadline = now + absolute
+	redis.call('HSET', KEYS[2], 'user_id', userID, 'created_at', now, 'deadline', deadline)
+	redis.call('SADD', indexKey, KEYS[1])
+	if redis.call('PTTL', indexKey) < absolute then
+		redis.call('PEXPIRE', indexKey, absolute)
+	end
+end
+if ttl < 0 then
+	ttl = math.min(tonumber(ARGV[2]), deadline - now)
+end
+if ttl <= 0 then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	redis.call('SREM', indexKey, KEYS[1])
+	return 1
+end
+redis.call('PEXPIRE', KEYS[1], ttl)
+redis.call('PEXPIRE', KEYS[2], ttl)
+return 1`)
+
+const (
+	// сессии - это ключи-UUID со значением ID пользователя
+	sessionKeyPattern = "????????-????-????-????-????????????"
+	backfillBatch     = 1000
+	// ответы PTTL: ключа нет или у него нет срока
+	sessionKeyMissing = -2
+	sessionNoTTL      = -1
+
+	emailResendKeyFormat  = "email_resend:%s:%d:%s"
+	emailHourKeyFormat    = "email_hour:%s:%d"
+	userSessionsKeyFormat = "user_sessions:%d"
+	sessionInfoKeyFormat  = "session_info:%s"
+)
+
 type UserStorage struct {
 	pool      *pgxpool.Pool
 	redisPool *redis.Pool
@@ -146,7 +236,7 @@ func (active *UserStorage) CreateUser(ctx context.Context, email,
 	errs := utils.Validate(email, password)
 
 	if password != passwordRepeat {
-		errs = append(errs, "Passwords do not match")
+		errs = append(errs, utils.ErrPasswordMismatch)
 	}
 
 	if errs != nil {
@@ -189,24 +279,52 @@ func (active *UserStorage) CreateUser(ctx context.Context, email,
 	return &user, nil
 }
 
+func (active *UserStorage) emailTaken(ctx context.Context, tx pgx.Tx, id uint, email string) (bool, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLEmailTaken := `SELECT EXISTS(SELECT 1 FROM public."user" WHERE email = $1 AND id <> $2);`
+
+	logging.LogInfo(logger, "SELECT FROM user")
+
+	start := time.Now()
+
+	userLine := tx.QueryRow(ctx, SQLEmailTaken, email, id)
+
+	active.metrics.AddDuration(funcName, time.Since(start))
+
+	var taken bool
+
+	if err := userLine.Scan(&taken); err != nil {
+		logging.LogError(logger, fmt.Errorf("error while scanning email taken, err=%w", err))
+		active.metrics.IncreaseErrors(funcName)
+
+		return false, err
+	}
+
+	return taken, nil
+}
+
 func (active *UserStorage) editUserEmail(ctx context.Context, tx pgx.Tx, id uint, email string) (*models.User, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	SQLUserExists := `UPDATE public."user"	SET email=$1 WHERE id=$2 RETURNING id, email;`
+	SQLEditUserEmail := `UPDATE public."user" SET pending_email = NULLIF($1, email) WHERE id = $2
+		RETURNING id, email, email_confirmed, COALESCE(pending_email, '');`
 
 	logging.LogInfo(logger, "UPDATE user")
 
 	start := time.Now()
 
-	userLine := tx.QueryRow(ctx, SQLUserExists, email, id)
+	userLine := tx.QueryRow(ctx, SQLEditUserEmail, email, id)
 
 	active.metrics.AddDuration(funcName, time.Since(start))
 
 	user := models.User{}
 
-	if err := userLine.Scan(&user.ID, &user.Email); err != nil {
+	if err := userLine.Scan(&user.ID, &user.Email, &user.EmailConfirmed, &user.PendingEmail); err != nil {
 		logging.LogError(logger, fmt.Errorf("error while scanning edit user email, err=%w", err))
+		active.metrics.IncreaseErrors(funcName)
 
 		return nil, err
 	}
@@ -225,12 +343,25 @@ func (active *UserStorage) EditUserEmail(ctx context.Context, id uint, email str
 	var user *models.User
 
 	err = pgx.BeginFunc(ctx, active.pool, func(tx pgx.Tx) error {
+		taken, err := active.emailTaken(ctx, tx, id, email)
+		if err != nil {
+			return err
+		}
+
+		if taken {
+			return userusecases.ErrEmailTaken
+		}
+
 		userInner, err := active.editUserEmail(ctx, tx, id, email)
 		user = userInner
 
 		return err
 	})
 
+	if errors.Is(err, userusecases.ErrEmailTaken) {
+		return nil, err
+	}
+
 	if err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while editing user profile , err=%w",
 			errUserNotExists))
@@ -241,11 +372,147 @@ func (active *UserStorage) EditUserEmail(ctx context.Context, id uint, email str
 	return user, nil
 }
 
+func (active *UserStorage) confirmEmail(ctx context.Context, tx pgx.Tx, id uint, email string) (*models.User, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLConfirmEmail := `UPDATE public."user"
+		SET email = $2,
+		    email_confirmed = TRUE,
+		    pending_email = CASE WHEN pending_email = $2 THEN NULL ELSE pending_email END
+		WHERE id = $1 AND (pending_email = $2 OR (email = $2 AND NOT email_confirmed))
+		RETURNING id, email, email_confirmed, COALESCE(pending_email, '');`
+
+	logging.LogInfo(logger, "UPDATE user")
+
+	start := time.Now()
+
+	userLine := tx.QueryRow(ctx, SQLConfirmEmail, id, email)
+
+	active.metrics.AddDuration(funcName, time.Since(start))
+
+	user := models.User{}
+
+	if err := userLine.Scan(&user.ID, &user.Email, &user.EmailConfirmed, &user.PendingEmail); err != nil {
+		logging.LogError(logger, fmt.Errorf("error while scanning confirm email, err=%w", err))
+		active.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (active *UserStorage) ConfirmEmail(ctx context.Context, id uint, email string) (*models.User, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var user *models.User
+
+	err := pgx.BeginFunc(ctx, active.pool, func(tx pgx.Tx) error {
+		userInner, err := active.confirmEmail(ctx, tx, id, email)
+		user = userInner
+
+		return err
+	})
+
+	var pgErr *pgconn.PgError
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, userusecases.ErrConfirmationOutdated
+	case errors.As(err, &pgErr) && pgErr.Code == "23505":
+		// пока письмо шло, адрес успел занять другой пользователь
+		return nil, userusecases.ErrEmailTaken
+	case err != nil:
+		logging.LogError(logger, fmt.Errorf("something went wrong while confirming email, err=%w", err))
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (active *UserStorage) AllowEmail(ctx context.Context, purpose string, id uint, email string,
+	interval time.Duration, perHour int) (bool, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := active.redisPool.Get()
+	defer conn.Close()
+
+	reply, err := conn.Do("SET", fmt.Sprintf(emailResendKeyFormat, purpose, id, email), 1, "PX",
+		interval.Milliseconds(), "NX")
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting email resend key, err=%w", err))
+
+		return false, err
+	}
+
+	if reply == nil {
+		return false, nil
+	}
+
+	count, err := repository.IncrWindow(conn, fmt.Sprintf(emailHourKeyFormat, purpose, id), time.Hour)
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while counting %s emails, err=%w", purpose, err))
+
+		return false, err
+	}
+
+	return count <= perHour, nil
+}
+
+func (active *UserStorage) setPassword(ctx context.Context, tx pgx.Tx, id uint, oldHash, newHash string) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetPassword := `UPDATE public."user" SET password_hash = $1 WHERE id = $2 AND password_hash = $3;`
+
+	logging.LogInfo(logger, "UPDATE user")
+
+	start := time.Now()
+
+	tag, err := tx.Exec(ctx, SQLSetPassword, newHash, id, oldHash)
+
+	active.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while executing set password query, err=%w",
+			err))
+		active.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	// пароль успели сменить, пока мы проверяли ссылку или старый пароль
+	if tag.RowsAffected() == 0 {
+		return userusecases.ErrPasswordChanged
+	}
+
+	return nil
+}
+
+func (active *UserStorage) SetPassword(ctx context.Context, id uint, oldHash, newHash string) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	err := pgx.BeginFunc(ctx, active.pool, func(tx pgx.Tx) error {
+		return active.setPassword(ctx, tx, id, oldHash, newHash)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting password, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
 func (active *UserStorage) getUserByEmail(ctx context.Context, tx pgx.Tx, email string) (*models.User, error) {
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	SQLUserByEmail := `SELECT id, email, password_hash	FROM public."user" where email = $1 `
+	SQLUserByEmail := `SELECT id, email, password_hash, email_confirmed, COALESCE(pending_email, '')
+		FROM public."user" where email = $1 `
 
 	logging.LogInfo(logger, "SELECT FROM user")
 
@@ -257,7 +524,8 @@ func (active *UserStorage) getUserByEmail(ctx context.Context, tx pgx.Tx, email
 
 	user := models.User{}
 
-	if err := userLine.Scan(&user.ID, &user.Email, &user.PasswordHash); err != nil {
+	if err := userLine.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.EmailConfirmed,
+		&user.PendingEmail); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while getting user by email from seq, err=%w",
 			err))
 
@@ -294,7 +562,8 @@ func (active *UserStorage) getUserByID(ctx context.Context, tx pgx.Tx, id uint)
 	funcName := logging.GetOnlyFunctionName()
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
-	SQLUserByID := `SELECT id, email, password_hash	FROM public."user" where id = $1 `
+	SQLUserByID := `SELECT id, email, password_hash, email_confirmed, COALESCE(pending_email, '')
+		FROM public."user" where id = $1 `
 
 	logging.LogInfo(logger, "SELECT FROM user")
 
@@ -306,7 +575,8 @@ func (active *UserStorage) getUserByID(ctx context.Context, tx pgx.Tx, id uint)
 
 	user := models.User{}
 
-	if err := userLine.Scan(&user.ID, &user.Email, &user.PasswordHash); err != nil {
+	if err := userLine.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.EmailConfirmed,
+		&user.PendingEmail); err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while getting user by id from seq, err=%w",
 			err))
 
@@ -331,7 +601,11 @@ func (active *UserStorage) GetUserByID(ctx context.Context, userID uint) (*model
 	if err != nil {
 		logging.LogError(logger, fmt.Errorf("something went wrong while getting user by id from seq, err=%w", err))
 
-		return nil, errUserNotExists
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, userusecases.ErrUserNotFound
+		}
+
+		return nil, err
 	}
 
 	return user, nil
@@ -388,15 +662,30 @@ func (active *UserStorage) SessionExists(sessionID string) bool {
 	return exists
 }
 
-func (active *UserStorage) AddSession(ctx context.Context, id uint) string {
+func (active *UserStorage) AddSession(ctx context.Context, id uint, client models.SessionClient, idleTTL,
+	absoluteTTL time.Duration) string {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	sessionID := uuid.NewString()
+	infoKey := fmt.Sprintf(sessionInfoKeyFormat, sessionID)
+	indexKey := fmt.Sprintf(userSessionsKeyFormat, id)
+
+	now := time.Now()
+	ttl := min(idleTTL, absoluteTTL).Milliseconds()
 
 	conn := active.redisPool.Get()
 	defer conn.Close()
 
-	_, err := conn.Do("SET", sessionID, id)
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", sessionID, id, "PX", ttl)
+	_ = conn.Send("HSET", infoKey, "user_id", id, "ip", client.IP, "user_agent", client.UserAgent,
+		"created_at", now.UnixMilli(), "last_seen", now.UnixMilli(), "deadline", now.Add(absoluteTTL).UnixMilli())
+	_ = conn.Send("PEXPIRE", infoKey, ttl)
+	_ = conn.Send("SADD", indexKey, sessionID)
+	// новая сессия доживёт до конца позже всех остальных, поэтому индекс истекает вместе с ней
+	_ = conn.Send("PEXPIRE", indexKey, absoluteTTL.Milliseconds())
+
+	_, err := conn.Do("EXEC")
 	if err != nil {
 		logging.LogError(logger,
 			fmt.Errorf("something went wrong while setting user session, err=%w", err))
@@ -407,20 +696,149 @@ func (active *UserStorage) AddSession(ctx context.Context, id uint) string {
 	return sessionID
 }
 
+func (active *UserStorage) TouchSession(ctx context.Context, sessionID string, client models.SessionClient,
+	idleTTL, absoluteTTL time.Duration) (uint, time.Time, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := active.redisPool.Get()
+	defer conn.Close()
+
+	values, err := redis.Values(touchScript.Do(conn, sessionID, fmt.Sprintf(sessionInfoKeyFormat, sessionID),
+		time.Now().UnixMilli(), idleTTL.Milliseconds(), absoluteTTL.Milliseconds(), client.IP, client.UserAgent))
+	if errors.Is(err, redis.ErrNil) {
+		return 0, time.Time{}, userusecases.ErrSessionNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while touching session, err=%w", err))
+
+		return 0, time.Time{}, err
+	}
+
+	var (
+		userID    uint64
+		expiresAt int64
+	)
+
+	if _, err := redis.Scan(values, &userID, &expiresAt); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning session, err=%w", err))
+
+		return 0, time.Time{}, err
+	}
+
+	return uint(userID), time.UnixMilli(expiresAt), nil
+}
+
+func (active *UserStorage) ListSessions(ctx context.Context, id uint) ([]*models.ActiveSession, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := active.redisPool.Get()
+	defer conn.Close()
+
+	indexKey := fmt.Sprintf(userSessionsKeyFormat, id)
+
+	sessionIDs, err := redis.Strings(conn.Do("SMEMBERS", indexKey))
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while listing user sessions, err=%w", err))
+
+		return nil, err
+	}
+
+	for _, sessionID := range sessionIDs {
+		_ = conn.Send("HGETALL", fmt.Sprintf(sessionInfoKeyFormat, sessionID))
+		_ = conn.Send("PTTL", sessionID)
+	}
+
+	if err := conn.Flush(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while reading user sessions, err=%w", err))
+
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions := make([]*models.ActiveSession, 0, len(sessionIDs))
+	expired := []any{indexKey}
+
+	for _, sessionID := range sessionIDs {
+		info, err := redis.StringMap(conn.Receive())
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while reading session info, err=%w", err))
+
+			return nil, err
+		}
+
+		ttl, err := redis.Int64(conn.Receive())
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while reading session ttl, err=%w", err))
+
+			return nil, err
+		}
+
+		// сессия истекла, а из индекса её никто не убрал
+		if ttl == sessionKeyMissing {
+			expired = append(expired, sessionID)
+
+			continue
+		}
+
+		expiresAt := now.Add(time.Duration(ttl) * time.Millisecond)
+		// старая сессия без срока, его поставит BackfillSessions или первое обращение
+		if ttl == sessionNoTTL {
+			expiresAt = millisToTime(info["deadline"])
+		}
+
+		sessions = append(sessions, &models.ActiveSession{
+			ID:        userusecases.SessionHandle(sessionID),
+			Device:    userusecases.DescribeDevice(info["user_agent"]),
+			IP:        info["ip"],
+			UserAgent: info["user_agent"],
+			CreatedAt: millisToTime(info["created_at"]),
+			LastSeen:  millisToTime(info["last_seen"]),
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	if len(expired) > 1 {
+		if _, err := conn.Do("SREM", expired...); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while cleaning session index, err=%w", err))
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+
+	return sessions, nil
+}
+
+func millisToTime(value string) time.Time {
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(millis)
+}
+
 func (active *UserStorage) RemoveSession(ctx context.Context, sessionID string) error {
 	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
 
 	conn := active.redisPool.Get()
 	defer conn.Close()
 
-	if !active.SessionExists(sessionID) {
+	userID, err := redis.Uint64(conn.Do("GET", sessionID))
+	if err != nil {
 		logging.LogError(logger,
 			fmt.Errorf("something went wrong while removing session, err=%w", errSessionNotExists))
 
 		return errSessionNotExists
 	}
 
-	_, err := conn.Do("DEL", sessionID)
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", sessionID, fmt.Sprintf(sessionInfoKeyFormat, sessionID))
+	_ = conn.Send("SREM", fmt.Sprintf(userSessionsKeyFormat, userID), sessionID)
+
+	_, err = conn.Do("EXEC")
 	if err != nil {
 		logging.LogError(logger,
 			fmt.Errorf("something went wrong while removing session, err=%w", errSessionNotExists))
@@ -430,3 +848,136 @@ func (active *UserStorage) RemoveSession(ctx context.Context, sessionID string)
 
 	return nil
 }
+
+// RemoveUserSession ищет сессию по отпечатку среди сессий пользователя, так что чужую сессию так не завершить.
+func (active *UserStorage) RemoveUserSession(ctx context.Context, id uint, handle string) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := active.redisPool.Get()
+	defer conn.Close()
+
+	indexKey := fmt.Sprintf(userSessionsKeyFormat, id)
+
+	sessionIDs, err := redis.Strings(conn.Do("SMEMBERS", indexKey))
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while listing user sessions, err=%w", err))
+
+		return err
+	}
+
+	for _, sessionID := range sessionIDs {
+		if userusecases.SessionHandle(sessionID) != handle {
+			continue
+		}
+
+		_ = conn.Send("MULTI")
+		_ = conn.Send("DEL", sessionID, fmt.Sprintf(sessionInfoKeyFormat, sessionID))
+		_ = conn.Send("SREM", indexKey, sessionID)
+
+		reply, err := redis.Values(conn.Do("EXEC"))
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while removing user session, err=%w", err))
+
+			return err
+		}
+
+		// сессия могла истечь, пока лежала в индексе
+		if removed, _ := redis.Int(reply[0], nil); removed == 0 {
+			return userusecases.ErrSessionNotFound
+		}
+
+		return nil
+	}
+
+	return userusecases.ErrSessionNotFound
+}
+
+// RemoveUserSessions завершает все сессии пользователя, кроме except. Сессии ищутся по индексу
+// user_sessions, который ведут AddSession и RemoveSession, а старые сессии в него вносит BackfillSessions.
+func (active *UserStorage) RemoveUserSessions(ctx context.Context, id uint, except string) error {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := active.redisPool.Get()
+	defer conn.Close()
+
+	indexKey := fmt.Sprintf(userSessionsKeyFormat, id)
+
+	sessionIDs, err := redis.Strings(conn.Do("SMEMBERS", indexKey))
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while listing user sessions, err=%w", err))
+
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+
+	for _, sessionID := range sessionIDs {
+		if sessionID == except {
+			continue
+		}
+
+		_ = conn.Send("DEL", sessionID, fmt.Sprintf(sessionInfoKeyFormat, sessionID))
+		_ = conn.Send("SREM", indexKey, sessionID)
+	}
+
+	_, err = conn.Do("EXEC")
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while removing user sessions, err=%w", err))
+
+		return err
+	}
+
+	return nil
+}
+
+// BackfillSessions обходит ключи сессий через SCAN, так что Redis не блокируется, а повторный запуск
+// пропускает уже описанные сессии.
+func (active *UserStorage) BackfillSessions(ctx context.Context, idleTTL, absoluteTTL time.Duration) (int,
+	error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	conn := active.redisPool.Get()
+	defer conn.Close()
+
+	now := time.Now().UnixMilli()
+	cursor := 0
+	backfilled := 0
+
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", sessionKeyPattern, "COUNT", backfillBatch,
+			"TYPE", "string"))
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning sessions, err=%w", err))
+
+			return backfilled, err
+		}
+
+		var sessionIDs []string
+
+		if _, err := redis.Scan(values, &cursor, &sessionIDs); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning sessions, err=%w", err))
+
+			return backfilled, err
+		}
+
+		for _, sessionID := range sessionIDs {
+			added, err := redis.Int(backfillScript.Do(conn, sessionID, fmt.Sprintf(sessionInfoKeyFormat, sessionID),
+				now, idleTTL.Milliseconds(), absoluteTTL.Milliseconds()))
+			if err != nil {
+				logging.LogError(logger, fmt.Errorf("something went wrong while backfilling session, err=%w", err))
+
+				return backfilled, err
+			}
+
+			backfilled += added
+		}
+
+		if cursor == 0 {
+			return backfilled, nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return backfilled, err
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const (
+	sessionHandleLen = 16
+	unknownDevice    = "Unknown device"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionHandle - отпечаток идентификатора сессии для списка сессий. Сам идентификатор наружу не отдаётся:
+// он лежит в куке и даёт доступ к аккаунту.
+func SessionHandle(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+
+	return hex.EncodeToString(sum[:])[:sessionHandleLen]
+}
+
+// порядок важен: User-Agent Edge и Opera содержит Chrome, а Chrome - Safari
+//
+//nolint:gochecknoglobals
+var (
+	knownBrowsers = []struct{ marker, name string }{
+		{"YaBrowser/", "Yandex Browser"},
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"CriOS/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+
+	knownSystems = []struct{ marker, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DescribeDevice по User-Agent даёт короткое название вида "Firefox on Windows".
+func DescribeDevice(userAgent string) string {
+	browser := ""
+
+	for _, known := range knownBrowsers {
+		if strings.Contains(userAgent, known.marker) {
+			browser = known.name
+
+			break
+		}
+	}
+
+	system := ""
+
+	for _, known := range knownSystems {
+		if strings.Contains(userAgent, known.marker) {
+			system = known.name
+
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	default:
+		return unknownDevice
+	}
+}
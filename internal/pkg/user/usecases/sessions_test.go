@@ -0,0 +1,57 @@
+//nolint:all
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
+)
+
+func TestDescribeDevice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		userAgent string
+		want      string
+	}{
+		{userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) " +
+			"Chrome/124.0.0.0 Safari/537.36", want: "Chrome on Windows"},
+		{userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) " +
+			"Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.51", want: "Edge on Windows"},
+		{userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_4) AppleWebKit/605.1.15 (KHTML, like Gecko) " +
+			"Version/17.4 Safari/605.1.15", want: "Safari on macOS"},
+		{userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 " +
+			"(KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1", want: "Safari on iOS"},
+		{userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) " +
+			"Chrome/124.0.0.0 Mobile Safari/537.36", want: "Chrome on Android"},
+		{userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+			want: "Firefox on Linux"},
+		{userAgent: "curl/8.5.0", want: "Unknown device"},
+		{userAgent: "", want: "Unknown device"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			if got := usecases.DescribeDevice(tt.userAgent); got != tt.want {
+				t.Errorf("DescribeDevice(%q) = %q, want %q", tt.userAgent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionHandle(t *testing.T) {
+	t.Parallel()
+
+	handle := usecases.SessionHandle("8a6e0804-2bd0-4672-b79d-d97027f9071a")
+
+	if len(handle) != 16 || handle != usecases.SessionHandle("8a6e0804-2bd0-4672-b79d-d97027f9071a") {
+		t.Errorf("SessionHandle() = %q, want stable 16 hex chars", handle)
+	}
+
+	if handle == usecases.SessionHandle("8a6e0804-2bd0-4672-b79d-d97027f9071b") {
+		t.Error("SessionHandle() is equal for different sessions")
+	}
+}
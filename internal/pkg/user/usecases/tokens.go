@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// PurposeEmailConfirm - ссылка из письма подтверждения адреса
+	PurposeEmailConfirm = "email_confirm"
+	// PurposePasswordReset - ссылка сброса пароля, привязана к текущему хешу пароля
+	PurposePasswordReset = "password_reset"
+
+	randomSecretLen = 32
+	tokenParts      = 3
+	fingerprintLen  = 12
+)
+
+var (
+	ErrTokenInvalid = errors.New("token is invalid")
+	ErrTokenExpired = errors.New("token is expired")
+)
+
+// SignedToken - содержимое проверенного токена. Subject - то, к чему токен привязан, например адрес почты.
+type SignedToken struct {
+	UserID    uint
+	Subject   string
+	ExpiresAt time.Time
+}
+
+// TokenSigner выдаёт токены для ссылок из писем: данные идут открытым текстом, а подпись HMAC не даёт их
+// подделать. Назначение входит в подпись, поэтому токен одного назначения не подходит для другого.
+type TokenSigner struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewTokenSigner с пустым секретом берёт случайный: токены работают до перезапуска сервиса.
+func NewTokenSigner(secret string) *TokenSigner {
+	key := []byte(secret)
+
+	if len(key) == 0 {
+		key = make([]byte, randomSecretLen)
+		_, _ = rand.Read(key)
+	}
+
+	return &TokenSigner{
+		secret: key,
+		now:    time.Now,
+	}
+}
+
+func (signer *TokenSigner) Sign(purpose string, userID uint, subject string, ttl time.Duration) string {
+	expiresAt := signer.now().Add(ttl).Unix()
+	payload := strconv.FormatUint(uint64(userID), 10) + "|" + strconv.FormatInt(expiresAt, 10) + "|" + subject
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(signer.mac(purpose, payload))
+}
+
+func (signer *TokenSigner) Verify(purpose, token string) (*SignedToken, error) {
+	encodedPayload, encodedMAC, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrTokenInvalid
+	}
+
+	rawPayload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	payload := string(rawPayload)
+
+	if !hmac.Equal(mac, signer.mac(purpose, payload)) {
+		return nil, ErrTokenInvalid
+	}
+
+	parts := strings.SplitN(payload, "|", tokenParts)
+	if len(parts) != tokenParts {
+		return nil, ErrTokenInvalid
+	}
+
+	userID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+
+	signed := &SignedToken{
+		UserID:    uint(userID),
+		Subject:   parts[2],
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+
+	if !signer.now().Before(signed.ExpiresAt) {
+		return nil, ErrTokenExpired
+	}
+
+	return signed, nil
+}
+
+// PasswordFingerprint - отпечаток хеша пароля для токена сброса: после смены пароля он меняется,
+// и уже использованная или устаревшая ссылка перестаёт подходить.
+func PasswordFingerprint(passwordHash string) string {
+	sum := sha256.Sum256([]byte(passwordHash))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:fingerprintLen])
+}
+
+func (signer *TokenSigner) mac(purpose, payload string) []byte {
+	hash := hmac.New(sha256.New, signer.secret)
+	hash.Write([]byte(purpose + "\n" + payload))
+
+	return hash.Sum(nil)
+}
@@ -0,0 +1,76 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/usecases"
+)
+
+func TestTokenSigner(t *testing.T) {
+	t.Parallel()
+
+	signer := usecases.NewTokenSigner("secret")
+	token := signer.Sign(usecases.PurposeEmailConfirm, 42, "user|name@example.com", time.Hour)
+
+	signed, err := signer.Verify(usecases.PurposeEmailConfirm, token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	if signed.UserID != 42 || signed.Subject != "user|name@example.com" {
+		t.Errorf("Verify() = %+v", signed)
+	}
+
+	payload, mac, _ := strings.Cut(token, ".")
+	forged := usecases.NewTokenSigner("secret").Sign(usecases.PurposeEmailConfirm, 43, "user|name@example.com",
+		time.Hour)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name    string
+		signer  *usecases.TokenSigner
+		purpose string
+		token   string
+		wantErr error
+	}{
+		{name: "other secret", signer: usecases.NewTokenSigner("other"), purpose: usecases.PurposeEmailConfirm,
+			token: token, wantErr: usecases.ErrTokenInvalid},
+		{name: "other purpose", signer: signer, purpose: "password_reset", token: token,
+			wantErr: usecases.ErrTokenInvalid},
+		{name: "payload swapped", signer: signer, purpose: usecases.PurposeEmailConfirm,
+			token: forgedPayload + "." + mac, wantErr: usecases.ErrTokenInvalid},
+		{name: "no signature", signer: signer, purpose: usecases.PurposeEmailConfirm, token: payload,
+			wantErr: usecases.ErrTokenInvalid},
+		{name: "garbage", signer: signer, purpose: usecases.PurposeEmailConfirm, token: "!!.??",
+			wantErr: usecases.ErrTokenInvalid},
+		{name: "expired", signer: signer, purpose: usecases.PurposeEmailConfirm,
+			token:   signer.Sign(usecases.PurposeEmailConfirm, 42, "name@example.com", -time.Minute),
+			wantErr: usecases.ErrTokenExpired},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := tt.signer.Verify(tt.purpose, tt.token); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenSignerRandomSecret(t *testing.T) {
+	t.Parallel()
+
+	token := usecases.NewTokenSigner("").Sign(usecases.PurposeEmailConfirm, 1, "name@example.com", time.Hour)
+
+	if _, err := usecases.NewTokenSigner("").Verify(usecases.PurposeEmailConfirm, token); !errors.Is(err,
+		usecases.ErrTokenInvalid) {
+		t.Errorf("Verify() with another random secret error = %v, want ErrTokenInvalid", err)
+	}
+}
@@ -2,10 +2,24 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
 )
 
+var (
+	ErrEmailTaken            = errors.New("email is already in use")
+	ErrEmailAlreadyConfirmed = errors.New("email is already confirmed")
+	ErrConfirmationOutdated  = errors.New("email confirmation is outdated")
+	ErrTooManyRequests       = errors.New("too many requests")
+	ErrWrongPassword         = errors.New("wrong current password")
+	ErrPasswordInvalid       = errors.New("password does not meet requirements")
+	ErrResetTokenInvalid     = errors.New("password reset token is invalid, expired or already used")
+	ErrPasswordChanged       = errors.New("password was changed by another request")
+	ErrUserNotFound          = errors.New("user not found")
+)
+
 //go:generate mockgen -source=user.go -destination=../mocks/user_mocks.go
 
 type UsersStorageInterface interface {
@@ -13,11 +27,40 @@ type UsersStorageInterface interface {
 	CreateUser(ctx context.Context, email, password, passwordRepeat string) (*models.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	GetUserBySession(ctx context.Context, sessionID string) (*models.User, error)
+	// GetUserByID возвращает ErrUserNotFound, если такого пользователя нет.
+	GetUserByID(ctx context.Context, userID uint) (*models.User, error)
 	GetLastID(ctx context.Context) uint
 
+	// EditUserEmail не меняет адрес для входа, а откладывает новый в pending_email до подтверждения;
+	// адрес, совпадающий с текущим, отменяет ожидающую смену.
 	EditUserEmail(ctx context.Context, id uint, email string) (*models.User, error)
+	// ConfirmEmail подтверждает email, если он всё ещё ожидает подтверждения: либо это ожидающий новый адрес,
+	// либо неподтверждённый текущий. Иначе возвращает ErrConfirmationOutdated.
+	ConfirmEmail(ctx context.Context, id uint, email string) (*models.User, error)
+	// AllowEmail учитывает отправку письма назначения purpose: одно на адрес за interval и не больше
+	// perHour в час.
+	AllowEmail(ctx context.Context, purpose string, id uint, email string, interval time.Duration,
+		perHour int) (bool, error)
+	// SetPassword меняет хеш пароля, только если он всё ещё равен oldHash, иначе возвращает ErrPasswordChanged:
+	// из двух одновременных смен по одной ссылке пройдёт одна.
+	SetPassword(ctx context.Context, id uint, oldHash, newHash string) error
 
 	SessionExists(sessionID string) bool
-	AddSession(ctx context.Context, id uint) string
+	// AddSession открывает сессию на idleTTL, но не дольше absoluteTTL, и добавляет её в список сессий
+	// пользователя вместе с устройством и адресом client.
+	AddSession(ctx context.Context, id uint, client models.SessionClient, idleTTL, absoluteTTL time.Duration) string
+	// TouchSession продлевает сессию ещё на idleTTL, но не дальше absoluteTTL от входа, и возвращает владельца
+	// и новый срок. Для истёкшей и завершённой сессии возвращает ErrSessionNotFound.
+	TouchSession(ctx context.Context, sessionID string, client models.SessionClient, idleTTL,
+		absoluteTTL time.Duration) (uint, time.Time, error)
+	// ListSessions возвращает активные сессии пользователя; ID в них - отпечатки из SessionHandle.
+	ListSessions(ctx context.Context, id uint) ([]*models.ActiveSession, error)
+	// RemoveUserSession завершает сессию пользователя по отпечатку из SessionHandle.
+	RemoveUserSession(ctx context.Context, id uint, handle string) error
 	RemoveSession(ctx context.Context, sessionID string) error
+	// RemoveUserSessions завершает все сессии пользователя, кроме except (пустая строка - все).
+	RemoveUserSessions(ctx context.Context, id uint, except string) error
+	// BackfillSessions даёт сессиям, открытым до появления сроков, описание, срок жизни и место в индексе
+	// user_sessions, чтобы их можно было завершить; возвращает число таких сессий.
+	BackfillSessions(ctx context.Context, idleTTL, absoluteTTL time.Duration) (int, error)
 }
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+const (
+	orientationNormal      = 1
+	orientationTag         = 0x0112
+	jpegMarkerAPP1         = 0xe1
+	jpegMarkerSOS          = 0xda
+	tiffHeaderLen          = 8
+	ifdEntryLen            = 12
+	exifOrientationsNumber = 8
+)
+
+// ReadOrientation достаёт тег Orientation из EXIF блока JPEG файла. Для остальных форматов
+// и файлов без EXIF возвращается нормальная ориентация.
+func ReadOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xff || data[1] != 0xd8 {
+		return orientationNormal
+	}
+
+	for offset := 2; offset+4 <= len(data); {
+		if data[offset] != 0xff {
+			return orientationNormal
+		}
+
+		marker := data[offset+1]
+		if marker == jpegMarkerSOS {
+			return orientationNormal
+		}
+
+		segmentLen := int(binary.BigEndian.Uint16(data[offset+2:]))
+		segmentEnd := offset + 2 + segmentLen
+
+		if segmentLen < 2 || segmentEnd > len(data) {
+			return orientationNormal
+		}
+
+		segment := data[offset+4 : segmentEnd]
+		if marker == jpegMarkerAPP1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		offset = segmentEnd
+	}
+
+	return orientationNormal
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < tiffHeaderLen {
+		return orientationNormal
+	}
+
+	var order binary.ByteOrder
+
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+
+	ifdOffset := int(order.Uint32(tiff[4:]))
+	if ifdOffset < tiffHeaderLen || ifdOffset+2 > len(tiff) {
+		return orientationNormal
+	}
+
+	entriesNumber := int(order.Uint16(tiff[ifdOffset:]))
+
+	for i := 0; i < entriesNumber; i++ {
+		entry := ifdOffset + 2 + i*ifdEntryLen
+		if entry+ifdEntryLen > len(tiff) {
+			return orientationNormal
+		}
+
+		if order.Uint16(tiff[entry:]) != orientationTag {
+			continue
+		}
+
+		orientation := int(order.Uint16(tiff[entry+8:]))
+		if orientation < orientationNormal || orientation > exifOrientationsNumber {
+			return orientationNormal
+		}
+
+		return orientation
+	}
+
+	return orientationNormal
+}
+
+// ApplyOrientation поворачивает и отражает изображение так, чтобы оно выглядело как задумано камерой.
+func ApplyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= orientationNormal || orientation > exifOrientationsNumber {
+		return img
+	}
+
+	bounds := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Rect, img, bounds.Min, draw.Src)
+
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	dstWidth, dstHeight := width, height
+
+	// ориентации 5-8 меняют местами ширину и высоту
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for dy := 0; dy < dstHeight; dy++ {
+		for dx := 0; dx < dstWidth; dx++ {
+			var sx, sy int
+
+			switch orientation {
+			case 2:
+				sx, sy = width-1-dx, dy
+			case 3:
+				sx, sy = width-1-dx, height-1-dy
+			case 4:
+				sx, sy = dx, height-1-dy
+			case 5:
+				sx, sy = dy, dx
+			case 6:
+				sx, sy = dy, height-1-dx
+			case 7:
+				sx, sy = width-1-dy, height-1-dx
+			case 8:
+				sx, sy = width-1-dy, dx
+			}
+
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(sx, sy):])
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,255 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // регистрируем декодер PNG для image.Decode
+	"io"
+	"log"
+	"mime/multipart"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	"go.uber.org/zap"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // регистрируем декодер WebP для image.Decode
+)
+
+const (
+	RenditionThumbnail = "thumbnail"
+	RenditionCard      = "card"
+	RenditionFull      = "full"
+
+	defaultImageQuality = 85
+)
+
+var errUnknownRendition = errors.New("unknown image rendition")
+
+//nolint:gochecknoglobals
+var defaultRenditions = []config.ImageRendition{
+	{Name: RenditionThumbnail, Width: 160, Height: 160, Crop: true},
+	{Name: RenditionCard, Width: endX, Height: endY, Crop: true},
+	{Name: RenditionFull, Width: 1280, Height: 1280},
+}
+
+// ImagePipeline нарезает загруженную картинку на набор размеров: поворачивает по EXIF,
+// сохраняет пропорции (вписывает или обрезает по центру) и перекодирует, отбрасывая метаданные.
+type ImagePipeline struct {
+	store      blobstore.BlobStore
+	renditions []config.ImageRendition
+	webp       bool
+	quality    int
+}
+
+func NewImagePipeline(cfg config.ImagesConfig, store blobstore.BlobStore) *ImagePipeline {
+	pipeline := &ImagePipeline{
+		store:      store,
+		renditions: cfg.Renditions,
+		webp:       cfg.WebP,
+		quality:    cfg.Quality,
+	}
+
+	if len(pipeline.renditions) == 0 {
+		pipeline.renditions = defaultRenditions
+	}
+
+	if pipeline.quality <= 0 || pipeline.quality > 100 {
+		pipeline.quality = defaultImageQuality
+	}
+
+	if cfg.WebP && cfg.Quality > 0 {
+		log.Println("images.quality applies only to the full rendition, webp renditions are lossless")
+	}
+
+	return pipeline
+}
+
+// Process сохраняет указанные размеры изображения (по умолчанию все) и возвращает
+// ключи в хранилище по имени размера.
+func (p *ImagePipeline) Process(ctx context.Context, file *multipart.FileHeader, folderName string,
+	renditionNames ...string) (map[string]string, error) {
+	renditions, err := p.selectRenditions(renditionNames)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadedFile, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer uploadedFile.Close()
+
+	data, err := io.ReadAll(uploadedFile)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	img = ApplyOrientation(img, ReadOrientation(data))
+
+	keys := make(map[string]string, len(renditions))
+	currentTime := time.Now()
+
+	for _, rendition := range renditions {
+		key := fmt.Sprintf("%s/%s/%d-%02d-%02d/%s%s", folderName, rendition.Name,
+			currentTime.Year(), currentTime.Month(), currentTime.Day(), RandString(filenameLen), p.extension(rendition))
+
+		err := p.writeRendition(ctx, img, rendition, key)
+		if err != nil {
+			p.Remove(ctx, keys)
+
+			return nil, err
+		}
+
+		keys[rendition.Name] = key
+	}
+
+	return keys, nil
+}
+
+func (p *ImagePipeline) selectRenditions(names []string) ([]config.ImageRendition, error) {
+	if len(names) == 0 {
+		return p.renditions, nil
+	}
+
+	selected := make([]config.ImageRendition, 0, len(names))
+
+	for _, name := range names {
+		found := false
+
+		for _, rendition := range p.renditions {
+			if rendition.Name == name {
+				selected = append(selected, rendition)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errUnknownRendition, name)
+		}
+	}
+
+	return selected, nil
+}
+
+// useWebP - наш WebP только без потерь, поэтому большую фотографию он раздул бы сильнее JPEG:
+// full всегда остаётся JPEG с настроенным качеством.
+func (p *ImagePipeline) useWebP(rendition config.ImageRendition) bool {
+	return p.webp && rendition.Name != RenditionFull
+}
+
+func (p *ImagePipeline) extension(rendition config.ImageRendition) string {
+	if p.useWebP(rendition) {
+		return ".webp"
+	}
+
+	return ".jpg"
+}
+
+func (p *ImagePipeline) contentType(rendition config.ImageRendition) string {
+	if p.useWebP(rendition) {
+		return "image/webp"
+	}
+
+	return "image/jpeg"
+}
+
+func (p *ImagePipeline) writeRendition(ctx context.Context, img image.Image, rendition config.ImageRendition,
+	key string) error {
+	var (
+		buf bytes.Buffer
+		err error
+	)
+
+	webp := p.useWebP(rendition)
+	resized := ResizeImage(img, rendition.Width, rendition.Height, rendition.Crop, !webp)
+
+	if webp {
+		err = EncodeWebP(&buf, resized)
+	} else {
+		err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: p.quality})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return p.store.Put(ctx, key, &buf, int64(buf.Len()), p.contentType(rendition))
+}
+
+// ResizeImage приводит изображение к размеру width x height без искажения пропорций. В режиме crop
+// картинка заполняет весь кадр, а лишнее обрезается по центру, иначе она вписывается в кадр
+// и никогда не увеличивается. Для форматов без прозрачности фон заливается белым.
+func ResizeImage(img image.Image, width, height int, crop, opaque bool) image.Image {
+	bounds := img.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	srcRect := bounds
+
+	if crop {
+		// сравниваем srcWidth/srcHeight и width/height без деления
+		if srcWidth*height > width*srcHeight {
+			cropWidth := srcHeight * width / height
+			srcRect.Min.X += (srcWidth - cropWidth) / 2
+			srcRect.Max.X = srcRect.Min.X + cropWidth
+		} else {
+			cropHeight := srcWidth * height / width
+			srcRect.Min.Y += (srcHeight - cropHeight) / 2
+			srcRect.Max.Y = srcRect.Min.Y + cropHeight
+		}
+	} else {
+		width, height = fitSize(srcWidth, srcHeight, width, height)
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	op := draw.Src
+
+	if opaque {
+		draw.Draw(dst, dst.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+		op = draw.Over
+	}
+
+	draw.CatmullRom.Scale(dst, dst.Rect, img, srcRect, op, nil)
+
+	return dst
+}
+
+func fitSize(srcWidth, srcHeight, maxWidth, maxHeight int) (int, int) {
+	if srcWidth <= maxWidth && srcHeight <= maxHeight {
+		return srcWidth, srcHeight
+	}
+
+	if srcWidth*maxHeight > maxWidth*srcHeight {
+		return maxWidth, max(1, srcHeight*maxWidth/srcWidth)
+	}
+
+	return max(1, srcWidth*maxHeight/srcHeight), maxHeight
+}
+
+// Remove удаляет из хранилища все файлы набора размеров, не останавливаясь на ошибках;
+// неудалённые файлы попадают в лог, чтобы их можно было найти.
+func (p *ImagePipeline) Remove(ctx context.Context, keys map[string]string) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+
+		if err := p.store.Delete(ctx, key); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while deleting image %s, err=%w", key, err))
+		}
+	}
+}
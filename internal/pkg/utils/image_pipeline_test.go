@@ -0,0 +1,240 @@
+//nolint:all
+package utils_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	utils "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebPRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewSource(1))
+
+	solid := image.NewNRGBA(image.Rect(0, 0, 7, 5))
+	for i := range solid.Pix {
+		solid.Pix[i] = 42
+	}
+
+	twoColors := image.NewNRGBA(image.Rect(0, 0, 3, 3))
+	for i := range twoColors.Pix {
+		twoColors.Pix[i] = uint8((i / 4 % 2) * 200)
+	}
+
+	noise := image.NewNRGBA(image.Rect(0, 0, 300, 211))
+	for i := range noise.Pix {
+		noise.Pix[i] = uint8(rnd.Intn(256))
+	}
+
+	skewed := image.NewNRGBA(image.Rect(0, 0, 640, 480))
+	for y := 0; y < 480; y++ {
+		for x := 0; x < 640; x++ {
+			v := uint8(rnd.ExpFloat64() * 3)
+			skewed.SetNRGBA(x, y, color.NRGBA{R: v, G: uint8(x), B: v * 2, A: 255})
+		}
+	}
+
+	tests := []struct {
+		name string
+		img  image.Image
+	}{
+		{name: "Solid color", img: solid},
+		{name: "Two colors", img: twoColors},
+		{name: "Noise with alpha", img: noise},
+		{name: "Skewed histogram", img: skewed},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			if err := utils.EncodeWebP(&buf, tt.img); err != nil {
+				t.Fatalf("EncodeWebP() error = %v", err)
+			}
+
+			decoded, err := webp.Decode(&buf)
+			if err != nil {
+				t.Fatalf("webp.Decode() error = %v", err)
+			}
+
+			bounds := tt.img.Bounds()
+			if decoded.Bounds() != bounds {
+				t.Fatalf("bounds = %v, want %v", decoded.Bounds(), bounds)
+			}
+
+			for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+				for x := bounds.Min.X; x < bounds.Max.X; x++ {
+					want := color.NRGBAModel.Convert(tt.img.At(x, y)).(color.NRGBA)
+					got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+
+					if want.A == 0 && got.A == 0 {
+						continue
+					}
+
+					if want != got {
+						t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func jpegWithOrientation(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x01")
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	data := []byte{0xff, 0xd8, 0xff, 0xe1}
+	data = binary.BigEndian.AppendUint16(data, uint16(len(segment)+2))
+	data = append(data, segment...)
+
+	return append(data, 0xff, 0xda, 0x00, 0x02)
+}
+
+func TestReadOrientation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "Rotated JPEG", data: jpegWithOrientation(6), want: 6},
+		{name: "Mirrored JPEG", data: jpegWithOrientation(2), want: 2},
+		{name: "Invalid orientation", data: jpegWithOrientation(42), want: 1},
+		{name: "JPEG without EXIF", data: []byte{0xff, 0xd8, 0xff, 0xda, 0x00, 0x02}, want: 1},
+		{name: "Not a JPEG", data: []byte("\x89PNG\r\n\x1a\n"), want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := utils.ReadOrientation(tt.data); got != tt.want {
+				t.Errorf("ReadOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	t.Parallel()
+
+	// 2x1: красный пиксель слева, синий справа
+	red := color.NRGBA{R: 255, A: 255}
+	blue := color.NRGBA{B: 255, A: 255}
+
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.SetNRGBA(0, 0, red)
+	src.SetNRGBA(1, 0, blue)
+
+	tests := []struct {
+		name        string
+		orientation int
+		bounds      image.Rectangle
+		first       color.NRGBA
+	}{
+		{name: "Normal", orientation: 1, bounds: image.Rect(0, 0, 2, 1), first: red},
+		{name: "Mirrored", orientation: 2, bounds: image.Rect(0, 0, 2, 1), first: blue},
+		{name: "Rotated 90 CW", orientation: 6, bounds: image.Rect(0, 0, 1, 2), first: red},
+		{name: "Rotated 90 CCW", orientation: 8, bounds: image.Rect(0, 0, 1, 2), first: blue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := utils.ApplyOrientation(src, tt.orientation)
+
+			if got.Bounds() != tt.bounds {
+				t.Fatalf("bounds = %v, want %v", got.Bounds(), tt.bounds)
+			}
+
+			if first := color.NRGBAModel.Convert(got.At(0, 0)); first != tt.first {
+				t.Errorf("top-left pixel = %v, want %v", first, tt.first)
+			}
+		})
+	}
+}
+
+func TestResizeImage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		crop   bool
+		want   image.Rectangle
+	}{
+		{name: "Fit keeps aspect ratio", width: 100, height: 100, want: image.Rect(0, 0, 100, 50)},
+		{name: "Fit never upscales", width: 1000, height: 1000, want: image.Rect(0, 0, 400, 200)},
+		{name: "Crop fills the frame", width: 215, height: 295, crop: true, want: image.Rect(0, 0, 215, 295)},
+	}
+
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := utils.ResizeImage(src, tt.width, tt.height, tt.crop, true)
+			if got.Bounds() != tt.want {
+				t.Errorf("bounds = %v, want %v", got.Bounds(), tt.want)
+			}
+		})
+	}
+}
+
+func TestImagePipelineKeepsFullInJPEG(t *testing.T) {
+	t.Parallel()
+
+	request := multipartRequest(t, "photos", []uploadedFile{{name: "a.jpg", data: encodeImage(t, 300, "jpeg")}})
+	if err := request.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatal(err)
+	}
+
+	store := blobstore.NewLocalStore(config.LocalBlobStoreConfig{Root: t.TempDir()})
+	pipeline := utils.NewImagePipeline(config.ImagesConfig{WebP: true}, store)
+
+	keys, err := pipeline.Process(context.Background(), request.MultipartForm.File["photos"][0], "advert_images")
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	want := map[string]string{utils.RenditionThumbnail: ".webp", utils.RenditionCard: ".webp",
+		utils.RenditionFull: ".jpg"}
+
+	for name, ext := range want {
+		if !strings.HasSuffix(keys[name], ext) {
+			t.Errorf("%s key = %q, want %s", name, keys[name], ext)
+		}
+	}
+}
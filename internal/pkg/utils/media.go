@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+)
+
+const (
+	MediaURLPrefix = "/media/"
+	// документы продавцов на проверке видят только модераторы, через /media/ они не отдаются
+	VerificationDocumentsFolder = "verification_documents"
+)
+
+// IsPrivateMediaKey сообщает, что файл нельзя отдавать по публичной ссылке.
+func IsPrivateMediaKey(key string) bool {
+	return strings.HasPrefix(key, VerificationDocumentsFolder+"/")
+}
+
+// MediaURL превращает ключ загруженного файла в адрес, по которому его отдаёт /media/.
+func MediaURL(key string) string {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "://") {
+		return key
+	}
+
+	key, err := blobstore.NormalizeKey(key)
+	if err != nil {
+		return ""
+	}
+
+	return MediaURLPrefix + key
+}
+
+// InlineImagesRequested сообщает, просил ли клиент встраивать картинки в ответ в виде base64.
+func InlineImagesRequested(ctx context.Context) bool {
+	inline, _ := ctx.Value(config.InlineImagesContextKey).(bool)
+
+	return inline
+}
+
+// MediaImage возвращает адрес картинки и, только для клиентов со старым протоколом, её содержимое в base64.
+func MediaImage(ctx context.Context, store blobstore.BlobStore, key string) (string, string) {
+	if key == "" || !InlineImagesRequested(ctx) {
+		return MediaURL(key), ""
+	}
+
+	encoded, _ := InlineImage(ctx, store, key)
+
+	return MediaURL(key), encoded
+}
+
+func MediaImages(ctx context.Context, store blobstore.BlobStore, keys []string) ([]string, []string) {
+	urls := make([]string, 0, len(keys))
+
+	var encoded []string
+
+	inline := InlineImagesRequested(ctx)
+
+	for _, key := range keys {
+		urls = append(urls, MediaURL(key))
+
+		if inline {
+			image, _ := InlineImage(ctx, store, key)
+			encoded = append(encoded, image)
+		}
+	}
+
+	return urls, encoded
+}
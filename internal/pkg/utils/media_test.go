@@ -0,0 +1,57 @@
+//nolint:all
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	utils "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+func TestMediaURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "Uploaded file", path: "./uploads/avatars/full/2024-05-01/abcdefgh.jpg",
+			want: "/media/avatars/full/2024-05-01/abcdefgh.jpg"},
+		{name: "Empty path", path: "", want: ""},
+		{name: "Storage key", path: "avatars/full/2024-05-01/abcdefgh.jpg",
+			want: "/media/avatars/full/2024-05-01/abcdefgh.jpg"},
+		{name: "Already a URL", path: "/media/avatars/a.jpg", want: "/media/avatars/a.jpg"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := utils.MediaURL(tt.path); got != tt.want {
+				t.Errorf("MediaURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMediaImagesWithoutInline(t *testing.T) {
+	t.Parallel()
+
+	urls, inline := utils.MediaImages(context.Background(), nil, []string{"./uploads/a/b.jpg"})
+	if len(urls) != 1 || urls[0] != "/media/a/b.jpg" {
+		t.Errorf("urls = %v", urls)
+	}
+
+	if inline != nil {
+		t.Errorf("inline = %v, want nil", inline)
+	}
+
+	ctx := context.WithValue(context.Background(), config.InlineImagesContextKey, true)
+	if !utils.InlineImagesRequested(ctx) {
+		t.Error("InlineImagesRequested() = false, want true")
+	}
+}
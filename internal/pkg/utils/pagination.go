@@ -0,0 +1,15 @@
+package utils
+
+// PageLimit приводит запрошенный размер страницы к допустимому: непереданный или отрицательный
+// заменяется на defaultLimit, слишком большой урезается до maxLimit.
+func PageLimit(limit int, defaultLimit, maxLimit uint) uint {
+	if limit <= 0 {
+		return defaultLimit
+	}
+
+	if uint(limit) > maxLimit {
+		return maxLimit
+	}
+
+	return uint(limit)
+}
@@ -0,0 +1,35 @@
+//nolint:all
+package utils_test
+
+import (
+	"testing"
+
+	utils "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+func TestPageLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		limit int
+		want  uint
+	}{
+		{name: "default", limit: 0, want: 30},
+		{name: "negative", limit: -5, want: 30},
+		{name: "in range", limit: 10, want: 10},
+		{name: "max", limit: 100, want: 100},
+		{name: "too large", limit: 1000, want: 100},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := utils.PageLimit(tt.limit, 30, 100); got != tt.want {
+				t.Errorf("PageLimit(%d) = %d, want %d", tt.limit, got, tt.want)
+			}
+		})
+	}
+}
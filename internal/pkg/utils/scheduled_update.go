@@ -39,6 +39,25 @@ func scheduledUpdate(ctx context.Context, tx pgx.Tx, metrics *mymetrics.Database
 		return err
 	}
 
+	// истёкшая бронь и так никому не мешает, строки удаляются только чтобы не копились
+	SQLDeleteExpiredReservations := `DELETE FROM public.advert_reservation WHERE reserved_until <= now();`
+
+	logging.LogInfo(logger, "DELETE FROM advert_reservation")
+
+	start = time.Now()
+
+	_, err = tx.Exec(ctx, SQLDeleteExpiredReservations)
+
+	metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while deleting expired reservations, err=%w",
+			err))
+		metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,253 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+)
+
+const (
+	UploadTooManyFiles    = "too_many_files"
+	UploadFileTooLarge    = "file_too_large"
+	UploadRequestTooLarge = "request_too_large"
+	UploadEmptyFile       = "empty_file"
+	UploadUnsupportedType = "unsupported_type"
+	UploadTooManyPixels   = "too_many_pixels"
+	UploadCorruptedImage  = "corrupted_image"
+
+	defaultMaxFileSize    = 10 << 20
+	defaultMaxRequestSize = 50 << 20
+	defaultMaxFiles       = 10
+	defaultMaxPixels      = 40_000_000
+	// сколько байт держать в памяти при разборе multipart, остальное уходит во временные файлы
+	maxUploadMemory = 8 << 20
+	sniffLen        = 512
+)
+
+//nolint:gochecknoglobals
+var (
+	defaultAllowedTypes  = []string{"image/jpeg", "image/png", "image/webp"}
+	defaultDocumentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+	// какой формат image.DecodeConfig соответствует MIME-типу, определённому по содержимому
+	decoderFormats = map[string]string{
+		"image/jpeg": "jpeg",
+		"image/png":  "png",
+		"image/webp": "webp",
+	}
+)
+
+// UploadValidationError собирает все нарушения в загруженных файлах, чтобы вернуть их клиенту разом.
+type UploadValidationError struct {
+	Errors []*models.UploadError
+}
+
+func (e *UploadValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+
+	for _, uploadErr := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s[%s]: %s", uploadErr.Field, uploadErr.File, uploadErr.Message))
+	}
+
+	return "upload validation failed: " + strings.Join(messages, "; ")
+}
+
+func (e *UploadValidationError) add(field, file, code, message string) {
+	e.Errors = append(e.Errors, &models.UploadError{Field: field, File: file, Code: code, Message: message})
+}
+
+// UploadValidator проверяет загрузки до того, как они попадут в ImagePipeline: расширению и заявленному
+// Content-Type не доверяет, тип определяет по первым байтам, а размер картинки - по заголовку без полного
+// декодирования, чтобы маленький файл не развернулся в гигабайты пикселей.
+type UploadValidator struct {
+	maxFileSize    int64
+	maxRequestSize int64
+	maxFiles       int
+	maxPixels      int64
+	allowedTypes   map[string]bool
+	documentTypes  map[string]bool
+}
+
+func typesSet(types, defaults []string) map[string]bool {
+	if len(types) == 0 {
+		types = defaults
+	}
+
+	set := make(map[string]bool, len(types))
+
+	for _, allowedType := range types {
+		set[allowedType] = true
+	}
+
+	return set
+}
+
+func NewUploadValidator(cfg config.UploadsConfig) *UploadValidator {
+	validator := &UploadValidator{
+		maxFileSize:    cfg.MaxFileSize,
+		maxRequestSize: cfg.MaxRequestSize,
+		maxFiles:       cfg.MaxFiles,
+		maxPixels:      cfg.MaxPixels,
+		allowedTypes:   typesSet(cfg.AllowedTypes, defaultAllowedTypes),
+		documentTypes:  typesSet(cfg.DocumentTypes, defaultDocumentTypes),
+	}
+
+	if validator.maxFileSize <= 0 {
+		validator.maxFileSize = defaultMaxFileSize
+	}
+
+	if validator.maxRequestSize <= 0 {
+		validator.maxRequestSize = defaultMaxRequestSize
+	}
+
+	if validator.maxFiles <= 0 {
+		validator.maxFiles = defaultMaxFiles
+	}
+
+	if validator.maxPixels <= 0 {
+		validator.maxPixels = defaultMaxPixels
+	}
+
+	return validator
+}
+
+// ParseMultipartForm разбирает тело запроса, не читая больше maxRequestSize байт.
+func (v *UploadValidator) ParseMultipartForm(writer http.ResponseWriter, request *http.Request) error {
+	request.Body = http.MaxBytesReader(writer, request.Body, v.maxRequestSize)
+
+	err := request.ParseMultipartForm(min(maxUploadMemory, v.maxRequestSize))
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		validationErr := &UploadValidationError{}
+		validationErr.add("", "", UploadRequestTooLarge,
+			fmt.Sprintf("request body exceeds %d bytes", v.maxRequestSize))
+
+		return validationErr
+	}
+
+	return err
+}
+
+// ValidateFiles проверяет все файлы поля формы и возвращает *UploadValidationError со списком нарушений.
+func (v *UploadValidator) ValidateFiles(field string, files []*multipart.FileHeader) error {
+	return v.validateFiles(field, files, v.allowedTypes)
+}
+
+// ValidateDocuments проверяет сканы документов: кроме картинок допускает PDF, для которого размер в пикселях
+// не проверяется.
+func (v *UploadValidator) ValidateDocuments(field string, files []*multipart.FileHeader) error {
+	return v.validateFiles(field, files, v.documentTypes)
+}
+
+func (v *UploadValidator) validateFiles(field string, files []*multipart.FileHeader,
+	allowedTypes map[string]bool) error {
+	validationErr := &UploadValidationError{}
+
+	if len(files) > v.maxFiles {
+		validationErr.add(field, "", UploadTooManyFiles,
+			fmt.Sprintf("got %d files, at most %d allowed", len(files), v.maxFiles))
+	}
+
+	for _, file := range files {
+		v.validateFile(validationErr, field, file, allowedTypes)
+	}
+
+	if len(validationErr.Errors) != 0 {
+		return validationErr
+	}
+
+	return nil
+}
+
+func (v *UploadValidator) validateFile(validationErr *UploadValidationError, field string,
+	file *multipart.FileHeader, allowedTypes map[string]bool) {
+	switch {
+	case file.Size == 0:
+		validationErr.add(field, file.Filename, UploadEmptyFile, "file is empty")
+
+		return
+	case file.Size > v.maxFileSize:
+		validationErr.add(field, file.Filename, UploadFileTooLarge,
+			fmt.Sprintf("file size %d exceeds %d bytes", file.Size, v.maxFileSize))
+
+		return
+	}
+
+	uploadedFile, err := file.Open()
+	if err != nil {
+		validationErr.add(field, file.Filename, UploadCorruptedImage, "file can not be read")
+
+		return
+	}
+	defer uploadedFile.Close()
+
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(uploadedFile, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		validationErr.add(field, file.Filename, UploadCorruptedImage, "file can not be read")
+
+		return
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	if !allowedTypes[contentType] {
+		validationErr.add(field, file.Filename, UploadUnsupportedType,
+			fmt.Sprintf("content type %s is not allowed", contentType))
+
+		return
+	}
+
+	format, isImage := decoderFormats[contentType]
+	if !isImage {
+		return
+	}
+
+	if _, err := uploadedFile.Seek(0, io.SeekStart); err != nil {
+		validationErr.add(field, file.Filename, UploadCorruptedImage, "file can not be read")
+
+		return
+	}
+
+	imageConfig, decodedFormat, err := image.DecodeConfig(uploadedFile)
+	if err != nil || decodedFormat != format || imageConfig.Width <= 0 || imageConfig.Height <= 0 {
+		validationErr.add(field, file.Filename, UploadCorruptedImage, "image header is malformed")
+
+		return
+	}
+
+	if int64(imageConfig.Width)*int64(imageConfig.Height) > v.maxPixels {
+		validationErr.add(field, file.Filename, UploadTooManyPixels,
+			fmt.Sprintf("image %dx%d exceeds %d pixels", imageConfig.Width, imageConfig.Height, v.maxPixels))
+	}
+}
+
+// UploadErrResponse превращает ошибку разбора или проверки загрузки в ответ клиенту.
+func UploadErrResponse(err error) *models.ErrResponse {
+	var validationErr *UploadValidationError
+	if !errors.As(err, &validationErr) {
+		return responses.NewErrResponse(responses.StatusBadRequest, responses.ErrBadRequest)
+	}
+
+	code := responses.StatusBadRequest
+
+	for _, uploadErr := range validationErr.Errors {
+		if uploadErr.Code == UploadRequestTooLarge {
+			code = responses.StatusRequestEntityTooLarge
+		}
+	}
+
+	response := responses.NewErrResponse(code, responses.ErrUploadNotValid)
+	response.Errors = validationErr.Errors
+
+	return response
+}
@@ -0,0 +1,230 @@
+//nolint:all
+package utils_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/config"
+	utils "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+type uploadedFile struct {
+	name string
+	data []byte
+}
+
+func encodeImage(t *testing.T, size int, format string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+
+	var err error
+	if format == "png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, nil)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func multipartRequest(t *testing.T, field string, files []uploadedFile) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	for _, file := range files {
+		part, err := writer.CreateFormFile(field, file.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		part.Write(file.data)
+	}
+
+	writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/adverts/create", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return request
+}
+
+func TestUploadValidator(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.UploadsConfig{MaxFileSize: 4096, MaxRequestSize: 16384, MaxFiles: 2, MaxPixels: 400}
+
+	tests := []struct {
+		name      string
+		files     []uploadedFile
+		wantCodes []string
+	}{
+		{
+			name:  "Valid JPEG and PNG",
+			files: []uploadedFile{{"a.jpg", encodeImage(t, 10, "jpeg")}, {"b.png", encodeImage(t, 20, "png")}},
+		},
+		{
+			name:      "Text disguised as JPEG",
+			files:     []uploadedFile{{"evil.jpg", []byte("<?php echo 'hi'; ?>")}},
+			wantCodes: []string{utils.UploadUnsupportedType},
+		},
+		{
+			name:      "Too many pixels",
+			files:     []uploadedFile{{"big.png", encodeImage(t, 21, "png")}},
+			wantCodes: []string{utils.UploadTooManyPixels},
+		},
+		{
+			name:      "Truncated PNG",
+			files:     []uploadedFile{{"broken.png", encodeImage(t, 10, "png")[:20]}},
+			wantCodes: []string{utils.UploadCorruptedImage},
+		},
+		{
+			name:      "Empty file",
+			files:     []uploadedFile{{"empty.png", nil}},
+			wantCodes: []string{utils.UploadEmptyFile},
+		},
+		{
+			name:      "File too large",
+			files:     []uploadedFile{{"huge.png", append(encodeImage(t, 10, "png"), make([]byte, 4096)...)}},
+			wantCodes: []string{utils.UploadFileTooLarge},
+		},
+		{
+			name: "Too many files",
+			files: []uploadedFile{{"1.png", encodeImage(t, 1, "png")}, {"2.png", encodeImage(t, 1, "png")},
+				{"3.png", encodeImage(t, 1, "png")}},
+			wantCodes: []string{utils.UploadTooManyFiles},
+		},
+		{
+			name: "Request too large",
+			files: []uploadedFile{{"a.png", make([]byte, 4000)}, {"b.png", make([]byte, 4000)},
+				{"c.png", make([]byte, 4000)}, {"d.png", make([]byte, 4000)}, {"e.png", make([]byte, 4000)}},
+			wantCodes: []string{utils.UploadRequestTooLarge},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := utils.NewUploadValidator(cfg)
+			request := multipartRequest(t, "photos", tt.files)
+
+			err := validator.ParseMultipartForm(httptest.NewRecorder(), request)
+			if err == nil {
+				err = validator.ValidateFiles("photos", request.MultipartForm.File["photos"])
+			}
+
+			var validationErr *utils.UploadValidationError
+
+			if len(tt.wantCodes) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error = %v", err)
+				}
+
+				return
+			}
+
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("error = %v, want *UploadValidationError", err)
+			}
+
+			codes := make([]string, 0, len(validationErr.Errors))
+			for _, uploadErr := range validationErr.Errors {
+				codes = append(codes, uploadErr.Code)
+			}
+
+			if strings.Join(codes, ",") != strings.Join(tt.wantCodes, ",") {
+				t.Errorf("codes = %v, want %v", codes, tt.wantCodes)
+			}
+
+			if tt.wantCodes[0] != utils.UploadRequestTooLarge && tt.wantCodes[0] != utils.UploadTooManyFiles &&
+				validationErr.Errors[0].File != tt.files[0].name {
+				t.Errorf("file = %q, want %q", validationErr.Errors[0].File, tt.files[0].name)
+			}
+		})
+	}
+}
+
+func TestUploadValidatorDocuments(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.UploadsConfig{MaxFileSize: 4096, MaxRequestSize: 16384, MaxFiles: 2, MaxPixels: 400}
+	pdf := []byte("%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\n%%EOF")
+
+	tests := []struct {
+		name      string
+		files     []uploadedFile
+		wantCodes []string
+	}{
+		{
+			name:  "PDF and PNG scans",
+			files: []uploadedFile{{"passport.pdf", pdf}, {"inn.png", encodeImage(t, 10, "png")}},
+		},
+		{
+			name:      "Text disguised as PDF",
+			files:     []uploadedFile{{"evil.pdf", []byte("<?php echo 'hi'; ?>")}},
+			wantCodes: []string{utils.UploadUnsupportedType},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := utils.NewUploadValidator(cfg)
+			request := multipartRequest(t, "documents", tt.files)
+
+			err := validator.ParseMultipartForm(httptest.NewRecorder(), request)
+			if err == nil {
+				err = validator.ValidateDocuments("documents", request.MultipartForm.File["documents"])
+			}
+
+			if len(tt.wantCodes) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error = %v", err)
+				}
+
+				return
+			}
+
+			var validationErr *utils.UploadValidationError
+			if !errors.As(err, &validationErr) || validationErr.Errors[0].Code != tt.wantCodes[0] {
+				t.Fatalf("error = %v, want codes %v", err, tt.wantCodes)
+			}
+		})
+	}
+
+	// в поле для фотографий PDF по-прежнему не принимается
+	validator := utils.NewUploadValidator(cfg)
+	request := multipartRequest(t, "photos", []uploadedFile{{"doc.pdf", pdf}})
+
+	if err := validator.ParseMultipartForm(httptest.NewRecorder(), request); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validator.ValidateFiles("photos", request.MultipartForm.File["photos"]); err == nil {
+		t.Error("ValidateFiles() accepted PDF")
+	}
+}
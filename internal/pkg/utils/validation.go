@@ -10,6 +10,7 @@ const (
 	ErrTooLong          = "Password is too long"
 	ErrWrongFormat      = "Password does not contain required symbols"
 	ErrWrongEmailFormat = "Wrong email format"
+	ErrPasswordMismatch = "Passwords do not match"
 )
 
 const (
@@ -67,6 +68,17 @@ func Validate(email, password string) []string {
 	return errors
 }
 
+// ValidatePassword проверяет новый пароль и его повтор по тем же правилам, что и при регистрации.
+func ValidatePassword(password, passwordRepeat string) []string {
+	errors := validatePassword(password)
+
+	if password != passwordRepeat {
+		errors = append(errors, ErrPasswordMismatch)
+	}
+
+	return errors
+}
+
 func ValidateEmail(email string) error {
 	_, err := mail.ParseAddress(email)
 
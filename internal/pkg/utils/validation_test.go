@@ -109,3 +109,32 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePassword(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		password       string
+		passwordRepeat string
+		want           []string
+	}{
+		{name: "Valid password", password: "Password123", passwordRepeat: "Password123", want: []string{}},
+		{name: "Mismatch", password: "Password123", passwordRepeat: "Password124",
+			want: []string{"Passwords do not match"}},
+		{name: "Weak and mismatch", password: "pass", passwordRepeat: "",
+			want: []string{"Password is too short", "Password does not contain required symbols",
+				"Passwords do not match"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := utils.ValidatePassword(tt.password, tt.passwordRepeat); !compareSlices(got, tt.want) {
+				t.Errorf("ValidatePassword() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
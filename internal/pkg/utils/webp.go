@@ -0,0 +1,368 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"sort"
+)
+
+// Минимальный кодировщик WebP без потерь (VP8L): без преобразований, кэша цветов и обратных ссылок,
+// только префиксные коды по гистограммам каналов. Сжимает хуже libwebp, зато не требует cgo.
+
+const (
+	vp8lSignature       = 0x2f
+	vp8lMaxDimension    = 1 << 14
+	vp8lGreenAlphabet   = 256 + 24
+	vp8lColorAlphabet   = 256
+	vp8lDistAlphabet    = 40
+	vp8lMaxCodeLength   = 15
+	vp8lMaxLengthLength = 7
+	vp8lCodeLengthCodes = 19
+)
+
+var errWebPTooLarge = errors.New("image is too large for webp")
+
+//nolint:gochecknoglobals
+var vp8lCodeLengthOrder = [vp8lCodeLengthCodes]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+type bitWriter struct {
+	buf   bytes.Buffer
+	acc   uint64
+	nbits uint
+}
+
+func (w *bitWriter) write(value uint32, nbits uint) {
+	w.acc |= uint64(value) << w.nbits
+	w.nbits += nbits
+
+	for w.nbits >= 8 {
+		w.buf.WriteByte(byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nbits > 0 {
+		w.buf.WriteByte(byte(w.acc))
+		w.acc = 0
+		w.nbits = 0
+	}
+
+	return w.buf.Bytes()
+}
+
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32
+	symbols []int
+}
+
+type huffmanNode struct {
+	count  int
+	symbol int
+	left   *huffmanNode
+	right  *huffmanNode
+}
+
+func huffmanDepths(node *huffmanNode, depth uint8, lengths []uint8) {
+	if node.left == nil {
+		lengths[node.symbol] = depth
+
+		return
+	}
+
+	huffmanDepths(node.left, depth+1, lengths)
+	huffmanDepths(node.right, depth+1, lengths)
+}
+
+// buildCodeLengths строит длины кодов Хаффмана не длиннее maxLength. Если дерево получилось
+// слишком глубоким, редкие символы подтягиваются к минимальной частоте и дерево строится заново.
+func buildCodeLengths(histogram []int, maxLength uint8) []uint8 {
+	lengths := make([]uint8, len(histogram))
+
+	for countMin := 1; ; countMin *= 2 {
+		nodes := make([]*huffmanNode, 0, len(histogram))
+
+		for symbol, count := range histogram {
+			if count > 0 {
+				if count < countMin {
+					count = countMin
+				}
+
+				nodes = append(nodes, &huffmanNode{count: count, symbol: symbol})
+			}
+		}
+
+		if len(nodes) == 1 {
+			lengths[nodes[0].symbol] = 1
+
+			return lengths
+		}
+
+		for len(nodes) > 1 {
+			sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].count < nodes[j].count })
+			merged := &huffmanNode{count: nodes[0].count + nodes[1].count, left: nodes[0], right: nodes[1]}
+			nodes = append([]*huffmanNode{merged}, nodes[2:]...)
+		}
+
+		for i := range lengths {
+			lengths[i] = 0
+		}
+
+		huffmanDepths(nodes[0], 0, lengths)
+
+		tooDeep := false
+
+		for _, length := range lengths {
+			if length > maxLength {
+				tooDeep = true
+
+				break
+			}
+		}
+
+		if !tooDeep {
+			return lengths
+		}
+	}
+}
+
+func reverseBits(code uint32, length uint8) uint32 {
+	var reversed uint32
+
+	for i := uint8(0); i < length; i++ {
+		reversed = (reversed << 1) | (code & 1)
+		code >>= 1
+	}
+
+	return reversed
+}
+
+// canonicalCodes назначает канонические коды и разворачивает биты, так как VP8L читает поток с младших битов.
+func canonicalCodes(lengths []uint8) []uint32 {
+	var lengthCount [vp8lMaxCodeLength + 1]uint32
+
+	for _, length := range lengths {
+		if length > 0 {
+			lengthCount[length]++
+		}
+	}
+
+	var nextCode [vp8lMaxCodeLength + 2]uint32
+
+	code := uint32(0)
+
+	for length := 1; length <= vp8lMaxCodeLength; length++ {
+		code = (code + lengthCount[length-1]) << 1
+		nextCode[length] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+
+	for symbol, length := range lengths {
+		if length > 0 {
+			codes[symbol] = reverseBits(nextCode[length], length)
+			nextCode[length]++
+		}
+	}
+
+	return codes
+}
+
+func newPrefixCode(histogram []int) *prefixCode {
+	code := &prefixCode{}
+
+	for symbol, count := range histogram {
+		if count > 0 {
+			code.symbols = append(code.symbols, symbol)
+		}
+	}
+
+	if len(code.symbols) == 0 {
+		code.symbols = []int{0}
+	}
+
+	// один-два символа из первых 256 кодируются "простым" кодом
+	if len(code.symbols) <= 2 && code.symbols[len(code.symbols)-1] < 256 {
+		code.lengths = make([]uint8, len(histogram))
+		code.codes = make([]uint32, len(histogram))
+
+		if len(code.symbols) == 2 {
+			code.lengths[code.symbols[0]], code.lengths[code.symbols[1]] = 1, 1
+			code.codes[code.symbols[1]] = 1
+		}
+
+		return code
+	}
+
+	code.symbols = nil
+	code.lengths = buildCodeLengths(histogram, vp8lMaxCodeLength)
+	code.codes = canonicalCodes(code.lengths)
+
+	return code
+}
+
+func (code *prefixCode) writeSymbol(w *bitWriter, symbol int) {
+	if code.lengths[symbol] > 0 {
+		w.write(code.codes[symbol], uint(code.lengths[symbol]))
+	}
+}
+
+func (code *prefixCode) writeHeader(w *bitWriter) {
+	if code.symbols != nil {
+		w.write(1, 1)
+		w.write(uint32(len(code.symbols)-1), 1)
+
+		if code.symbols[0] > 1 {
+			w.write(1, 1)
+			w.write(uint32(code.symbols[0]), 8)
+		} else {
+			w.write(0, 1)
+			w.write(uint32(code.symbols[0]), 1)
+		}
+
+		if len(code.symbols) == 2 {
+			w.write(uint32(code.symbols[1]), 8)
+		}
+
+		return
+	}
+
+	w.write(0, 1)
+
+	lengthHistogram := make([]int, vp8lCodeLengthCodes)
+	for _, length := range code.lengths {
+		lengthHistogram[length]++
+	}
+
+	lengthLengths := buildCodeLengths(lengthHistogram, vp8lMaxLengthLength)
+	lengthCodes := canonicalCodes(lengthLengths)
+
+	// если используется одна длина, декодер воспринимает её код как нулевой длины
+	used := 0
+
+	for _, length := range lengthLengths {
+		if length > 0 {
+			used++
+		}
+	}
+
+	numCodes := vp8lCodeLengthCodes
+	for numCodes > 4 && lengthLengths[vp8lCodeLengthOrder[numCodes-1]] == 0 {
+		numCodes--
+	}
+
+	w.write(uint32(numCodes-4), 4)
+
+	for i := 0; i < numCodes; i++ {
+		w.write(uint32(lengthLengths[vp8lCodeLengthOrder[i]]), 3)
+	}
+
+	w.write(0, 1)
+
+	for _, length := range code.lengths {
+		if used > 1 {
+			w.write(lengthCodes[length], uint(lengthLengths[length]))
+		}
+	}
+}
+
+// EncodeWebP записывает изображение в формате WebP без потерь.
+func EncodeWebP(out io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width <= 0 || height <= 0 || width > vp8lMaxDimension || height > vp8lMaxDimension {
+		return errWebPTooLarge
+	}
+
+	pixels := make([][4]uint8, 0, width*height)
+	histograms := [5][]int{
+		make([]int, vp8lGreenAlphabet),
+		make([]int, vp8lColorAlphabet),
+		make([]int, vp8lColorAlphabet),
+		make([]int, vp8lColorAlphabet),
+		make([]int, vp8lDistAlphabet),
+	}
+	hasAlpha := false
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// VP8L хранит не предумноженные цвета
+			c, _ := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+
+			pixel := [4]uint8{c.G, c.R, c.B, c.A}
+			pixels = append(pixels, pixel)
+
+			for channel := 0; channel < 4; channel++ {
+				histograms[channel][pixel[channel]]++
+			}
+
+			if pixel[3] != 0xff {
+				hasAlpha = true
+			}
+		}
+	}
+
+	w := &bitWriter{}
+	w.write(vp8lSignature, 8)
+	w.write(uint32(width-1), 14)
+	w.write(uint32(height-1), 14)
+
+	if hasAlpha {
+		w.write(1, 1)
+	} else {
+		w.write(0, 1)
+	}
+
+	w.write(0, 3) // версия
+	w.write(0, 1) // без преобразований
+	w.write(0, 1) // без кэша цветов
+	w.write(0, 1) // одна группа префиксных кодов
+
+	codes := make([]*prefixCode, len(histograms))
+	for i, histogram := range histograms {
+		codes[i] = newPrefixCode(histogram)
+		codes[i].writeHeader(w)
+	}
+
+	for _, pixel := range pixels {
+		for channel := 0; channel < 4; channel++ {
+			codes[channel].writeSymbol(w, int(pixel[channel]))
+		}
+	}
+
+	return writeRIFF(out, w.bytes())
+}
+
+func writeRIFF(out io.Writer, payload []byte) error {
+	chunkSize := len(payload)
+	padding := chunkSize & 1
+
+	header := make([]byte, 0, 20)
+	header = append(header, "RIFF"...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(4+8+chunkSize+padding))
+	header = append(header, "WEBPVP8L"...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(chunkSize))
+
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+
+	if _, err := out.Write(payload); err != nil {
+		return err
+	}
+
+	if padding == 1 {
+		if _, err := out.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	authcheck "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/middleware/auth_check"
+	moderationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/moderation/usecases"
+	responses "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/server/delivery"
+	authproto "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/user/delivery/protobuf"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	verificationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/usecases"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	documentsField = "documents"
+	// документы с персональными данными не должны оседать в кэшах браузера и прокси
+	documentCacheControl = "private, no-store"
+)
+
+var errNotModerator = errors.New("user is not a moderator")
+
+type VerificationHandler struct {
+	storage           verificationusecases.VerificationStorageInterface
+	moderationStorage moderationusecases.ModerationStorageInterface
+	authClient        authproto.AuthClient
+	uploads           *utils.UploadValidator
+	blobs             blobstore.BlobStore
+}
+
+func NewVerificationHandler(storage verificationusecases.VerificationStorageInterface,
+	moderationStorage moderationusecases.ModerationStorageInterface, authClient authproto.AuthClient,
+	uploads *utils.UploadValidator, blobs blobstore.BlobStore) *VerificationHandler {
+	return &VerificationHandler{
+		storage:           storage,
+		moderationStorage: moderationStorage,
+		authClient:        authClient,
+		uploads:           uploads,
+		blobs:             blobs,
+	}
+}
+
+// SubmitRequest принимает заявку продавца: реквизиты полями формы и сканы документов в поле documents.
+func (h *VerificationHandler) SubmitRequest(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	err := h.uploads.ParseMultipartForm(writer, request)
+	if err == nil {
+		err = h.uploads.ValidateDocuments(documentsField, request.MultipartForm.File[documentsField])
+	}
+
+	if err != nil {
+		response := utils.UploadErrResponse(err)
+
+		logging.LogHandlerError(logger, err, response.Code)
+		log.Println(err, response.Code)
+		responses.SendErrResponse(request, writer, response)
+
+		return
+	}
+
+	documents := request.MultipartForm.File[documentsField]
+
+	data := &models.ReceivedVerificationRequest{
+		BusinessName:    request.PostFormValue("businessName"),
+		TaxID:           request.PostFormValue("taxId"),
+		BusinessAddress: request.PostFormValue("businessAddress"),
+	}
+
+	data.Sanitize()
+
+	if err := verificationusecases.ValidateRequest(data, len(documents)); err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+
+	verificationRequest, err := h.storage.SubmitRequest(ctx, userID, data, documents)
+	if err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Verification request %d submitted by %d", verificationRequest.ID,
+		userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(verificationusecases.WithDocumentURLs(
+		verificationRequest)))
+}
+
+// GetMyRequests отдаёт продавцу его заявки вместе с решениями модераторов, последние сверху.
+func (h *VerificationHandler) GetMyRequests(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	requests, err := h.storage.GetUserRequests(ctx, userID)
+	if err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+
+	for _, verificationRequest := range requests {
+		verificationusecases.WithDocumentURLs(verificationRequest)
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Get verification requests of user %d", userID), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(requests))
+}
+
+// GetPendingRequests отдаёт модератору заявки на рассмотрении в порядке подачи; следующую страницу просят
+// с after равным id последней заявки.
+func (h *VerificationHandler) GetPendingRequests(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if _, ok := h.moderatorID(writer, request, logger); !ok {
+		return
+	}
+
+	after, _ := strconv.Atoi(request.URL.Query().Get("after"))
+	limit, _ := strconv.Atoi(request.URL.Query().Get("limit"))
+
+	if after < 0 {
+		after = 0
+	}
+
+	requests, err := h.storage.GetPendingRequests(ctx, uint(after), verificationusecases.PendingPageLimit(limit))
+	if err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+
+	for _, verificationRequest := range requests {
+		verificationusecases.WithDocumentURLs(verificationRequest)
+	}
+
+	logging.LogHandlerInfo(logger, "success", responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(requests))
+}
+
+// ResolveRequest одобряет или отклоняет заявку; одобрение отмечает профиль продавца проверенным.
+func (h *VerificationHandler) ResolveRequest(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	if request.Method != http.MethodPost {
+		http.Error(writer, responses.ErrNotAllowed, responses.StatusNotAllowed)
+
+		return
+	}
+
+	moderatorID, ok := h.moderatorID(writer, request, logger)
+	if !ok {
+		return
+	}
+
+	var data models.ReceivedVerificationDecision
+
+	reqData, _ := io.ReadAll(request.Body)
+
+	if err := data.UnmarshalJSON(reqData); err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusBadRequest)
+		log.Println(err, responses.StatusBadRequest)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusBadRequest,
+			responses.ErrBadRequest))
+
+		return
+	}
+
+	if err := verificationusecases.ValidateDecision(&data); err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+
+	verificationRequest, err := h.storage.ResolveRequest(ctx, moderatorID, &data)
+	if err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Verification request %d resolved by %d, approved=%t",
+		data.RequestID, moderatorID, data.Approved), responses.StatusOk)
+	responses.SendOkResponse(writer, responses.NewOkResponse(verificationusecases.WithDocumentURLs(
+		verificationRequest)))
+}
+
+// GetDocument отдаёт скан из заявки её автору или модератору.
+func (h *VerificationHandler) GetDocument(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	vars := mux.Vars(request)
+	requestID, _ := strconv.Atoi(vars["id"])
+	index, _ := strconv.Atoi(vars["n"])
+
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	verificationRequest, err := h.storage.GetRequest(ctx, uint(requestID))
+	if err == nil && verificationRequest.UserID != userID && !h.moderationStorage.IsModerator(ctx, userID) {
+		// чужие заявки для продавца не существуют
+		err = verificationusecases.ErrRequestNotFound
+	}
+
+	var key string
+	if err == nil {
+		key, err = verificationusecases.DocumentKey(verificationRequest, index)
+	}
+
+	var blob *blobstore.Blob
+	if err == nil {
+		blob, err = h.blobs.Get(ctx, key)
+	}
+
+	if errors.Is(err, blobstore.ErrNotFound) {
+		err = fmt.Errorf("%w: %w", verificationusecases.ErrDocumentNotFound, err)
+	}
+
+	if err != nil {
+		sendVerificationError(writer, request, logger, err)
+
+		return
+	}
+	defer blob.Reader.Close()
+
+	writer.Header().Set("Content-Type", blob.ContentType)
+	writer.Header().Set("Content-Length", strconv.FormatInt(blob.Size, 10))
+	writer.Header().Set("Cache-Control", documentCacheControl)
+	writer.Header().Set("Content-Disposition", "inline")
+	writer.WriteHeader(responses.StatusOk)
+
+	if _, err := io.Copy(writer, blob.Reader); err != nil {
+		logging.LogHandlerError(logger, err, responses.StatusOk)
+		log.Println(err)
+
+		return
+	}
+
+	logging.LogHandlerInfo(logger, fmt.Sprintf("Document %d of verification request %d sent to %d", index,
+		requestID, userID), responses.StatusOk)
+}
+
+func (h *VerificationHandler) moderatorID(writer http.ResponseWriter, request *http.Request,
+	logger *zap.SugaredLogger) (uint, bool) {
+	userID := authcheck.CurrentUserID(request, h.authClient)
+
+	if !h.moderationStorage.IsModerator(request.Context(), userID) {
+		logging.LogHandlerError(logger, errNotModerator, responses.StatusForbidden)
+		log.Println(errNotModerator, responses.StatusForbidden)
+		responses.SendErrResponse(request, writer, responses.NewErrResponse(responses.StatusForbidden,
+			responses.ErrForbidden))
+
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func sendVerificationError(writer http.ResponseWriter, request *http.Request, logger *zap.SugaredLogger,
+	err error) {
+	code, message := responses.StatusInternalServerError, responses.ErrInternalServer
+
+	switch {
+	case errors.Is(err, verificationusecases.ErrRequestNotValid),
+		errors.Is(err, verificationusecases.ErrDecisionNotValid):
+		code, message = responses.StatusBadRequest, responses.ErrVerificationNotValid
+	case errors.Is(err, verificationusecases.ErrRequestPending):
+		code, message = responses.StatusConflict, responses.ErrVerificationPending
+	case errors.Is(err, verificationusecases.ErrRequestNotPending):
+		code, message = responses.StatusConflict, responses.ErrVerificationResolved
+	case errors.Is(err, verificationusecases.ErrAlreadyVerified):
+		code, message = responses.StatusConflict, responses.ErrAlreadyVerified
+	case errors.Is(err, verificationusecases.ErrRequestNotFound):
+		code, message = responses.StatusNotFound, responses.ErrVerificationNotExist
+	case errors.Is(err, verificationusecases.ErrDocumentNotFound):
+		code, message = responses.StatusNotFound, responses.ErrDocumentNotExist
+	}
+
+	logging.LogHandlerError(logger, err, code)
+	log.Println(err, code)
+	responses.SendErrResponse(request, writer, responses.NewErrResponse(code, message))
+}
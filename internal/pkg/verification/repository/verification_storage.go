@@ -0,0 +1,485 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/blobstore"
+	mymetrics "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/metrics"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+	logging "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils/log"
+	verificationusecases "github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/usecases"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	documentNameLen = 16
+	sniffLen        = 512
+
+	requestColumnsSQL = `vr.id, vr.user_id, vr.business_name, vr.tax_id, vr.business_address, vr.documents,
+	vr.status, vr.reason, vr.created_time, vr.decided_time`
+)
+
+//nolint:gochecknoglobals
+var documentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+}
+
+type VerificationStorage struct {
+	pool    *pgxpool.Pool
+	metrics *mymetrics.DatabaseMetrics
+	blobs   blobstore.BlobStore
+}
+
+func NewVerificationStorage(pool *pgxpool.Pool, metrics *mymetrics.DatabaseMetrics,
+	blobs blobstore.BlobStore) *VerificationStorage {
+	return &VerificationStorage{
+		pool:    pool,
+		metrics: metrics,
+		blobs:   blobs,
+	}
+}
+
+// saveDocument кладёт скан в закрытую часть хранилища; тип файла уже проверен UploadValidator.
+func (storage *VerificationStorage) saveDocument(ctx context.Context, file *multipart.FileHeader) (string, error) {
+	uploadedFile, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer uploadedFile.Close()
+
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(uploadedFile, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err := uploadedFile.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	currentTime := time.Now()
+	key := fmt.Sprintf("%s/%d-%02d-%02d/%s%s", utils.VerificationDocumentsFolder, currentTime.Year(),
+		currentTime.Month(), currentTime.Day(), utils.RandString(documentNameLen), documentExtensions[contentType])
+
+	if err := storage.blobs.Put(ctx, key, uploadedFile, file.Size, contentType); err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func (storage *VerificationStorage) removeDocuments(ctx context.Context, keys []string) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	for _, key := range keys {
+		if err := storage.blobs.Delete(ctx, key); err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while removing document %s, err=%w", key,
+				err))
+		}
+	}
+}
+
+func (storage *VerificationStorage) isVerified(ctx context.Context, tx pgx.Tx, userID uint) (bool, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLIsVerified := `SELECT COALESCE((SELECT verified FROM public.profile WHERE user_id = $1), FALSE);`
+
+	logging.LogInfo(logger, "SELECT FROM profile")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLIsVerified, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	var verified bool
+
+	if err := line.Scan(&verified); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning profile verified, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return false, err
+	}
+
+	return verified, nil
+}
+
+func (storage *VerificationStorage) insertRequest(ctx context.Context, tx pgx.Tx, userID uint,
+	data *models.ReceivedVerificationRequest, keys []string) (*models.VerificationRequest, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLInsertRequest := `
+	INSERT INTO public.verification_request AS vr (user_id, business_name, tax_id, business_address, documents)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING ` + requestColumnsSQL + `;`
+
+	logging.LogInfo(logger, "INSERT INTO verification_request")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLInsertRequest, userID, data.BusinessName, data.TaxID, data.BusinessAddress, keys)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	request, err := scanRequest(line)
+	if isUniqueViolation(err) {
+		return nil, verificationusecases.ErrRequestPending
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while inserting verification request, err=%w",
+			err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (storage *VerificationStorage) SubmitRequest(ctx context.Context, userID uint,
+	data *models.ReceivedVerificationRequest, files []*multipart.FileHeader) (*models.VerificationRequest, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	keys := make([]string, 0, len(files))
+
+	for _, file := range files {
+		key, err := storage.saveDocument(ctx, file)
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while saving verification document, err=%w",
+				err))
+			storage.removeDocuments(ctx, keys)
+
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	var request *models.VerificationRequest
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		verified, err := storage.isVerified(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		if verified {
+			return verificationusecases.ErrAlreadyVerified
+		}
+
+		requestInner, err := storage.insertRequest(ctx, tx, userID, data, keys)
+		request = requestInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while submitting verification request, err=%w",
+			err))
+		storage.removeDocuments(ctx, keys)
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (storage *VerificationStorage) getRequest(ctx context.Context, tx pgx.Tx, requestID uint,
+	forUpdate bool) (*models.VerificationRequest, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLGetRequest := `SELECT ` + requestColumnsSQL + ` FROM public.verification_request vr WHERE vr.id = $1`
+	if forUpdate {
+		SQLGetRequest += ` FOR UPDATE`
+	}
+
+	logging.LogInfo(logger, "SELECT FROM verification_request")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLGetRequest+`;`, requestID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	request, err := scanRequest(line)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, verificationusecases.ErrRequestNotFound
+	}
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning verification request, err=%w",
+			err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (storage *VerificationStorage) GetRequest(ctx context.Context,
+	requestID uint) (*models.VerificationRequest, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var request *models.VerificationRequest
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		requestInner, err := storage.getRequest(ctx, tx, requestID, false)
+		request = requestInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting verification request, err=%w", err))
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (storage *VerificationStorage) getRequests(ctx context.Context, tx pgx.Tx, SQLQuery string,
+	args ...any) ([]*models.VerificationRequest, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	logging.LogInfo(logger, "SELECT FROM verification_request")
+
+	start := time.Now()
+
+	rows, err := tx.Query(ctx, SQLQuery, args...)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while selecting verification requests, err=%w",
+			err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	requests := []*models.VerificationRequest{}
+
+	for rows.Next() {
+		request, err := scanRequest(rows)
+		if err != nil {
+			logging.LogError(logger, fmt.Errorf("something went wrong while scanning verification requests, err=%w",
+				err))
+			storage.metrics.IncreaseErrors(funcName)
+
+			return nil, err
+		}
+
+		requests = append(requests, request)
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while scanning verification requests, err=%w",
+			err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (storage *VerificationStorage) GetUserRequests(ctx context.Context,
+	userID uint) ([]*models.VerificationRequest, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLUserRequests := `SELECT ` + requestColumnsSQL + `
+	FROM public.verification_request vr
+	WHERE vr.user_id = $1
+	ORDER BY vr.id DESC;`
+
+	var requests []*models.VerificationRequest
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		requestsInner, err := storage.getRequests(ctx, tx, SQLUserRequests, userID)
+		requests = requestsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting user verification requests, err=%w",
+			err))
+
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (storage *VerificationStorage) GetPendingRequests(ctx context.Context, after,
+	limit uint) ([]*models.VerificationRequest, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLPendingRequests := `SELECT ` + requestColumnsSQL + `
+	FROM public.verification_request vr
+	WHERE vr.status = $1 AND vr.id > $2
+	ORDER BY vr.id
+	LIMIT $3;`
+
+	var requests []*models.VerificationRequest
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		requestsInner, err := storage.getRequests(ctx, tx, SQLPendingRequests, models.VerificationPending, after,
+			limit)
+		requests = requestsInner
+
+		return err
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while getting pending verification requests, "+
+			"err=%w", err))
+
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (storage *VerificationStorage) decideRequest(ctx context.Context, tx pgx.Tx, moderatorID uint,
+	data *models.ReceivedVerificationDecision) (*models.VerificationRequest, error) {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	status := models.VerificationRejected
+	if data.Approved {
+		status = models.VerificationApproved
+	}
+
+	SQLDecideRequest := `
+	UPDATE public.verification_request AS vr
+	SET status = $2, moderator_id = $3, reason = $4, decided_time = NOW()
+	WHERE vr.id = $1
+	RETURNING ` + requestColumnsSQL + `;`
+
+	logging.LogInfo(logger, "UPDATE verification_request")
+
+	start := time.Now()
+
+	line := tx.QueryRow(ctx, SQLDecideRequest, data.RequestID, status, moderatorID, data.Reason)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	request, err := scanRequest(line)
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while updating verification request, err=%w",
+			err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (storage *VerificationStorage) setVerified(ctx context.Context, tx pgx.Tx, userID uint) error {
+	funcName := logging.GetOnlyFunctionName()
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	SQLSetVerified := `UPDATE public.profile SET verified = TRUE WHERE user_id = $1;`
+
+	logging.LogInfo(logger, "UPDATE profile")
+
+	start := time.Now()
+
+	_, err := tx.Exec(ctx, SQLSetVerified, userID)
+
+	storage.metrics.AddDuration(funcName, time.Since(start))
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while setting profile verified, err=%w", err))
+		storage.metrics.IncreaseErrors(funcName)
+
+		return err
+	}
+
+	return nil
+}
+
+func (storage *VerificationStorage) ResolveRequest(ctx context.Context, moderatorID uint,
+	data *models.ReceivedVerificationDecision) (*models.VerificationRequest, error) {
+	logger := logging.GetLoggerFromContext(ctx).With(zap.String("func", logging.GetFunctionName()))
+
+	var request *models.VerificationRequest
+
+	err := pgx.BeginFunc(ctx, storage.pool, func(tx pgx.Tx) error {
+		pending, err := storage.getRequest(ctx, tx, data.RequestID, true)
+		if err != nil {
+			return err
+		}
+
+		if pending.Status != models.VerificationPending {
+			return verificationusecases.ErrRequestNotPending
+		}
+
+		requestInner, err := storage.decideRequest(ctx, tx, moderatorID, data)
+		if err != nil {
+			return err
+		}
+
+		request = requestInner
+
+		if !data.Approved {
+			return nil
+		}
+
+		return storage.setVerified(ctx, tx, request.UserID)
+	})
+
+	if err != nil {
+		logging.LogError(logger, fmt.Errorf("something went wrong while resolving verification request, err=%w",
+			err))
+
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func scanRequest(row pgx.Row) (*models.VerificationRequest, error) {
+	request := &models.VerificationRequest{}
+
+	if err := row.Scan(&request.ID, &request.UserID, &request.BusinessName, &request.TaxID,
+		&request.BusinessAddress, &request.DocumentKeys, &request.Status, &request.Reason, &request.CreatedTime,
+		&request.DecidedTime); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// isUniqueViolation отличает вторую заявку на рассмотрении от прочих ошибок базы.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/utils"
+)
+
+const (
+	DefaultPendingPage = 20
+	MaxPendingPage     = 100
+
+	maxBusinessNameLen    = 256
+	maxBusinessAddressLen = 512
+	maxReasonLen          = 1024
+
+	// документы отдаются через API, а не через /media/: их видят только заявитель и модераторы
+	documentURLFormat = "/api/verification/%d/documents/%d"
+)
+
+var (
+	ErrRequestNotValid   = errors.New("verification request is not valid")
+	ErrDecisionNotValid  = errors.New("verification decision is not valid")
+	ErrRequestNotFound   = errors.New("verification request not found")
+	ErrRequestPending    = errors.New("verification request is already pending")
+	ErrAlreadyVerified   = errors.New("seller is already verified")
+	ErrRequestNotPending = errors.New("verification request is already resolved")
+	ErrDocumentNotFound  = errors.New("verification document not found")
+	ErrNoDocuments       = errors.New("verification request has no documents")
+)
+
+//nolint:gochecknoglobals
+var (
+	innWeights10  = []int{2, 4, 10, 3, 5, 9, 4, 6, 8}
+	innWeights12a = []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+	innWeights12b = []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+)
+
+type VerificationStorageInterface interface {
+	// SubmitRequest сохраняет документы в хранилище и создаёт заявку; у продавца может быть только одна
+	// заявка на рассмотрении.
+	SubmitRequest(ctx context.Context, userID uint, data *models.ReceivedVerificationRequest,
+		files []*multipart.FileHeader) (*models.VerificationRequest, error)
+	GetRequest(ctx context.Context, requestID uint) (*models.VerificationRequest, error)
+	GetUserRequests(ctx context.Context, userID uint) ([]*models.VerificationRequest, error)
+	// GetPendingRequests отдаёт limit заявок на рассмотрении с id больше after, старые сверху.
+	GetPendingRequests(ctx context.Context, after, limit uint) ([]*models.VerificationRequest, error)
+	// ResolveRequest закрывает заявку и при одобрении отмечает профиль продавца проверенным.
+	ResolveRequest(ctx context.Context, moderatorID uint,
+		data *models.ReceivedVerificationDecision) (*models.VerificationRequest, error)
+}
+
+// ValidTaxID проверяет ИНН организации (10 цифр) или предпринимателя (12 цифр) по контрольным разрядам.
+func ValidTaxID(taxID string) bool {
+	digits := make([]int, 0, len(taxID))
+
+	for _, r := range taxID {
+		if r < '0' || r > '9' {
+			return false
+		}
+
+		digits = append(digits, int(r-'0'))
+	}
+
+	switch len(digits) {
+	case len(innWeights10) + 1:
+		return innCheckDigit(digits, innWeights10) == digits[9]
+	case len(innWeights12b) + 1:
+		return innCheckDigit(digits, innWeights12a) == digits[10] &&
+			innCheckDigit(digits, innWeights12b) == digits[11]
+	default:
+		return false
+	}
+}
+
+func innCheckDigit(digits, weights []int) int {
+	sum := 0
+
+	for i, weight := range weights {
+		sum += digits[i] * weight
+	}
+
+	return sum % 11 % 10
+}
+
+// ValidateRequest нормализует реквизиты заявки и проверяет их; документы проверяет UploadValidator.
+func ValidateRequest(data *models.ReceivedVerificationRequest, documents int) error {
+	data.BusinessName = strings.TrimSpace(data.BusinessName)
+	data.BusinessAddress = strings.TrimSpace(data.BusinessAddress)
+	data.TaxID = strings.TrimSpace(data.TaxID)
+
+	switch {
+	case data.BusinessName == "" || utf8.RuneCountInString(data.BusinessName) > maxBusinessNameLen:
+		return fmt.Errorf("%w: business name", ErrRequestNotValid)
+	case utf8.RuneCountInString(data.BusinessAddress) > maxBusinessAddressLen:
+		return fmt.Errorf("%w: business address", ErrRequestNotValid)
+	case !ValidTaxID(data.TaxID):
+		return fmt.Errorf("%w: tax id", ErrRequestNotValid)
+	case documents == 0:
+		return fmt.Errorf("%w: %w", ErrRequestNotValid, ErrNoDocuments)
+	}
+
+	return nil
+}
+
+// ValidateDecision требует причину при отказе, чтобы продавцу было понятно, что исправить.
+func ValidateDecision(data *models.ReceivedVerificationDecision) error {
+	data.Reason = strings.TrimSpace(data.Reason)
+
+	switch {
+	case data.RequestID == 0:
+		return fmt.Errorf("%w: request id", ErrDecisionNotValid)
+	case !data.Approved && data.Reason == "":
+		return fmt.Errorf("%w: rejection without reason", ErrDecisionNotValid)
+	case utf8.RuneCountInString(data.Reason) > maxReasonLen:
+		return fmt.Errorf("%w: reason", ErrDecisionNotValid)
+	}
+
+	return nil
+}
+
+// PendingPageLimit приводит размер страницы к допустимому.
+func PendingPageLimit(limit int) uint {
+	return utils.PageLimit(limit, DefaultPendingPage, MaxPendingPage)
+}
+
+// WithDocumentURLs заполняет адреса документов заявки.
+func WithDocumentURLs(request *models.VerificationRequest) *models.VerificationRequest {
+	request.Documents = make([]string, 0, len(request.DocumentKeys))
+
+	for i := range request.DocumentKeys {
+		request.Documents = append(request.Documents, fmt.Sprintf(documentURLFormat, request.ID, i))
+	}
+
+	return request
+}
+
+// DocumentKey возвращает ключ документа заявки по его номеру.
+func DocumentKey(request *models.VerificationRequest, index int) (string, error) {
+	if index < 0 || index >= len(request.DocumentKeys) {
+		return "", ErrDocumentNotFound
+	}
+
+	return request.DocumentKeys[index], nil
+}
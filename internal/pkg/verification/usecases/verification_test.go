@@ -0,0 +1,120 @@
+//nolint:all
+package usecases_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/models"
+	"github.com/go-park-mail-ru/2024_1_IMAO/internal/pkg/verification/usecases"
+)
+
+func TestValidTaxID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		taxID string
+		want  bool
+	}{
+		{taxID: "7707083893", want: true},
+		{taxID: "7707083894"},
+		{taxID: "500100732259", want: true},
+		{taxID: "500100732258"},
+		{taxID: "770708389"},
+		{taxID: "77070838931"},
+		{taxID: "77070838a3"},
+		{taxID: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.taxID, func(t *testing.T) {
+			t.Parallel()
+
+			if got := usecases.ValidTaxID(tt.taxID); got != tt.want {
+				t.Errorf("ValidTaxID(%q) = %v, want %v", tt.taxID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		data      models.ReceivedVerificationRequest
+		documents int
+		wantErr   error
+	}{
+		{name: "valid", data: models.ReceivedVerificationRequest{BusinessName: " ИП Иванов ",
+			TaxID: " 500100732259 "}, documents: 1},
+		{name: "empty name", data: models.ReceivedVerificationRequest{BusinessName: "  ", TaxID: "7707083893"},
+			documents: 1, wantErr: usecases.ErrRequestNotValid},
+		{name: "bad tax id", data: models.ReceivedVerificationRequest{BusinessName: "ООО Ромашка",
+			TaxID: "1234567890"}, documents: 1, wantErr: usecases.ErrRequestNotValid},
+		{name: "no documents", data: models.ReceivedVerificationRequest{BusinessName: "ООО Ромашка",
+			TaxID: "7707083893"}, wantErr: usecases.ErrNoDocuments},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.ValidateRequest(&tt.data, tt.documents); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateRequest() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDecision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    models.ReceivedVerificationDecision
+		wantErr error
+	}{
+		{name: "approve", data: models.ReceivedVerificationDecision{RequestID: 1, Approved: true}},
+		{name: "reject with reason", data: models.ReceivedVerificationDecision{RequestID: 1,
+			Reason: "Скан нечитаем"}},
+		{name: "reject without reason", data: models.ReceivedVerificationDecision{RequestID: 1, Reason: " "},
+			wantErr: usecases.ErrDecisionNotValid},
+		{name: "no request", data: models.ReceivedVerificationDecision{Approved: true},
+			wantErr: usecases.ErrDecisionNotValid},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := usecases.ValidateDecision(&tt.data); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateDecision() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDocuments(t *testing.T) {
+	t.Parallel()
+
+	request := usecases.WithDocumentURLs(&models.VerificationRequest{ID: 5,
+		DocumentKeys: []string{"verification_documents/a.pdf", "verification_documents/b.png"}})
+
+	want := []string{"/api/verification/5/documents/0", "/api/verification/5/documents/1"}
+	if !reflect.DeepEqual(request.Documents, want) {
+		t.Errorf("Documents = %v, want %v", request.Documents, want)
+	}
+
+	if key, err := usecases.DocumentKey(request, 1); err != nil || key != "verification_documents/b.png" {
+		t.Errorf("DocumentKey(1) = %q, %v", key, err)
+	}
+
+	if _, err := usecases.DocumentKey(request, 2); !errors.Is(err, usecases.ErrDocumentNotFound) {
+		t.Errorf("DocumentKey(2) error = %v, want %v", err, usecases.ErrDocumentNotFound)
+	}
+}